@@ -1,15 +1,27 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"os"
 	apiAdapter "telemafia/internal/adapters/api"
+	fsAdapter "telemafia/internal/adapters/filesystem"
 	memrepo "telemafia/internal/adapters/repository/memory"
+	sqliterepo "telemafia/internal/adapters/repository/sqlite"
 	"telemafia/internal/config"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
 	gameCommand "telemafia/internal/domain/game/usecase/command"
 	gameQuery "telemafia/internal/domain/game/usecase/query"
+	mediaPort "telemafia/internal/domain/media/port"
+	mediaCommand "telemafia/internal/domain/media/usecase/command"
+	mediaQuery "telemafia/internal/domain/media/usecase/query"
+	roomPort "telemafia/internal/domain/room/port"
 	roomCommand "telemafia/internal/domain/room/usecase/command"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
+	scenarioPort "telemafia/internal/domain/scenario/port"
 	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
 	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
 	telegramHandler "telemafia/internal/presentation/telegram/handler"
@@ -32,6 +44,22 @@ func (p *EventPublisher) Publish(e event.Event) error {
 }
 
 func main() {
+	// Subcommands run instead of the bot
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatalf("Migration error: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "scenario" {
+		if err := runScenario(os.Args[2:]); errors.Is(err, errLintFailed) {
+			os.Exit(1)
+		} else if err != nil {
+			log.Fatalf("Scenario error: %v", err)
+		}
+		return
+	}
+
 	// Load Configuration
 	cfg, err := config.LoadConfig("config.json")
 	if err != nil {
@@ -74,9 +102,11 @@ func initializeDependencies(cfg *config.Config, msgs *messages.Messages) (*teleg
 	}
 
 	// Initialize repositories (Adapters)
-	roomRepo := memrepo.NewInMemoryRoomRepository()
-	scenarioRepo := memrepo.NewInMemoryScenarioRepository()
-	gameRepo := memrepo.NewInMemoryGameRepository()
+	repos, err := initializeRepositories(cfg)
+	if err != nil {
+		return nil, err
+	}
+	roomRepo, scenarioRepo, gameRepo := repos.room, repos.scenario, repos.game
 
 	// Initialize API Client Adapters (using local repos for now)
 	roomClient := apiAdapter.NewLocalRoomClient(roomRepo)
@@ -98,6 +128,8 @@ func initializeDependencies(cfg *config.Config, msgs *messages.Messages) (*teleg
 	getPlayersInRoomsHandler := roomQuery.NewGetPlayersInRoomHandler(roomRepo)
 	addDescriptionHandler := roomCommand.NewAddDescriptionHandler(roomRepo)
 	changeModeratorHandler := roomCommand.NewChangeModeratorHandler(roomRepo)
+	dropPlayersHandler := roomCommand.NewDropPlayersHandler(roomRepo)
+	roomSeatClient := apiAdapter.NewLocalRoomSeatClient(dropPlayersHandler)
 
 	// Scenario Use Cases
 	createScenarioHandler := scenarioCommand.NewCreateScenarioHandler(scenarioRepo)
@@ -105,6 +137,20 @@ func initializeDependencies(cfg *config.Config, msgs *messages.Messages) (*teleg
 	getScenarioByIDHandler := scenarioQuery.NewGetScenarioByIDHandler(scenarioRepo)
 	getAllScenariosHandler := scenarioQuery.NewGetAllScenariosHandler(scenarioRepo)
 	addScenarioJSONHandler := scenarioCommand.NewAddScenarioJSONHandler(scenarioRepo)
+	addSideHandler := scenarioCommand.NewAddSideHandler(scenarioRepo)
+	renameSideHandler := scenarioCommand.NewRenameSideHandler(scenarioRepo)
+	removeSideHandler := scenarioCommand.NewRemoveSideHandler(scenarioRepo)
+	setPopulationRateHandler := scenarioCommand.NewSetPopulationRateHandler(scenarioRepo)
+	setDefaultRoleHandler := scenarioCommand.NewSetDefaultRoleHandler(scenarioRepo)
+	addRoleHandler := scenarioCommand.NewAddRoleHandler(scenarioRepo)
+	removeRoleHandler := scenarioCommand.NewRemoveRoleHandler(scenarioRepo)
+	setRoleAddedAtHandler := scenarioCommand.NewSetRoleAddedAtHandler(scenarioRepo)
+	exportScenarioHandler := scenarioQuery.NewExportScenarioHandler(scenarioRepo)
+	exportAllScenariosHandler := scenarioQuery.NewExportAllScenariosHandler(scenarioRepo)
+	seedScenariosHandler := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource(cfg.ScenarioDir), scenarioRepo)
+
+	// Load the bundled scenarios before the bot starts serving requests
+	seedScenarios(seedScenariosHandler, cfg.ScenarioDir, cfg.ReseedScenarios)
 
 	// Game Use Cases
 	createGameHandler := gameCommand.NewCreateGameHandler(gameRepo, roomClient, scenarioClient)
@@ -112,6 +158,44 @@ func initializeDependencies(cfg *config.Config, msgs *messages.Messages) (*teleg
 	updateGameHandler := gameCommand.NewUpdateGameHandler(gameRepo)
 	getGamesHandler := gameQuery.NewGetGamesHandler(gameRepo)
 	getGameByIDHandler := gameQuery.NewGetGameByIDHandler(gameRepo)
+	startNightHandler := gameCommand.NewStartNightHandler(gameRepo)
+	startDayHandler := gameCommand.NewStartDayHandler(gameRepo)
+	startVotingHandler := gameCommand.NewStartVotingHandler(gameRepo)
+	startDefenseHandler := gameCommand.NewStartDefenseHandler(gameRepo)
+	endGameHandler := gameCommand.NewEndGameHandler(gameRepo)
+	eliminatePlayerHandler := gameCommand.NewEliminatePlayerHandler(gameRepo)
+	revivePlayerHandler := gameCommand.NewRevivePlayerHandler(gameRepo)
+	getGameByRoomIDHandler := gameQuery.NewGetGameByRoomIDHandler(gameRepo)
+	openVoteHandler := gameCommand.NewOpenVoteHandler(gameRepo)
+	castVoteHandler := gameCommand.NewCastVoteHandler(gameRepo)
+	closeVoteHandler := gameCommand.NewCloseVoteHandler(gameRepo)
+	submitNightActionHandler := gameCommand.NewSubmitNightActionHandler(gameRepo)
+	resolveNightHandler := gameCommand.NewResolveNightHandler(gameRepo, gameEntity.NewNightResolver())
+	startTimerHandler := gameCommand.NewStartTimerHandler(gameRepo, common.SystemClock{})
+	stopTimerHandler := gameCommand.NewStopTimerHandler(gameRepo)
+	tickTimerHandler := gameCommand.NewTickTimerHandler(gameRepo, common.SystemClock{})
+	startTurnsHandler := gameCommand.NewStartSpeakingTurnsHandler(gameRepo)
+	nextSpeakerHandler := gameCommand.NewNextSpeakerHandler(gameRepo)
+	requestChallengeHandler := gameCommand.NewRequestChallengeHandler(gameRepo)
+	grantChallengeHandler := gameCommand.NewGrantChallengeHandler(gameRepo)
+	moveSeatHandler := roomCommand.NewMoveSeatHandler(roomRepo)
+	shuffleSeatsHandler := roomCommand.NewShuffleSeatsHandler(roomRepo)
+	setRoomCapacityHandler := roomCommand.NewSetRoomCapacityHandler(roomRepo)
+	startReadyCheckHandler := gameCommand.NewStartReadyCheckHandler(gameRepo, roomRepo, common.SystemClock{})
+	markReadyHandler := gameCommand.NewMarkReadyHandler(gameRepo)
+	kickUnreadyHandler := gameCommand.NewKickUnreadyPlayersHandler(gameRepo, roomSeatClient)
+	tickReadyCheckHandler := gameCommand.NewTickReadyCheckHandler(gameRepo, roomSeatClient, common.SystemClock{})
+	syncReadyCheckHandler := gameCommand.NewSyncReadyCheckHandler(gameRepo)
+	saveRoleSelectionHandler := gameCommand.NewSaveRoleSelectionHandler(repos.roleSelection)
+	deleteRoleSelectionHandler := gameCommand.NewDeleteRoleSelectionHandler(repos.roleSelection)
+	getRoleSelectionsHandler := gameQuery.NewGetRoleSelectionsHandler(repos.roleSelection)
+	watchRoomHandler := roomCommand.NewWatchRoomHandler(roomRepo)
+	setRoomVisibilityHandler := roomCommand.NewSetRoomVisibilityHandler(roomRepo)
+	resetInviteHandler := roomCommand.NewResetInviteHandler(roomRepo)
+
+	// Media cache: local images are uploaded once per bot and reused by file ID
+	resolveMediaHandler := mediaQuery.NewResolveMediaHandler(repos.mediaCache, fsAdapter.NewMediaDirectorySource(cfg.MediaDir))
+	rememberMediaHandler := mediaCommand.NewRememberMediaHandler(repos.mediaCache)
 
 	// Initialize Telegram Bot Handler (Delivery Mechanism)
 	// Pass the correctly typed repository (roomRepo satisfies the interface needed by BotHandler)
@@ -120,6 +204,9 @@ func initializeDependencies(cfg *config.Config, msgs *messages.Messages) (*teleg
 		cfg.AdminUsernames,
 		msgs,
 		roomRepo,
+		saveRoleSelectionHandler,
+		deleteRoleSelectionHandler,
+		getRoleSelectionsHandler,
 		createRoomHandler,
 		joinRoomHandler,
 		leaveRoomHandler,
@@ -141,7 +228,121 @@ func initializeDependencies(cfg *config.Config, msgs *messages.Messages) (*teleg
 		updateGameHandler,
 		getGamesHandler,
 		getGameByIDHandler,
+		startNightHandler,
+		startDayHandler,
+		startVotingHandler,
+		startDefenseHandler,
+		endGameHandler,
+		eliminatePlayerHandler,
+		revivePlayerHandler,
+		getGameByRoomIDHandler,
+		openVoteHandler,
+		castVoteHandler,
+		closeVoteHandler,
+		submitNightActionHandler,
+		resolveNightHandler,
+		startTimerHandler,
+		stopTimerHandler,
+		tickTimerHandler,
+		startTurnsHandler,
+		nextSpeakerHandler,
+		requestChallengeHandler,
+		grantChallengeHandler,
+		moveSeatHandler,
+		shuffleSeatsHandler,
+		setRoomCapacityHandler,
+		startReadyCheckHandler,
+		markReadyHandler,
+		kickUnreadyHandler,
+		tickReadyCheckHandler,
+		syncReadyCheckHandler,
+		watchRoomHandler,
+		setRoomVisibilityHandler,
+		resetInviteHandler,
+		resolveMediaHandler,
+		rememberMediaHandler,
+		addSideHandler,
+		renameSideHandler,
+		removeSideHandler,
+		setPopulationRateHandler,
+		setDefaultRoleHandler,
+		addRoleHandler,
+		removeRoleHandler,
+		setRoleAddedAtHandler,
+		exportScenarioHandler,
+		exportAllScenariosHandler,
 	)
 
 	return botHandler, nil
 }
+
+// repositories groups the storage adapters chosen by the configured driver
+type repositories struct {
+	room          roomPort.RoomRepository
+	scenario      scenarioPort.ScenarioRepository
+	game          gamePort.GameRepository
+	roleSelection gamePort.RoleSelectionRepository
+	mediaCache    mediaPort.MediaCacheRepository
+}
+
+// initializeRepositories creates the repositories for the configured storage driver
+func initializeRepositories(cfg *config.Config) (*repositories, error) {
+	switch cfg.StorageDriver {
+	case config.StorageSQLite:
+		db, err := sqliterepo.Open(cfg.DatabasePath)
+		if errors.Is(err, sqliterepo.ErrSchemaTooNew) {
+			return nil, fmt.Errorf("refusing to start, upgrade the bot or run `telemafia migrate down` with the newer binary: %w", err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize SQLite storage: %w", err)
+		}
+		log.Printf("Using SQLite storage at '%s'", cfg.DatabasePath)
+		return &repositories{
+			room:          sqliterepo.NewSQLiteRoomRepository(db),
+			scenario:      sqliterepo.NewSQLiteScenarioRepository(db),
+			game:          sqliterepo.NewSQLiteGameRepository(db),
+			roleSelection: sqliterepo.NewSQLiteRoleSelectionRepository(db),
+			mediaCache:    sqliterepo.NewSQLiteMediaCacheRepository(db),
+		}, nil
+	default:
+		log.Println("Using in-memory storage")
+		return &repositories{
+			room:          memrepo.NewInMemoryRoomRepository(),
+			scenario:      memrepo.NewInMemoryScenarioRepository(),
+			game:          memrepo.NewInMemoryGameRepository(),
+			roleSelection: memrepo.NewInMemoryRoleSelectionRepository(),
+			mediaCache:    memrepo.NewInMemoryMediaCacheRepository(),
+		}, nil
+	}
+}
+
+// seedScenarios loads the scenario files of dir and logs a per-file report
+func seedScenarios(handler *scenarioCommand.SeedScenariosHandler, dir string, overwrite bool) {
+	results, err := handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{Overwrite: overwrite})
+	if err != nil {
+		log.Printf("⚠️ Skipping scenario seeding: %v", err)
+		return
+	}
+
+	failed, skipped := 0, 0
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			failed++
+			log.Printf("  ❌ %s: %v", r.File, r.Err)
+		case r.Replaced:
+			log.Printf("  ♻️ %s: updated scenario '%s' (%s)", r.File, r.Name, r.ScenarioID)
+		case r.Unchanged:
+			log.Printf("  ✅ %s: scenario '%s' (%s) is up to date", r.File, r.Name, r.ScenarioID)
+		case r.Skipped:
+			skipped++
+			log.Printf("  ⏭️ %s: kept scenario '%s' (%s), which was edited in the bot; changes in the file are not applied without reseed_scenarios / -reseed-scenarios", r.File, r.Name, r.ScenarioID)
+		default:
+			log.Printf("  ✅ %s: loaded scenario '%s' (%s)", r.File, r.Name, r.ScenarioID)
+		}
+	}
+	log.Printf("Seeded %d/%d scenario files from '%s'", len(results)-failed, len(results), dir)
+	if skipped > 0 {
+		log.Printf("⚠️ %d scenario files were skipped because their scenarios were edited in the bot; set reseed_scenarios or pass -reseed-scenarios to overwrite them with the files", skipped)
+	}
+}
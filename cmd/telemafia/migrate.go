@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	sqliterepo "telemafia/internal/adapters/repository/sqlite"
+	"telemafia/internal/config"
+)
+
+const migrateUsage = `Usage: telemafia migrate [-config config.json] [-db telemafia.db] <command>
+
+Commands:
+  up          apply all pending migrations (default)
+  down [N]    revert the last N applied migrations (default 1)
+  status      list migrations and whether they are applied`
+
+// runMigrate implements the `telemafia migrate` subcommand for the SQLite storage.
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	configFile := fs.String("config", "config.json", "Path to JSON config file")
+	dbPath := fs.String("db", "", "Path to the SQLite database file (overrides config)")
+	fs.Usage = func() { fmt.Fprintln(fs.Output(), migrateUsage) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := config.LoadStorageConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *dbPath != "" {
+		cfg.DatabasePath = *dbPath
+	}
+
+	command := "up"
+	if fs.NArg() > 0 {
+		command = fs.Arg(0)
+	}
+
+	db, err := sqliterepo.Connect(cfg.DatabasePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrator, err := sqliterepo.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up()
+		for _, v := range applied {
+			fmt.Printf("✅ Applied migration %04d\n", v)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("Database is already up to date")
+		}
+	case "down":
+		steps := 1
+		if fs.NArg() > 1 {
+			steps, err = strconv.Atoi(fs.Arg(1))
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps '%s'", fs.Arg(1))
+			}
+		}
+		reverted, err := migrator.Down(steps)
+		for _, v := range reverted {
+			fmt.Printf("↩️ Reverted migration %04d\n", v)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Println("No applied migrations to revert")
+		}
+	case "status":
+		version, err := migrator.Version()
+		if err != nil {
+			return err
+		}
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Database: %s (version %d, binary supports %d)\n", cfg.DatabasePath, version, migrator.Latest())
+		for _, s := range statuses {
+			if s.Applied {
+				fmt.Printf("  [x] %04d_%s (applied %s)\n", s.Version, s.Name, s.AppliedAt.Format("2006-01-02 15:04:05"))
+			} else {
+				fmt.Printf("  [ ] %04d_%s\n", s.Version, s.Name)
+			}
+		}
+		if version > migrator.Latest() {
+			fmt.Println("⚠️ Database schema is newer than this binary")
+		}
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown migrate command '%s'", command)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+)
+
+const scenarioUsage = `Usage: telemafia scenario <command>
+
+Commands:
+  lint [-min N] [-max N] [-v] <file>...   check scenario files for problems (exits non-zero on errors)`
+
+// errLintFailed is returned when a linted scenario has errors, after the report has been printed
+var errLintFailed = errors.New("scenario lint found errors")
+
+// runScenario implements the `telemafia scenario` subcommand.
+func runScenario(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, scenarioUsage)
+		return fmt.Errorf("missing scenario command")
+	}
+	switch args[0] {
+	case "lint":
+		return runScenarioLint(args[1:])
+	default:
+		fmt.Fprintln(os.Stderr, scenarioUsage)
+		return fmt.Errorf("unknown scenario command '%s'", args[0])
+	}
+}
+
+// runScenarioLint runs the ScenarioValidator on every given file and prints its report
+func runScenarioLint(args []string) error {
+	fs := flag.NewFlagSet("scenario lint", flag.ContinueOnError)
+	minPlayers := fs.Int("min", scenarioEntity.DefaultValidationMinPlayers, "Smallest player count to check")
+	maxPlayers := fs.Int("max", scenarioEntity.DefaultValidationMaxPlayers, "Largest player count to check")
+	verbose := fs.Bool("v", false, "Print the roles handed out for every player count")
+	fs.Usage = func() { fmt.Fprintln(fs.Output(), scenarioUsage) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("no scenario files given")
+	}
+	if *minPlayers < 1 || *maxPlayers < *minPlayers {
+		return fmt.Errorf("invalid player range %d-%d", *minPlayers, *maxPlayers)
+	}
+
+	validator := scenarioEntity.NewScenarioValidator(*minPlayers, *maxPlayers)
+	failed := false
+	for _, path := range fs.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read scenario file '%s': %w", path, err)
+		}
+		var scenario scenarioEntity.Scenario
+		if err := json.Unmarshal(data, &scenario); err != nil {
+			fmt.Printf("❌ %s: invalid JSON format: %v\n", path, err)
+			failed = true
+			continue
+		}
+		report := validator.Validate(&scenario)
+		printLintReport(path, &scenario, report, *verbose)
+		failed = failed || report.HasErrors()
+	}
+	if failed {
+		return errLintFailed
+	}
+	return nil
+}
+
+// lintIssueText describes a validator finding in English for the console
+func lintIssueText(issue scenarioEntity.ValidationIssue, report *scenarioEntity.ValidationReport) string {
+	switch issue.Code {
+	case scenarioEntity.IssueInvalidScenario:
+		return issue.Detail
+	case scenarioEntity.IssueBoundsOutsideRange:
+		return fmt.Sprintf("the declared player counts lie outside the checked %d-%d", report.MinPlayers, report.MaxPlayers)
+	case scenarioEntity.IssueRateOutOfRange:
+		return fmt.Sprintf("side '%s': population_rate %.2f is outside %.2f-%.2f and is ignored", issue.Side, issue.Rate, scenarioEntity.MinPopulationRate, scenarioEntity.MaxPopulationRate)
+	case scenarioEntity.IssueRateWithoutDefaultRole:
+		return fmt.Sprintf("side '%s': population_rate has no effect without a default_role", issue.Side)
+	case scenarioEntity.IssueRatesExceedPlayers:
+		return fmt.Sprintf("population rates add up to %.2f, more than all players", issue.Rate)
+	case scenarioEntity.IssueDuplicateRole:
+		return fmt.Sprintf("side '%s': role '%s' is listed more than once", issue.Side, issue.Role)
+	case scenarioEntity.IssueSharedRole:
+		return fmt.Sprintf("role '%s' belongs to both '%s' and '%s'", issue.Role, issue.Side, issue.OtherSide)
+	case scenarioEntity.IssueTooFewRoles:
+		return "fewer roles than players"
+	case scenarioEntity.IssueTooManyRoles:
+		return "more roles than players"
+	case scenarioEntity.IssueSideWithoutPlayers:
+		return fmt.Sprintf("side '%s' gets no players", issue.Side)
+	case scenarioEntity.IssueParityAtStart:
+		return fmt.Sprintf("side '%s' starts with parity and wins at once", issue.Side)
+	default:
+		return string(issue.Code)
+	}
+}
+
+// printLintReport prints the issues of one scenario file, followed by its role table in verbose mode
+func printLintReport(path string, scenario *scenarioEntity.Scenario, report *scenarioEntity.ValidationReport, verbose bool) {
+	errs, warnings := report.Errors(), report.Warnings()
+	if len(report.Issues) == 0 {
+		fmt.Printf("✅ %s (%s): no problems for %d-%d players\n", path, scenario.Name, report.MinPlayers, report.MaxPlayers)
+	} else {
+		fmt.Printf("%s (%s): %d error(s), %d warning(s) for %d-%d players\n", path, scenario.Name, len(errs), len(warnings), report.MinPlayers, report.MaxPlayers)
+	}
+	for _, issue := range report.Issues {
+		prefix := "  warning:"
+		if issue.Severity == scenarioEntity.SeverityError {
+			prefix = "  error:"
+		}
+		if len(issue.Players) > 0 {
+			fmt.Printf("%s %s (players: %s)\n", prefix, lintIssueText(issue, report), issue.PlayerRanges())
+		} else {
+			fmt.Printf("%s %s\n", prefix, lintIssueText(issue, report))
+		}
+	}
+	if !verbose {
+		return
+	}
+	for _, count := range report.Counts {
+		sides := make([]string, 0, len(scenario.Sides))
+		for _, side := range scenario.Sides {
+			sides = append(sides, fmt.Sprintf("%s %d", side.Name, count.SideCounts[side.Name]))
+		}
+		roles := make([]string, 0, len(count.RoleCounts))
+		for _, name := range scenarioEntity.SortedNames(count.RoleCounts) {
+			roles = append(roles, fmt.Sprintf("%d× %s", count.RoleCounts[name], name))
+		}
+		fmt.Printf("  %2d players, %2d roles | %s | %s\n", count.Players, count.Roles, strings.Join(sides, ", "), strings.Join(roles, ", "))
+	}
+}
@@ -3,5 +3,6 @@ module telemafia
 go 1.22.3
 
 require (
-	gopkg.in/telebot.v4 v4.0.0-beta.4 // indirect
+	github.com/mattn/go-sqlite3 v1.14.22
+	gopkg.in/telebot.v4 v4.0.0-beta.4
 )
@@ -1,9 +1,13 @@
 package api
 
 import (
+	"context"
+
 	gamePort "telemafia/internal/domain/game/port"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomPort "telemafia/internal/domain/room/port"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
 )
 
 // Ensure LocalRoomClient implements the gamePort.RoomClient interface.
@@ -26,3 +30,22 @@ func (c *LocalRoomClient) FetchRoom(id roomEntity.RoomID) (*roomEntity.Room, err
 	// In a real microservice, this would make an API call to the Room service.
 	return c.roomRepo.GetRoomByID(id)
 }
+
+// Ensure LocalRoomSeatClient implements the gamePort.RoomSeatClient interface.
+var _ gamePort.RoomSeatClient = (*LocalRoomSeatClient)(nil)
+
+// LocalRoomSeatClient implements the RoomSeatClient interface by running
+// the Room domain's DropPlayers command within the monolith.
+type LocalRoomSeatClient struct {
+	dropPlayers *roomCommand.DropPlayersHandler
+}
+
+// NewLocalRoomSeatClient creates a new LocalRoomSeatClient.
+func NewLocalRoomSeatClient(dropPlayers *roomCommand.DropPlayersHandler) *LocalRoomSeatClient {
+	return &LocalRoomSeatClient{dropPlayers: dropPlayers}
+}
+
+// DropPlayers removes the players through the Room domain, which serializes it with the other room commands.
+func (c *LocalRoomSeatClient) DropPlayers(ctx context.Context, roomID roomEntity.RoomID, playerIDs []sharedEntity.UserID) (*roomEntity.Room, []sharedEntity.UserID, []*sharedEntity.User, error) {
+	return c.dropPlayers.Handle(ctx, roomCommand.DropPlayersCommand{RoomID: roomID, PlayerIDs: playerIDs})
+}
@@ -0,0 +1,36 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	mediaPort "telemafia/internal/domain/media/port"
+	"telemafia/internal/shared/common"
+)
+
+// Ensure MediaDirectorySource implements the mediaPort.MediaSource interface.
+var _ mediaPort.MediaSource = (*MediaDirectorySource)(nil)
+
+// MediaDirectorySource reads media files from a directory, such as the images under resources/
+type MediaDirectorySource struct {
+	dir string
+}
+
+// NewMediaDirectorySource creates a media source backed by a directory
+func NewMediaDirectorySource(dir string) mediaPort.MediaSource {
+	return &MediaDirectorySource{dir: dir}
+}
+
+// ReadMedia returns the content of a file of the directory; paths leaving the directory are rejected
+func (s *MediaDirectorySource) ReadMedia(path string) ([]byte, error) {
+	path, err := common.CleanMediaPath(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read media file '%s': %w", path, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,46 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	scenarioPort "telemafia/internal/domain/scenario/port"
+)
+
+// Ensure ScenarioDirectorySource implements the scenarioPort.ScenarioSource interface.
+var _ scenarioPort.ScenarioSource = (*ScenarioDirectorySource)(nil)
+
+// ScenarioDirectorySource reads scenario documents from the *.json files of a directory
+type ScenarioDirectorySource struct {
+	dir string
+}
+
+// NewScenarioDirectorySource creates a scenario source backed by a directory
+func NewScenarioDirectorySource(dir string) scenarioPort.ScenarioSource {
+	return &ScenarioDirectorySource{dir: dir}
+}
+
+// ListScenarioFiles returns the *.json files of the directory in name order
+func (s *ScenarioDirectorySource) ListScenarioFiles() ([]scenarioPort.ScenarioFile, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario directory '%s': %w", s.dir, err)
+	}
+
+	var files []scenarioPort.ScenarioFile
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read scenario file '%s': %w", entry.Name(), err)
+		}
+		files = append(files, scenarioPort.ScenarioFile{Name: entry.Name(), Data: data})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
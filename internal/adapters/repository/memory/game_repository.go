@@ -17,7 +17,8 @@ import (
 // Ensure InMemoryGameRepository implements the gamePort.GameRepository interface.
 var _ gamePort.GameRepository = (*InMemoryGameRepository)(nil)
 
-// InMemoryGameRepository provides an in-memory implementation of the game repository
+// InMemoryGameRepository provides an in-memory implementation of the game repository.
+// Games are copied on the way in and out, like the SQLite repository loads a fresh game per read.
 type InMemoryGameRepository struct {
 	games      map[gameEntity.GameID]*gameEntity.Game  // Use imported types
 	roomToGame map[roomEntity.RoomID]gameEntity.GameID // Use imported types
@@ -41,7 +42,7 @@ func (r *InMemoryGameRepository) GetGameByID(id gameEntity.GameID) (*gameEntity.
 	if !exists {
 		return nil, fmt.Errorf("game with ID %s not found", id)
 	}
-	return game, nil
+	return game.Clone(), nil
 }
 
 // GetGameByRoomID gets a game by room ID
@@ -66,7 +67,7 @@ func (r *InMemoryGameRepository) GetGameByRoomID(roomID roomEntity.RoomID) (*gam
 	}
 
 	log.Printf("Successfully retrieved game '%s' for room '%s'", game.ID, roomID)
-	return game, nil
+	return game.Clone(), nil
 }
 
 // GetAllGames returns all games
@@ -76,7 +77,7 @@ func (r *InMemoryGameRepository) GetAllGames() ([]*gameEntity.Game, error) {
 
 	games := make([]*gameEntity.Game, 0, len(r.games))
 	for _, game := range r.games {
-		games = append(games, game)
+		games = append(games, game.Clone())
 	}
 
 	log.Printf("Retrieved %d games from repository", len(games))
@@ -98,7 +99,7 @@ func (r *InMemoryGameRepository) CreateGame(game *gameEntity.Game) error {
 		return fmt.Errorf("game with ID %s already exists", game.ID)
 	}
 
-	r.games[game.ID] = game
+	r.games[game.ID] = game.Clone()
 	r.roomToGame[game.Room.ID] = game.ID
 
 	log.Printf("Successfully created game '%s' linked to room '%s'", game.ID, game.Room.ID)
@@ -120,8 +121,8 @@ func (r *InMemoryGameRepository) UpdateGame(game *gameEntity.Game) error {
 		return fmt.Errorf("cannot update game: game with ID %s not found", game.ID)
 	}
 
-	// Simply replace the existing game object in the map
-	r.games[game.ID] = game
+	// Store a copy so callers holding the game cannot change the stored one behind the repository's back
+	r.games[game.ID] = game.Clone()
 
 	// Note: This assumes the RoomID associated with the GameID doesn't change.
 	// If it could, the roomToGame map would also need updating.
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"sync"
+
+	mediaEntity "telemafia/internal/domain/media/entity"
+	mediaPort "telemafia/internal/domain/media/port"
+)
+
+// Ensure InMemoryMediaCacheRepository implements the mediaPort.MediaCacheRepository interface.
+var _ mediaPort.MediaCacheRepository = (*InMemoryMediaCacheRepository)(nil)
+
+// mediaKey identifies an upload by the bot that made it and the file
+type mediaKey struct {
+	botID int64
+	path  string
+}
+
+// InMemoryMediaCacheRepository keeps uploaded media in memory, so files are uploaded again after a restart
+type InMemoryMediaCacheRepository struct {
+	media map[mediaKey]mediaEntity.CachedMedia
+	mutex sync.RWMutex
+}
+
+// NewInMemoryMediaCacheRepository creates a new in-memory media cache
+func NewInMemoryMediaCacheRepository() mediaPort.MediaCacheRepository {
+	return &InMemoryMediaCacheRepository{
+		media: make(map[mediaKey]mediaEntity.CachedMedia),
+	}
+}
+
+// GetCachedMedia gets the upload of a file by a bot
+func (r *InMemoryMediaCacheRepository) GetCachedMedia(botID int64, path string) (*mediaEntity.CachedMedia, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	media, exists := r.media[mediaKey{botID: botID, path: path}]
+	if !exists {
+		return nil, mediaEntity.ErrMediaNotCached
+	}
+	return &media, nil
+}
+
+// SaveCachedMedia creates or replaces the upload of a file by a bot
+func (r *InMemoryMediaCacheRepository) SaveCachedMedia(media *mediaEntity.CachedMedia) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.media[mediaKey{botID: media.BotID, path: media.Path}] = *media
+	return nil
+}
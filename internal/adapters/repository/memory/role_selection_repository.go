@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// Ensure InMemoryRoleSelectionRepository implements the gamePort.RoleSelectionRepository interface.
+var _ gamePort.RoleSelectionRepository = (*InMemoryRoleSelectionRepository)(nil)
+
+// InMemoryRoleSelectionRepository keeps "Choose Card" rounds in memory.
+// It does not survive restarts; it exists so the memory storage driver needs no special casing.
+type InMemoryRoleSelectionRepository struct {
+	selections map[gameEntity.GameID]*gameEntity.RoleSelection
+	mutex      sync.RWMutex
+}
+
+// NewInMemoryRoleSelectionRepository creates a new in-memory role selection repository
+func NewInMemoryRoleSelectionRepository() gamePort.RoleSelectionRepository {
+	return &InMemoryRoleSelectionRepository{
+		selections: make(map[gameEntity.GameID]*gameEntity.RoleSelection),
+	}
+}
+
+// GetRoleSelection gets the saved round of a game
+func (r *InMemoryRoleSelectionRepository) GetRoleSelection(gameID gameEntity.GameID) (*gameEntity.RoleSelection, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	selection, exists := r.selections[gameID]
+	if !exists {
+		return nil, fmt.Errorf("no role selection found for game %s", gameID)
+	}
+	return copyRoleSelection(selection), nil
+}
+
+// GetAllRoleSelections gets every saved round
+func (r *InMemoryRoleSelectionRepository) GetAllRoleSelections() ([]*gameEntity.RoleSelection, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	selections := make([]*gameEntity.RoleSelection, 0, len(r.selections))
+	for _, selection := range r.selections {
+		selections = append(selections, copyRoleSelection(selection))
+	}
+	return selections, nil
+}
+
+// SaveRoleSelection creates or replaces the saved round of a game
+func (r *InMemoryRoleSelectionRepository) SaveRoleSelection(selection *gameEntity.RoleSelection) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.selections[selection.GameID] = copyRoleSelection(selection)
+	return nil
+}
+
+// DeleteRoleSelection removes the saved round of a game
+func (r *InMemoryRoleSelectionRepository) DeleteRoleSelection(gameID gameEntity.GameID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.selections, gameID)
+	return nil
+}
+
+// copyRoleSelection snapshots the round so callers can keep mutating their own copy.
+func copyRoleSelection(s *gameEntity.RoleSelection) *gameEntity.RoleSelection {
+	c := &gameEntity.RoleSelection{
+		GameID:        s.GameID,
+		ShuffledRoles: append(s.ShuffledRoles[:0:0], s.ShuffledRoles...),
+		Choices:       make(map[sharedEntity.UserID]gameEntity.RoleChoice, len(s.Choices)),
+		Messages:      append(s.Messages[:0:0], s.Messages...),
+	}
+	for k, v := range s.Choices {
+		c.Choices[k] = v
+	}
+	return c
+}
@@ -14,6 +14,8 @@ import (
 // Ensure InMemoryRepository implements the roomPort.RoomRepository interface.
 var _ roomPort.RoomRepository = (*InMemoryRoomRepository)(nil)
 
+// InMemoryRoomRepository keeps rooms in a map. Rooms are copied on the way in and out, so callers
+// change their own copy and only UpdateRoom changes the stored room.
 type InMemoryRoomRepository struct {
 	rooms map[roomEntity.RoomID]*roomEntity.Room
 	mutex sync.RWMutex
@@ -41,7 +43,7 @@ func (r *InMemoryRoomRepository) CreateRoom(room *roomEntity.Room) error {
 		return fmt.Errorf("room with ID %s already exists", room.ID) // Use roomEntity.ErrRoomAlreadyExists?
 	}
 
-	r.rooms[room.ID] = room
+	r.rooms[room.ID] = room.Clone()
 	// r.changeFlag = true // REMOVED
 	return nil
 }
@@ -55,7 +57,7 @@ func (r *InMemoryRoomRepository) GetRoomByID(id roomEntity.RoomID) (*roomEntity.
 		return nil, roomEntity.ErrRoomNotFound // Use error from entity package
 	}
 
-	return room, nil
+	return room.Clone(), nil
 }
 
 func (r *InMemoryRoomRepository) GetRooms() ([]*roomEntity.Room, error) {
@@ -64,7 +66,7 @@ func (r *InMemoryRoomRepository) GetRooms() ([]*roomEntity.Room, error) {
 
 	rooms := make([]*roomEntity.Room, 0, len(r.rooms))
 	for _, room := range r.rooms {
-		rooms = append(rooms, room)
+		rooms = append(rooms, room.Clone())
 	}
 
 	return rooms, nil
@@ -78,7 +80,7 @@ func (r *InMemoryRoomRepository) GetPlayerRooms(playerID sharedEntity.UserID) ([
 	for _, room := range r.rooms {
 		for _, player := range room.Players {
 			if player != nil && player.ID == playerID { // Add nil check
-				playerRooms = append(playerRooms, room)
+				playerRooms = append(playerRooms, room.Clone())
 				break
 			}
 		}
@@ -104,7 +106,8 @@ func (r *InMemoryRoomRepository) AddPlayerToRoom(roomID roomEntity.RoomID, playe
 		}
 	}
 
-	room.Players = append(room.Players, player)
+	user := *player
+	room.Players = append(room.Players, &user)
 	// r.changeFlag = true // REMOVED
 	return nil
 }
@@ -139,10 +142,7 @@ func (r *InMemoryRoomRepository) GetPlayersInRoom(roomID roomEntity.RoomID) ([]*
 		return nil, roomEntity.ErrRoomNotFound
 	}
 
-	// Return a copy to prevent external modification?
-	playersCopy := make([]*sharedEntity.User, len(room.Players))
-	copy(playersCopy, room.Players)
-	return playersCopy, nil
+	return room.Clone().Players, nil
 }
 
 // DeleteRoom deletes a room by ID
@@ -194,7 +194,7 @@ func (r *InMemoryRoomRepository) UpdateRoom(room *roomEntity.Room) error {
 		return roomEntity.ErrRoomNotFound
 	}
 	// Replace the existing entry
-	r.rooms[room.ID] = room
+	r.rooms[room.ID] = room.Clone()
 	// r.changeFlag = true // REMOVED
 	return nil
 }
@@ -34,7 +34,7 @@ func (r *InMemoryScenarioRepository) GetScenarioByID(id string) (*scenarioEntity
 
 	scenario, exists := r.data[id]
 	if !exists {
-		return nil, fmt.Errorf("scenario with ID %s not found", id)
+		return nil, fmt.Errorf("%w: scenario with ID %s not found", scenarioEntity.ErrScenarioNotFound, id)
 	}
 	return scenario, nil
 }
@@ -63,13 +63,25 @@ func (r *InMemoryScenarioRepository) CreateScenario(scenario *scenarioEntity.Sce
 	return nil
 }
 
+// UpdateScenario replaces an existing scenario
+func (r *InMemoryScenarioRepository) UpdateScenario(scenario *scenarioEntity.Scenario) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, exists := r.data[scenario.ID]; !exists {
+		return fmt.Errorf("%w: cannot update scenario with ID %s", scenarioEntity.ErrScenarioNotFound, scenario.ID)
+	}
+	r.data[scenario.ID] = scenario
+	return nil
+}
+
 // DeleteScenario removes a scenario by its ID
 func (r *InMemoryScenarioRepository) DeleteScenario(id string) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
 	if _, exists := r.data[id]; !exists {
-		return fmt.Errorf("scenario with ID %s not found for deletion", id)
+		return fmt.Errorf("%w: scenario with ID %s not found for deletion", scenarioEntity.ErrScenarioNotFound, id)
 	}
 	delete(r.data, id)
 	return nil
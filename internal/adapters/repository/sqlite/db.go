@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3" // Registers the "sqlite3" database/sql driver
+)
+
+// queryer is satisfied by both *sql.DB and *sql.Tx so loaders can be shared
+// between plain reads and reads inside a write transaction.
+type queryer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// Open opens (or creates) the SQLite database at path and applies any pending migrations.
+// It fails with ErrSchemaTooNew if the database was migrated by a newer binary.
+// The returned *sql.DB is shared by all SQLite repositories.
+func Open(path string) (*sql.DB, error) {
+	db, err := Connect(path)
+	if err != nil {
+		return nil, err
+	}
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := migrator.Up(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to migrate sqlite database '%s': %w", path, err)
+	}
+	return db, nil
+}
+
+// Connect opens (or creates) the SQLite database at path without touching the schema.
+// Use it together with NewMigrator to inspect or migrate a database explicitly.
+func Connect(path string) (*sql.DB, error) {
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000", path)
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database '%s': %w", path, err)
+	}
+	// SQLite allows a single writer; serialize access through one connection
+	// instead of surfacing "database is locked" errors to the handlers.
+	db.SetMaxOpenConns(1)
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to sqlite database '%s': %w", path, err)
+	}
+	return db, nil
+}
+
+// withTx runs fn inside a transaction, committing on success and rolling back on error.
+func withTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
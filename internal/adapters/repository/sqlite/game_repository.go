@@ -0,0 +1,592 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	roomEntity "telemafia/internal/domain/room/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// Ensure SQLiteGameRepository implements the gamePort.GameRepository interface.
+var _ gamePort.GameRepository = (*SQLiteGameRepository)(nil)
+
+// SQLiteGameRepository stores games and their role assignments in SQLite.
+// The room of a game is read from the same database when a game is loaded; the scenario is
+// stored with the game so later edits or deletes of the scenario do not change a running game.
+type SQLiteGameRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteGameRepository creates a new SQLite game repository
+func NewSQLiteGameRepository(db *sql.DB) gamePort.GameRepository {
+	return &SQLiteGameRepository{db: db}
+}
+
+// GetGameByID gets a game by its ID
+func (r *SQLiteGameRepository) GetGameByID(id gameEntity.GameID) (*gameEntity.Game, error) {
+	return loadGame(r.db, id)
+}
+
+// GetGameByRoomID gets the most recently created game of a room
+func (r *SQLiteGameRepository) GetGameByRoomID(roomID roomEntity.RoomID) (*gameEntity.Game, error) {
+	var id string
+	err := r.db.QueryRow(`SELECT id FROM games WHERE room_id = ? ORDER BY rowid DESC LIMIT 1`, string(roomID)).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no game found for room '%s'", roomID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up game for room '%s': %w", roomID, err)
+	}
+	return loadGame(r.db, gameEntity.GameID(id))
+}
+
+// GetAllGames returns all games
+func (r *SQLiteGameRepository) GetAllGames() ([]*gameEntity.Game, error) {
+	rows, err := r.db.Query(`SELECT id FROM games ORDER BY rowid`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query games: %w", err)
+	}
+	var ids []gameEntity.GameID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan game id: %w", err)
+		}
+		ids = append(ids, gameEntity.GameID(id))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate games: %w", err)
+	}
+
+	games := make([]*gameEntity.Game, 0, len(ids))
+	for _, id := range ids {
+		game, err := loadGame(r.db, id)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, game)
+	}
+	return games, nil
+}
+
+// CreateGame creates a new game
+func (r *SQLiteGameRepository) CreateGame(game *gameEntity.Game) error {
+	if game == nil || game.Room == nil {
+		return errors.New("cannot create game: game or game room is nil")
+	}
+	return withTx(r.db, func(tx *sql.Tx) error {
+		exists, err := gameExists(tx, game.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("game with ID %s already exists", game.ID)
+		}
+		return saveGame(tx, game)
+	})
+}
+
+// UpdateGame updates an existing game
+func (r *SQLiteGameRepository) UpdateGame(game *gameEntity.Game) error {
+	if game == nil {
+		return errors.New("cannot update nil game")
+	}
+	if game.Room == nil {
+		return fmt.Errorf("cannot update game %s: game room is nil", game.ID)
+	}
+	return withTx(r.db, func(tx *sql.Tx) error {
+		exists, err := gameExists(tx, game.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("cannot update game: game with ID %s not found", game.ID)
+		}
+		return saveGame(tx, game)
+	})
+}
+
+// DeleteGame deletes a game by ID; assignments are removed by cascade
+func (r *SQLiteGameRepository) DeleteGame(id gameEntity.GameID) error {
+	res, err := r.db.Exec(`DELETE FROM games WHERE id = ?`, string(id))
+	if err != nil {
+		return fmt.Errorf("failed to delete game %s: %w", id, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("cannot delete game: game with ID %s not found", id)
+	}
+	return nil
+}
+
+func gameExists(q queryer, id gameEntity.GameID) (bool, error) {
+	var n int
+	if err := q.QueryRow(`SELECT COUNT(*) FROM games WHERE id = ?`, string(id)).Scan(&n); err != nil {
+		return false, fmt.Errorf("failed to check game %s: %w", id, err)
+	}
+	return n > 0, nil
+}
+
+// saveGame upserts the game row and rewrites its role assignments.
+func saveGame(q queryer, game *gameEntity.Game) error {
+	var scenarioID sql.NullString
+	var scenarioData []byte
+	if game.Scenario != nil {
+		scenarioID = sql.NullString{String: game.Scenario.ID, Valid: true}
+		data, err := json.Marshal(game.Scenario)
+		if err != nil {
+			return fmt.Errorf("failed to encode scenario of game %s: %w", game.ID, err)
+		}
+		scenarioData = data
+	}
+	var phaseStartedAt sql.NullTime
+	if !game.Phase.StartedAt.IsZero() {
+		phaseStartedAt = sql.NullTime{Time: game.Phase.StartedAt, Valid: true}
+	}
+	winners, err := json.Marshal(game.Winners)
+	if err != nil {
+		return fmt.Errorf("failed to encode winners of game %s: %w", game.ID, err)
+	}
+	seats, err := json.Marshal(game.Seats)
+	if err != nil {
+		return fmt.Errorf("failed to encode seats of game %s: %w", game.ID, err)
+	}
+	_, err = q.Exec(`
+		INSERT INTO games (id, room_id, scenario_id, scenario_data, state, phase_type, phase_number, phase_started_at, resolved_night, winners, seats)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			room_id = excluded.room_id,
+			scenario_id = excluded.scenario_id,
+			scenario_data = excluded.scenario_data,
+			state = excluded.state,
+			phase_type = excluded.phase_type,
+			phase_number = excluded.phase_number,
+			phase_started_at = excluded.phase_started_at,
+			resolved_night = excluded.resolved_night,
+			winners = excluded.winners,
+			seats = excluded.seats`,
+		string(game.ID), string(game.Room.ID), scenarioID, string(scenarioData), string(game.State),
+		string(game.Phase.Type), game.Phase.Number, phaseStartedAt, game.ResolvedNight, string(winners), string(seats))
+	if err != nil {
+		return fmt.Errorf("failed to save game %s: %w", game.ID, err)
+	}
+
+	if _, err := q.Exec(`DELETE FROM game_assignments WHERE game_id = ?`, string(game.ID)); err != nil {
+		return fmt.Errorf("failed to reset assignments of game %s: %w", game.ID, err)
+	}
+	for userID, role := range game.Assignments {
+		data, err := json.Marshal(role)
+		if err != nil {
+			return fmt.Errorf("failed to encode role '%s': %w", role.Name, err)
+		}
+		status := game.Status(userID)
+		if _, err := q.Exec(`
+			INSERT INTO game_assignments (game_id, user_id, role_name, role_side, role_data, alive, death_cause, death_phase_type, death_phase_number, revealed)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			string(game.ID), int64(userID), role.Name, role.Side, string(data),
+			status.Alive, string(status.Cause), string(status.DiedIn.Type), status.DiedIn.Number, status.Revealed); err != nil {
+			return fmt.Errorf("failed to save assignment of user %d in game %s: %w", userID, game.ID, err)
+		}
+	}
+	if err := saveTimer(q, game); err != nil {
+		return err
+	}
+	if err := saveSpeakingTurns(q, game); err != nil {
+		return err
+	}
+	if err := saveVote(q, game); err != nil {
+		return err
+	}
+	if err := saveReadyCheck(q, game); err != nil {
+		return err
+	}
+	return saveNightActions(q, game)
+}
+
+// saveNightActions rewrites the night actions of a game, keeping their order.
+func saveNightActions(q queryer, game *gameEntity.Game) error {
+	if _, err := q.Exec(`DELETE FROM game_night_actions WHERE game_id = ?`, string(game.ID)); err != nil {
+		return fmt.Errorf("failed to reset night actions of game %s: %w", game.ID, err)
+	}
+	for pos, action := range game.NightActions {
+		targets, err := json.Marshal(action.Targets)
+		if err != nil {
+			return fmt.Errorf("failed to encode night action targets of game %s: %w", game.ID, err)
+		}
+		if _, err := q.Exec(`
+			INSERT INTO game_night_actions (game_id, night, actor_id, ability, targets, position) VALUES (?, ?, ?, ?, ?, ?)`,
+			string(game.ID), action.Night, int64(action.Actor), action.Ability, string(targets), pos); err != nil {
+			return fmt.Errorf("failed to save night action of user %d in game %s: %w", action.Actor, game.ID, err)
+		}
+	}
+	return nil
+}
+
+// loadNightActions reads the night actions of a game in the order they were first submitted.
+func loadNightActions(q queryer, id gameEntity.GameID) ([]gameEntity.NightAction, error) {
+	rows, err := q.Query(`SELECT night, actor_id, ability, targets FROM game_night_actions WHERE game_id = ? ORDER BY position`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load night actions of game %s: %w", id, err)
+	}
+	defer rows.Close()
+	var actions []gameEntity.NightAction
+	for rows.Next() {
+		var action gameEntity.NightAction
+		var actor int64
+		var targets string
+		if err := rows.Scan(&action.Night, &actor, &action.Ability, &targets); err != nil {
+			return nil, fmt.Errorf("failed to scan night action of game %s: %w", id, err)
+		}
+		action.Actor = sharedEntity.UserID(actor)
+		if err := json.Unmarshal([]byte(targets), &action.Targets); err != nil {
+			return nil, fmt.Errorf("failed to decode night action targets of game %s: %w", id, err)
+		}
+		actions = append(actions, action)
+	}
+	return actions, rows.Err()
+}
+
+// saveTimer replaces the stored timer of a game.
+func saveTimer(q queryer, game *gameEntity.Game) error {
+	if _, err := q.Exec(`DELETE FROM game_timers WHERE game_id = ?`, string(game.ID)); err != nil {
+		return fmt.Errorf("failed to reset timer of game %s: %w", game.ID, err)
+	}
+	timer := game.Timer
+	if timer == nil {
+		return nil
+	}
+	if _, err := q.Exec(`
+		INSERT INTO game_timers (game_id, phase_type, phase_number, duration_seconds, deadline, auto_advance, warned_seconds, expired)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		string(game.ID), string(timer.Phase.Type), timer.Phase.Number, int64(timer.Duration/time.Second),
+		timer.Deadline, timer.AutoAdvance, int64(timer.Warned/time.Second), timer.Expired); err != nil {
+		return fmt.Errorf("failed to save timer of game %s: %w", game.ID, err)
+	}
+	return nil
+}
+
+// loadTimer reads the timer of a game, or nil if its phase is not timed.
+func loadTimer(q queryer, id gameEntity.GameID) (*gameEntity.PhaseTimer, error) {
+	timer := &gameEntity.PhaseTimer{}
+	var phaseType string
+	var durationSeconds, warnedSeconds int64
+	err := q.QueryRow(`
+		SELECT phase_type, phase_number, duration_seconds, deadline, auto_advance, warned_seconds, expired
+		FROM game_timers WHERE game_id = ?`, string(id)).
+		Scan(&phaseType, &timer.Phase.Number, &durationSeconds, &timer.Deadline, &timer.AutoAdvance, &warnedSeconds, &timer.Expired)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load timer of game %s: %w", id, err)
+	}
+	timer.Phase.Type = gameEntity.PhaseType(phaseType)
+	timer.Duration = time.Duration(durationSeconds) * time.Second
+	timer.Warned = time.Duration(warnedSeconds) * time.Second
+	return timer, nil
+}
+
+// saveSpeakingTurns replaces the stored speaking turns of a game.
+func saveSpeakingTurns(q queryer, game *gameEntity.Game) error {
+	if _, err := q.Exec(`DELETE FROM game_speaking_turns WHERE game_id = ?`, string(game.ID)); err != nil {
+		return fmt.Errorf("failed to reset speaking turns of game %s: %w", game.ID, err)
+	}
+	turns := game.Turns
+	if turns == nil {
+		return nil
+	}
+	var encoded [3][]byte
+	for i, ids := range [][]sharedEntity.UserID{turns.Order, turns.Skipped, turns.Requests} {
+		data, err := json.Marshal(ids)
+		if err != nil {
+			return fmt.Errorf("failed to encode speaking turns of game %s: %w", game.ID, err)
+		}
+		encoded[i] = data
+	}
+	if _, err := q.Exec(`
+		INSERT INTO game_speaking_turns (game_id, phase_type, phase_number, speaker_order, current_index, skipped, requests, challenger_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		string(game.ID), string(turns.Phase.Type), turns.Phase.Number, string(encoded[0]), turns.Current,
+		string(encoded[1]), string(encoded[2]), int64(turns.Challenger)); err != nil {
+		return fmt.Errorf("failed to save speaking turns of game %s: %w", game.ID, err)
+	}
+	return nil
+}
+
+// loadSpeakingTurns reads the speaking turns of a game, or nil if none were started.
+func loadSpeakingTurns(q queryer, id gameEntity.GameID) (*gameEntity.SpeakingTurns, error) {
+	turns := &gameEntity.SpeakingTurns{}
+	var phaseType, order, skipped, requests string
+	var challenger int64
+	err := q.QueryRow(`
+		SELECT phase_type, phase_number, speaker_order, current_index, skipped, requests, challenger_id
+		FROM game_speaking_turns WHERE game_id = ?`, string(id)).
+		Scan(&phaseType, &turns.Phase.Number, &order, &turns.Current, &skipped, &requests, &challenger)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load speaking turns of game %s: %w", id, err)
+	}
+	turns.Phase.Type = gameEntity.PhaseType(phaseType)
+	turns.Challenger = sharedEntity.UserID(challenger)
+	for _, field := range []struct {
+		data string
+		ids  *[]sharedEntity.UserID
+	}{{order, &turns.Order}, {skipped, &turns.Skipped}, {requests, &turns.Requests}} {
+		if err := json.Unmarshal([]byte(field.data), field.ids); err != nil {
+			return nil, fmt.Errorf("failed to decode speaking turns of game %s: %w", id, err)
+		}
+		if len(*field.ids) == 0 {
+			*field.ids = nil
+		}
+	}
+	return turns, nil
+}
+
+// saveReadyCheck replaces the stored ready-check of a game.
+func saveReadyCheck(q queryer, game *gameEntity.Game) error {
+	if _, err := q.Exec(`DELETE FROM game_ready_checks WHERE game_id = ?`, string(game.ID)); err != nil {
+		return fmt.Errorf("failed to reset ready-check of game %s: %w", game.ID, err)
+	}
+	check := game.ReadyCheck
+	if check == nil {
+		return nil
+	}
+	players, err := json.Marshal(check.Players)
+	if err != nil {
+		return fmt.Errorf("failed to encode ready-check players of game %s: %w", game.ID, err)
+	}
+	ready, err := json.Marshal(check.Ready)
+	if err != nil {
+		return fmt.Errorf("failed to encode ready-check confirmations of game %s: %w", game.ID, err)
+	}
+	deadline := sql.NullTime{Time: check.Deadline, Valid: !check.Deadline.IsZero()}
+	if _, err := q.Exec(`
+		INSERT INTO game_ready_checks (game_id, players, ready, deadline, timed_out) VALUES (?, ?, ?, ?, ?)`,
+		string(game.ID), string(players), string(ready), deadline, check.TimedOut); err != nil {
+		return fmt.Errorf("failed to save ready-check of game %s: %w", game.ID, err)
+	}
+	return nil
+}
+
+// loadReadyCheck reads the ready-check of a game, or nil if none was started.
+func loadReadyCheck(q queryer, id gameEntity.GameID) (*gameEntity.ReadyCheck, error) {
+	check := &gameEntity.ReadyCheck{}
+	var players, ready string
+	var deadline sql.NullTime
+	err := q.QueryRow(`SELECT players, ready, deadline, timed_out FROM game_ready_checks WHERE game_id = ?`, string(id)).
+		Scan(&players, &ready, &deadline, &check.TimedOut)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ready-check of game %s: %w", id, err)
+	}
+	if deadline.Valid {
+		check.Deadline = deadline.Time
+	}
+	for _, field := range []struct {
+		data string
+		ids  *[]sharedEntity.UserID
+	}{{players, &check.Players}, {ready, &check.Ready}} {
+		if err := json.Unmarshal([]byte(field.data), field.ids); err != nil {
+			return nil, fmt.Errorf("failed to decode ready-check of game %s: %w", id, err)
+		}
+		if len(*field.ids) == 0 {
+			*field.ids = nil
+		}
+	}
+	return check, nil
+}
+
+// saveVote replaces the stored vote of a game and its ballots.
+func saveVote(q queryer, game *gameEntity.Game) error {
+	if _, err := q.Exec(`DELETE FROM game_votes WHERE game_id = ?`, string(game.ID)); err != nil {
+		return fmt.Errorf("failed to reset vote of game %s: %w", game.ID, err)
+	}
+	vote := game.Vote
+	if vote == nil {
+		return nil
+	}
+	candidates, err := json.Marshal(vote.Candidates)
+	if err != nil {
+		return fmt.Errorf("failed to encode vote candidates of game %s: %w", game.ID, err)
+	}
+	leaders, err := json.Marshal(vote.Leaders)
+	if err != nil {
+		return fmt.Errorf("failed to encode vote leaders of game %s: %w", game.ID, err)
+	}
+	if _, err := q.Exec(`
+		INSERT INTO game_votes (game_id, day, round, candidates, duration_seconds, opened_at, closes_at, closed, outcome, leaders)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		string(game.ID), vote.Day, vote.Round, string(candidates), int64(vote.Duration/time.Second),
+		vote.OpenedAt, vote.ClosesAt, vote.Closed, string(vote.Outcome), string(leaders)); err != nil {
+		return fmt.Errorf("failed to save vote of game %s: %w", game.ID, err)
+	}
+	for voter, target := range vote.Ballots {
+		if _, err := q.Exec(`INSERT INTO game_ballots (game_id, voter_id, target_id) VALUES (?, ?, ?)`,
+			string(game.ID), int64(voter), int64(target)); err != nil {
+			return fmt.Errorf("failed to save ballot of user %d in game %s: %w", voter, game.ID, err)
+		}
+	}
+	return nil
+}
+
+// loadVote reads the vote of a game, or nil if no vote was opened yet.
+func loadVote(q queryer, id gameEntity.GameID) (*gameEntity.Vote, error) {
+	vote := &gameEntity.Vote{Ballots: make(map[sharedEntity.UserID]sharedEntity.UserID)}
+	var candidates, outcome, leaders string
+	var durationSeconds int64
+	err := q.QueryRow(`
+		SELECT day, round, candidates, duration_seconds, opened_at, closes_at, closed, outcome, leaders
+		FROM game_votes WHERE game_id = ?`, string(id)).
+		Scan(&vote.Day, &vote.Round, &candidates, &durationSeconds, &vote.OpenedAt, &vote.ClosesAt, &vote.Closed, &outcome, &leaders)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vote of game %s: %w", id, err)
+	}
+	vote.Duration = time.Duration(durationSeconds) * time.Second
+	vote.Outcome = gameEntity.VoteOutcome(outcome)
+	if err := json.Unmarshal([]byte(candidates), &vote.Candidates); err != nil {
+		return nil, fmt.Errorf("failed to decode vote candidates of game %s: %w", id, err)
+	}
+	if err := json.Unmarshal([]byte(leaders), &vote.Leaders); err != nil {
+		return nil, fmt.Errorf("failed to decode vote leaders of game %s: %w", id, err)
+	}
+
+	rows, err := q.Query(`SELECT voter_id, target_id FROM game_ballots WHERE game_id = ?`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ballots of game %s: %w", id, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var voter, target int64
+		if err := rows.Scan(&voter, &target); err != nil {
+			return nil, fmt.Errorf("failed to scan ballot of game %s: %w", id, err)
+		}
+		vote.Ballots[sharedEntity.UserID(voter)] = sharedEntity.UserID(target)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate ballots of game %s: %w", id, err)
+	}
+	return vote, nil
+}
+
+// loadGame reads a game together with its room, scenario and assignments.
+// The scenario comes from the copy stored with the game; games saved before that copy existed
+// read the scenarios table instead. A room deleted after the game was created, or a missing
+// scenario of such an older game, is replaced by a stub holding only its ID.
+func loadGame(q queryer, id gameEntity.GameID) (*gameEntity.Game, error) {
+	game := &gameEntity.Game{ID: id, Assignments: make(map[sharedEntity.UserID]scenarioEntity.Role)}
+	var roomID, scenarioData, state, phaseType, winners, seats string
+	var scenarioID sql.NullString
+	var phaseStartedAt sql.NullTime
+	err := q.QueryRow(`SELECT room_id, scenario_id, scenario_data, state, phase_type, phase_number, phase_started_at, resolved_night, winners, seats FROM games WHERE id = ?`, string(id)).
+		Scan(&roomID, &scenarioID, &scenarioData, &state, &phaseType, &game.Phase.Number, &phaseStartedAt, &game.ResolvedNight, &winners, &seats)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("game with ID %s not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game %s: %w", id, err)
+	}
+	game.State = gameEntity.GameState(state)
+	game.Phase.Type = gameEntity.PhaseType(phaseType)
+	if phaseStartedAt.Valid {
+		game.Phase.StartedAt = phaseStartedAt.Time
+	}
+	if err := json.Unmarshal([]byte(winners), &game.Winners); err != nil {
+		return nil, fmt.Errorf("failed to decode winners of game %s: %w", id, err)
+	}
+	if len(game.Winners) == 0 {
+		game.Winners = nil
+	}
+	if err := json.Unmarshal([]byte(seats), &game.Seats); err != nil {
+		return nil, fmt.Errorf("failed to decode seats of game %s: %w", id, err)
+	}
+	if len(game.Seats) == 0 {
+		game.Seats = nil
+	}
+
+	rows, err := q.Query(`
+		SELECT user_id, role_data, alive, death_cause, death_phase_type, death_phase_number, revealed
+		FROM game_assignments WHERE game_id = ?`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load assignments of game %s: %w", id, err)
+	}
+	for rows.Next() {
+		var userID int64
+		var data, cause, phaseType string
+		var status gameEntity.PlayerStatus
+		if err := rows.Scan(&userID, &data, &status.Alive, &cause, &phaseType, &status.DiedIn.Number, &status.Revealed); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan assignment of game %s: %w", id, err)
+		}
+		var role scenarioEntity.Role
+		if err := json.Unmarshal([]byte(data), &role); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to decode assignment of game %s: %w", id, err)
+		}
+		game.Assignments[sharedEntity.UserID(userID)] = role
+		if !status.Alive {
+			status.Cause = gameEntity.DeathCause(cause)
+			status.DiedIn.Type = gameEntity.PhaseType(phaseType)
+			if game.Statuses == nil {
+				game.Statuses = make(map[sharedEntity.UserID]gameEntity.PlayerStatus)
+			}
+			game.Statuses[sharedEntity.UserID(userID)] = status
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate assignments of game %s: %w", id, err)
+	}
+	if game.Timer, err = loadTimer(q, id); err != nil {
+		return nil, err
+	}
+	if game.Turns, err = loadSpeakingTurns(q, id); err != nil {
+		return nil, err
+	}
+	if game.Vote, err = loadVote(q, id); err != nil {
+		return nil, err
+	}
+	if game.NightActions, err = loadNightActions(q, id); err != nil {
+		return nil, err
+	}
+	if game.ReadyCheck, err = loadReadyCheck(q, id); err != nil {
+		return nil, err
+	}
+
+	game.Room, err = loadRoom(q, roomEntity.RoomID(roomID))
+	if errors.Is(err, roomEntity.ErrRoomNotFound) {
+		log.Printf("Warning: room '%s' of game '%s' no longer exists", roomID, id)
+		game.Room = &roomEntity.Room{ID: roomEntity.RoomID(roomID), Description: make(map[string]string)}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if scenarioData != "" {
+		game.Scenario = &scenarioEntity.Scenario{}
+		if err := json.Unmarshal([]byte(scenarioData), game.Scenario); err != nil {
+			return nil, fmt.Errorf("failed to decode scenario of game %s: %w", id, err)
+		}
+	} else if scenarioID.Valid {
+		game.Scenario, err = loadScenario(q, scenarioID.String)
+		if err != nil {
+			log.Printf("Warning: could not load scenario '%s' of game '%s': %v", scenarioID.String, id, err)
+			game.Scenario = &scenarioEntity.Scenario{ID: scenarioID.String}
+		}
+	}
+	return game, nil
+}
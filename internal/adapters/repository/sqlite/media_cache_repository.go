@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	mediaEntity "telemafia/internal/domain/media/entity"
+	mediaPort "telemafia/internal/domain/media/port"
+)
+
+// Ensure SQLiteMediaCacheRepository implements the mediaPort.MediaCacheRepository interface.
+var _ mediaPort.MediaCacheRepository = (*SQLiteMediaCacheRepository)(nil)
+
+// SQLiteMediaCacheRepository stores uploaded media in SQLite
+type SQLiteMediaCacheRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteMediaCacheRepository creates a new SQLite media cache
+func NewSQLiteMediaCacheRepository(db *sql.DB) mediaPort.MediaCacheRepository {
+	return &SQLiteMediaCacheRepository{db: db}
+}
+
+// GetCachedMedia gets the upload of a file by a bot
+func (r *SQLiteMediaCacheRepository) GetCachedMedia(botID int64, path string) (*mediaEntity.CachedMedia, error) {
+	media := &mediaEntity.CachedMedia{BotID: botID, Path: path}
+	err := r.db.QueryRow(`SELECT checksum, file_id FROM media_cache WHERE bot_id = ? AND path = ?`, botID, path).
+		Scan(&media.Checksum, &media.FileID)
+	if err == sql.ErrNoRows {
+		return nil, mediaEntity.ErrMediaNotCached
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cached media '%s': %w", path, err)
+	}
+	return media, nil
+}
+
+// SaveCachedMedia creates or replaces the upload of a file by a bot
+func (r *SQLiteMediaCacheRepository) SaveCachedMedia(media *mediaEntity.CachedMedia) error {
+	_, err := r.db.Exec(`
+		INSERT INTO media_cache (bot_id, path, checksum, file_id) VALUES (?, ?, ?, ?)
+		ON CONFLICT(bot_id, path) DO UPDATE SET
+			checksum = excluded.checksum,
+			file_id = excluded.file_id`,
+		media.BotID, media.Path, media.Checksum, media.FileID)
+	if err != nil {
+		return fmt.Errorf("failed to save cached media '%s': %w", media.Path, err)
+	}
+	return nil
+}
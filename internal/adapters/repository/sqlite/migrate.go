@@ -0,0 +1,253 @@
+package sqlite
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migrationFiles holds the versioned schema migrations compiled into the binary.
+// Files are named <version>_<name>.up.sql / <version>_<name>.down.sql, e.g. 0001_initial.up.sql.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// ErrSchemaTooNew is returned when the database was migrated by a newer binary.
+var ErrSchemaTooNew = errors.New("database schema is newer than this binary supports")
+
+// Migration is one versioned schema change with its up and down scripts.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus describes a known migration and whether it has been applied.
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Migrator applies and reverts the embedded migrations, tracking them in schema_migrations.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for the embedded migrations
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Latest returns the highest migration version known to this binary.
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the highest migration version applied to the database (0 if none).
+func (m *Migrator) Version() (int, error) {
+	if err := m.ensureTable(); err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	if err := m.db.QueryRow(`SELECT MAX(version) FROM schema_migrations`).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return int(version.Int64), nil
+}
+
+// CheckCompatible returns ErrSchemaTooNew if the database is ahead of this binary.
+func (m *Migrator) CheckCompatible() error {
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version > m.Latest() {
+		return fmt.Errorf("%w: database is at version %d, binary supports up to %d", ErrSchemaTooNew, version, m.Latest())
+	}
+	return nil
+}
+
+// Up applies all pending migrations in order and returns the versions applied.
+func (m *Migrator) Up() ([]int, error) {
+	if err := m.CheckCompatible(); err != nil {
+		return nil, err
+	}
+	applied, err := m.appliedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []int
+	for _, mig := range m.migrations {
+		if _, ok := applied[mig.Version]; ok {
+			continue
+		}
+		err := withTx(m.db, func(tx *sql.Tx) error {
+			if _, err := tx.Exec(mig.Up); err != nil {
+				return fmt.Errorf("migration %04d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			_, err := tx.Exec(`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+				mig.Version, mig.Name, time.Now())
+			return err
+		})
+		if err != nil {
+			return done, err
+		}
+		done = append(done, mig.Version)
+	}
+	return done, nil
+}
+
+// Down reverts the given number of most recently applied migrations and returns the versions reverted.
+func (m *Migrator) Down(steps int) ([]int, error) {
+	if err := m.CheckCompatible(); err != nil {
+		return nil, err
+	}
+	applied, err := m.appliedVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	var done []int
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		mig := m.migrations[i]
+		if _, ok := applied[mig.Version]; !ok {
+			continue
+		}
+		err := withTx(m.db, func(tx *sql.Tx) error {
+			if _, err := tx.Exec(mig.Down); err != nil {
+				return fmt.Errorf("reverting migration %04d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			_, err := tx.Exec(`DELETE FROM schema_migrations WHERE version = ?`, mig.Version)
+			return err
+		})
+		if err != nil {
+			return done, err
+		}
+		done = append(done, mig.Version)
+	}
+	return done, nil
+}
+
+// Status lists every embedded migration with its applied state.
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	applied, err := m.appliedVersions()
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		at, ok := applied[mig.Version]
+		statuses = append(statuses, MigrationStatus{Migration: mig, Applied: ok, AppliedAt: at})
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) ensureTable() error {
+	_, err := m.db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			name       TEXT NOT NULL,
+			applied_at TIMESTAMP NOT NULL
+		)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+	return nil
+}
+
+func (m *Migrator) appliedVersions() (map[int]time.Time, error) {
+	if err := m.ensureTable(); err != nil {
+		return nil, err
+	}
+	rows, err := m.db.Query(`SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, fmt.Errorf("failed to scan applied migration: %w", err)
+		}
+		applied[version] = at
+	}
+	return applied, rows.Err()
+}
+
+// loadMigrations pairs the up/down files found in fsys and sorts them by version.
+func loadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(fileName, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(fileName, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name '%s': expected <version>_<name>.%s.sql", fileName, direction)
+		}
+		version, err := strconv.Atoi(versionStr)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in '%s'", fileName)
+		}
+		content, err := fs.ReadFile(fsys, "migrations/"+fileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration '%s': %w", fileName, err)
+		}
+
+		mig, exists := byVersion[version]
+		if !exists {
+			mig = &Migration{Version: version, Name: name}
+			byVersion[version] = mig
+		} else if mig.Name != name {
+			return nil, fmt.Errorf("migration version %d used by both '%s' and '%s'", version, mig.Name, name)
+		}
+		if direction == "up" {
+			mig.Up = string(content)
+		} else {
+			mig.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" || mig.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s must have both an up and a down file", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS game_assignments;
+DROP INDEX IF EXISTS idx_games_room_id;
+DROP TABLE IF EXISTS games;
+DROP TABLE IF EXISTS scenario_roles;
+DROP TABLE IF EXISTS scenario_sides;
+DROP TABLE IF EXISTS scenarios;
+DROP TABLE IF EXISTS room_descriptions;
+DROP TABLE IF EXISTS room_players;
+DROP TABLE IF EXISTS rooms;
+DROP TABLE IF EXISTS users;
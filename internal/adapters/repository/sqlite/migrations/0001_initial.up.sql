@@ -0,0 +1,73 @@
+-- Initial schema: rooms, scenarios, games and the users they reference.
+-- A scenario's default role for a side is stored in scenario_roles with position -1.
+-- Roles keep their full JSON in role_data so new role fields need no schema change.
+CREATE TABLE IF NOT EXISTS users (
+    id         INTEGER PRIMARY KEY,
+    first_name TEXT NOT NULL DEFAULT '',
+    last_name  TEXT NOT NULL DEFAULT '',
+    username   TEXT NOT NULL DEFAULT '',
+    admin      INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS rooms (
+    id            TEXT PRIMARY KEY,
+    name          TEXT NOT NULL,
+    created_at    TIMESTAMP NOT NULL,
+    scenario_name TEXT NOT NULL DEFAULT '',
+    moderator_id  INTEGER REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS room_players (
+    room_id  TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id  INTEGER NOT NULL REFERENCES users(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (room_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS room_descriptions (
+    room_id TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    name    TEXT NOT NULL,
+    text    TEXT NOT NULL,
+    PRIMARY KEY (room_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS scenarios (
+    id   TEXT PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS scenario_sides (
+    scenario_id     TEXT NOT NULL REFERENCES scenarios(id) ON DELETE CASCADE,
+    position        INTEGER NOT NULL,
+    name            TEXT NOT NULL,
+    population_rate REAL,
+    PRIMARY KEY (scenario_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS scenario_roles (
+    scenario_id   TEXT NOT NULL,
+    side_position INTEGER NOT NULL,
+    position      INTEGER NOT NULL,
+    name          TEXT NOT NULL,
+    role_data     TEXT NOT NULL,
+    PRIMARY KEY (scenario_id, side_position, position),
+    FOREIGN KEY (scenario_id, side_position) REFERENCES scenario_sides(scenario_id, position) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS games (
+    id          TEXT PRIMARY KEY,
+    room_id     TEXT NOT NULL,
+    scenario_id TEXT,
+    state       TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_games_room_id ON games(room_id);
+
+CREATE TABLE IF NOT EXISTS game_assignments (
+    game_id   TEXT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    user_id   INTEGER NOT NULL,
+    role_name TEXT NOT NULL,
+    role_side TEXT NOT NULL DEFAULT '',
+    role_data TEXT NOT NULL,
+    PRIMARY KEY (game_id, user_id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS role_selection_messages;
+DROP TABLE IF EXISTS role_selection_choices;
+DROP TABLE IF EXISTS role_selections;
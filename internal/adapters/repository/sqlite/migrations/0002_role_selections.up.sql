@@ -0,0 +1,21 @@
+-- Interactive "Choose Card" rounds, so they can be resumed after a restart.
+CREATE TABLE role_selections (
+    game_id        TEXT PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    shuffled_roles TEXT NOT NULL
+);
+
+CREATE TABLE role_selection_choices (
+    game_id      TEXT NOT NULL REFERENCES role_selections(game_id) ON DELETE CASCADE,
+    user_id      INTEGER NOT NULL REFERENCES users(id),
+    chosen_index INTEGER NOT NULL,
+    PRIMARY KEY (game_id, user_id),
+    UNIQUE (game_id, chosen_index)
+);
+
+CREATE TABLE role_selection_messages (
+    game_id    TEXT NOT NULL REFERENCES role_selections(game_id) ON DELETE CASCADE,
+    audience   TEXT NOT NULL,
+    chat_id    INTEGER NOT NULL,
+    message_id INTEGER NOT NULL,
+    PRIMARY KEY (game_id, audience, chat_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE games DROP COLUMN phase_started_at;
+ALTER TABLE games DROP COLUMN phase_number;
+ALTER TABLE games DROP COLUMN phase_type;
@@ -0,0 +1,4 @@
+-- Day/night cycle position of each game.
+ALTER TABLE games ADD COLUMN phase_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE games ADD COLUMN phase_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE games ADD COLUMN phase_started_at TIMESTAMP;
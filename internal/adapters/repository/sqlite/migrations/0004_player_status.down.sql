@@ -0,0 +1,6 @@
+ALTER TABLE game_assignments DROP COLUMN revealed;
+ALTER TABLE game_assignments DROP COLUMN death_phase_number;
+ALTER TABLE game_assignments DROP COLUMN death_phase_type;
+ALTER TABLE game_assignments DROP COLUMN death_cause;
+ALTER TABLE game_assignments DROP COLUMN alive;
+ALTER TABLE scenarios DROP COLUMN death_reveal;
@@ -0,0 +1,7 @@
+-- What the room learns when a player dies, and the life status of each player in a game.
+ALTER TABLE scenarios ADD COLUMN death_reveal TEXT NOT NULL DEFAULT '';
+ALTER TABLE game_assignments ADD COLUMN alive INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE game_assignments ADD COLUMN death_cause TEXT NOT NULL DEFAULT '';
+ALTER TABLE game_assignments ADD COLUMN death_phase_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE game_assignments ADD COLUMN death_phase_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE game_assignments ADD COLUMN revealed INTEGER NOT NULL DEFAULT 0;
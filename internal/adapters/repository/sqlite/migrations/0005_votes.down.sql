@@ -0,0 +1,3 @@
+DROP TABLE game_ballots;
+DROP TABLE game_votes;
+ALTER TABLE scenarios DROP COLUMN vote_tie;
@@ -0,0 +1,22 @@
+-- Day votes: the tie rule of each scenario, the current or last vote of each game and its ballots.
+ALTER TABLE scenarios ADD COLUMN vote_tie TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE game_votes (
+    game_id          TEXT PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    day              INTEGER NOT NULL,
+    round            INTEGER NOT NULL,
+    candidates       TEXT NOT NULL,
+    duration_seconds INTEGER NOT NULL,
+    opened_at        TIMESTAMP NOT NULL,
+    closes_at        TIMESTAMP NOT NULL,
+    closed           INTEGER NOT NULL DEFAULT 0,
+    outcome          TEXT NOT NULL DEFAULT '',
+    leaders          TEXT NOT NULL DEFAULT '[]'
+);
+
+CREATE TABLE game_ballots (
+    game_id   TEXT NOT NULL REFERENCES game_votes(game_id) ON DELETE CASCADE,
+    voter_id  INTEGER NOT NULL,
+    target_id INTEGER NOT NULL,
+    PRIMARY KEY (game_id, voter_id)
+);
@@ -0,0 +1,10 @@
+-- Abilities used by role holders during the nights of a game.
+CREATE TABLE game_night_actions (
+    game_id  TEXT NOT NULL REFERENCES games(id) ON DELETE CASCADE,
+    night    INTEGER NOT NULL,
+    actor_id INTEGER NOT NULL,
+    ability  TEXT NOT NULL,
+    targets  TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (game_id, night, actor_id, ability)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE games DROP COLUMN resolved_night;
+ALTER TABLE scenario_sides DROP COLUMN investigated_as;
+ALTER TABLE scenarios DROP COLUMN action_priority;
@@ -0,0 +1,5 @@
+-- Night resolution: the effect order of each scenario, investigation results of sides
+-- and the last night each game resolved.
+ALTER TABLE scenarios ADD COLUMN action_priority TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE scenario_sides ADD COLUMN investigated_as TEXT NOT NULL DEFAULT '';
+ALTER TABLE games ADD COLUMN resolved_night INTEGER NOT NULL DEFAULT 0;
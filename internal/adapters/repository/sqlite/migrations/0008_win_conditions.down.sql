@@ -0,0 +1,2 @@
+ALTER TABLE games DROP COLUMN winners;
+ALTER TABLE scenario_sides DROP COLUMN win_conditions;
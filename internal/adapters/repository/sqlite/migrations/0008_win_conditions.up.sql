@@ -0,0 +1,3 @@
+-- Win conditions of scenario sides and the winners of finished games.
+ALTER TABLE scenario_sides ADD COLUMN win_conditions TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE games ADD COLUMN winners TEXT NOT NULL DEFAULT '[]';
@@ -0,0 +1,11 @@
+-- Countdown of the current phase of each game. Only the deadline is stored, so timers survive restarts.
+CREATE TABLE game_timers (
+    game_id          TEXT PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    phase_type       TEXT NOT NULL,
+    phase_number     INTEGER NOT NULL,
+    duration_seconds INTEGER NOT NULL,
+    deadline         TIMESTAMP NOT NULL,
+    auto_advance     INTEGER NOT NULL DEFAULT 0,
+    warned_seconds   INTEGER NOT NULL DEFAULT 0,
+    expired          INTEGER NOT NULL DEFAULT 0
+);
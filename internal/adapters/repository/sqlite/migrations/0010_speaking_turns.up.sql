@@ -0,0 +1,11 @@
+-- Talk order of the current day or defense of each game.
+CREATE TABLE game_speaking_turns (
+    game_id       TEXT PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    phase_type    TEXT NOT NULL,
+    phase_number  INTEGER NOT NULL,
+    speaker_order TEXT NOT NULL DEFAULT '[]',
+    current_index INTEGER NOT NULL DEFAULT 0,
+    skipped       TEXT NOT NULL DEFAULT '[]',
+    requests      TEXT NOT NULL DEFAULT '[]',
+    challenger_id INTEGER NOT NULL DEFAULT 0
+);
@@ -0,0 +1,2 @@
+-- Players of each game in seat order, taken from the room when roles are assigned.
+ALTER TABLE games ADD COLUMN seats TEXT NOT NULL DEFAULT '[]';
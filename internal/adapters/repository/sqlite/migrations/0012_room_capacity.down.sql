@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS room_waitlist;
+ALTER TABLE rooms DROP COLUMN max_players;
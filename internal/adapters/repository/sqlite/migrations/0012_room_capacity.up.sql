@@ -0,0 +1,9 @@
+-- Optional seat limit of a room (0 for none) and the players waiting for a free seat.
+ALTER TABLE rooms ADD COLUMN max_players INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS room_waitlist (
+    room_id  TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id  INTEGER NOT NULL REFERENCES users(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (room_id, user_id)
+);
@@ -0,0 +1,8 @@
+-- Ready-check of each game waiting for its players. Only the deadline is stored, so timed checks survive restarts.
+CREATE TABLE game_ready_checks (
+    game_id   TEXT PRIMARY KEY REFERENCES games(id) ON DELETE CASCADE,
+    players   TEXT NOT NULL DEFAULT '[]',
+    ready     TEXT NOT NULL DEFAULT '[]',
+    deadline  TIMESTAMP,
+    timed_out INTEGER NOT NULL DEFAULT 0
+);
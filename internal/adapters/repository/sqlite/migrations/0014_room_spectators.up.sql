@@ -0,0 +1,7 @@
+-- Users following the public announcements of a room without playing.
+CREATE TABLE IF NOT EXISTS room_spectators (
+    room_id  TEXT NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    user_id  INTEGER NOT NULL REFERENCES users(id),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (room_id, user_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE rooms DROP COLUMN invite_code;
+ALTER TABLE rooms DROP COLUMN password;
+ALTER TABLE rooms DROP COLUMN visibility;
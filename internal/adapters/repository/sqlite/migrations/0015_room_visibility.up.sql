@@ -0,0 +1,4 @@
+-- Who can find and join a room, its password and the secret part of its invite link.
+ALTER TABLE rooms ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public';
+ALTER TABLE rooms ADD COLUMN password TEXT NOT NULL DEFAULT '';
+ALTER TABLE rooms ADD COLUMN invite_code TEXT NOT NULL DEFAULT '';
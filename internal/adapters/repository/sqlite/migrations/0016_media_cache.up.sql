@@ -0,0 +1,8 @@
+-- Telegram files that local media (such as role card images) were uploaded as, per bot.
+CREATE TABLE IF NOT EXISTS media_cache (
+    bot_id   INTEGER NOT NULL,
+    path     TEXT NOT NULL,
+    checksum TEXT NOT NULL,
+    file_id  TEXT NOT NULL,
+    PRIMARY KEY (bot_id, path)
+);
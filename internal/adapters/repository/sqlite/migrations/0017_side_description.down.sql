@@ -0,0 +1 @@
+ALTER TABLE scenario_sides DROP COLUMN description;
@@ -0,0 +1,2 @@
+-- The goal of a side and how it plays, shown to players with its roles.
+ALTER TABLE scenario_sides ADD COLUMN description TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE scenarios DROP COLUMN max_players;
+ALTER TABLE scenarios DROP COLUMN min_players;
@@ -0,0 +1,3 @@
+-- Player counts a scenario declares it supports; 0 means the bound is computed from its roles.
+ALTER TABLE scenarios ADD COLUMN min_players INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE scenarios ADD COLUMN max_players INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+ALTER TABLE rooms ADD COLUMN password TEXT NOT NULL DEFAULT '';
+UPDATE rooms SET visibility = 'unlisted' WHERE visibility = 'password';
+ALTER TABLE rooms DROP COLUMN password_hash;
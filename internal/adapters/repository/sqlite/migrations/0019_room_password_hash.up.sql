@@ -0,0 +1,5 @@
+-- Password rooms keep a salted hash of their password instead of the password itself. Stored plaintext
+-- passwords cannot be hashed here, so those rooms fall back to their invite link until a new password is set.
+ALTER TABLE rooms ADD COLUMN password_hash TEXT NOT NULL DEFAULT '';
+UPDATE rooms SET visibility = 'unlisted' WHERE visibility = 'password';
+ALTER TABLE rooms DROP COLUMN password;
@@ -0,0 +1,2 @@
+-- Copy of the scenario a game is played with, so edits or deletes of the scenario do not reach running games.
+ALTER TABLE games ADD COLUMN scenario_data TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE scenarios DROP COLUMN seed_checksum;
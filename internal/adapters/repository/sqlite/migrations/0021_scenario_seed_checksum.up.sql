@@ -0,0 +1,2 @@
+-- Checksum of the bundled file a scenario was last seeded from; empty for scenarios made or edited in the bot.
+ALTER TABLE scenarios ADD COLUMN seed_checksum TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,170 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// Ensure SQLiteRoleSelectionRepository implements the gamePort.RoleSelectionRepository interface.
+var _ gamePort.RoleSelectionRepository = (*SQLiteRoleSelectionRepository)(nil)
+
+// SQLiteRoleSelectionRepository stores "Choose Card" rounds in SQLite
+type SQLiteRoleSelectionRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteRoleSelectionRepository creates a new SQLite role selection repository
+func NewSQLiteRoleSelectionRepository(db *sql.DB) gamePort.RoleSelectionRepository {
+	return &SQLiteRoleSelectionRepository{db: db}
+}
+
+// GetRoleSelection gets the saved round of a game
+func (r *SQLiteRoleSelectionRepository) GetRoleSelection(gameID gameEntity.GameID) (*gameEntity.RoleSelection, error) {
+	return loadRoleSelection(r.db, gameID)
+}
+
+// GetAllRoleSelections gets every saved round
+func (r *SQLiteRoleSelectionRepository) GetAllRoleSelections() ([]*gameEntity.RoleSelection, error) {
+	rows, err := r.db.Query(`SELECT game_id FROM role_selections`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query role selections: %w", err)
+	}
+	var ids []gameEntity.GameID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan role selection: %w", err)
+		}
+		ids = append(ids, gameEntity.GameID(id))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate role selections: %w", err)
+	}
+
+	selections := make([]*gameEntity.RoleSelection, 0, len(ids))
+	for _, id := range ids {
+		selection, err := loadRoleSelection(r.db, id)
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, selection)
+	}
+	return selections, nil
+}
+
+// SaveRoleSelection creates or replaces the saved round of a game
+func (r *SQLiteRoleSelectionRepository) SaveRoleSelection(selection *gameEntity.RoleSelection) error {
+	roles, err := json.Marshal(selection.ShuffledRoles)
+	if err != nil {
+		return fmt.Errorf("failed to encode roles of game %s: %w", selection.GameID, err)
+	}
+	return withTx(r.db, func(tx *sql.Tx) error {
+		_, err := tx.Exec(`
+			INSERT INTO role_selections (game_id, shuffled_roles) VALUES (?, ?)
+			ON CONFLICT(game_id) DO UPDATE SET shuffled_roles = excluded.shuffled_roles`,
+			string(selection.GameID), string(roles))
+		if err != nil {
+			return fmt.Errorf("failed to save role selection of game %s: %w", selection.GameID, err)
+		}
+
+		if _, err := tx.Exec(`DELETE FROM role_selection_choices WHERE game_id = ?`, string(selection.GameID)); err != nil {
+			return fmt.Errorf("failed to reset choices of game %s: %w", selection.GameID, err)
+		}
+		for userID, choice := range selection.Choices {
+			player := choice.Player
+			player.ID = userID
+			if err := saveUser(tx, &player); err != nil {
+				return err
+			}
+			if _, err := tx.Exec(`INSERT INTO role_selection_choices (game_id, user_id, chosen_index) VALUES (?, ?, ?)`,
+				string(selection.GameID), int64(userID), choice.ChosenIndex); err != nil {
+				return fmt.Errorf("failed to save choice of user %d in game %s: %w", userID, selection.GameID, err)
+			}
+		}
+
+		if _, err := tx.Exec(`DELETE FROM role_selection_messages WHERE game_id = ?`, string(selection.GameID)); err != nil {
+			return fmt.Errorf("failed to reset messages of game %s: %w", selection.GameID, err)
+		}
+		for _, msg := range selection.Messages {
+			if _, err := tx.Exec(`INSERT OR REPLACE INTO role_selection_messages (game_id, audience, chat_id, message_id) VALUES (?, ?, ?, ?)`,
+				string(selection.GameID), string(msg.Audience), msg.ChatID, msg.MessageID); err != nil {
+				return fmt.Errorf("failed to save tracked message of game %s: %w", selection.GameID, err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteRoleSelection removes the saved round of a game; choices and messages are removed by cascade
+func (r *SQLiteRoleSelectionRepository) DeleteRoleSelection(gameID gameEntity.GameID) error {
+	if _, err := r.db.Exec(`DELETE FROM role_selections WHERE game_id = ?`, string(gameID)); err != nil {
+		return fmt.Errorf("failed to delete role selection of game %s: %w", gameID, err)
+	}
+	return nil
+}
+
+func loadRoleSelection(q queryer, gameID gameEntity.GameID) (*gameEntity.RoleSelection, error) {
+	var roles string
+	err := q.QueryRow(`SELECT shuffled_roles FROM role_selections WHERE game_id = ?`, string(gameID)).Scan(&roles)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no role selection found for game %s", gameID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load role selection of game %s: %w", gameID, err)
+	}
+
+	selection := &gameEntity.RoleSelection{
+		GameID:  gameID,
+		Choices: make(map[sharedEntity.UserID]gameEntity.RoleChoice),
+	}
+	var shuffled []scenarioEntity.Role
+	if err := json.Unmarshal([]byte(roles), &shuffled); err != nil {
+		return nil, fmt.Errorf("failed to decode roles of game %s: %w", gameID, err)
+	}
+	selection.ShuffledRoles = shuffled
+
+	choiceRows, err := q.Query(`
+		SELECT u.id, u.first_name, u.last_name, u.username, u.admin, c.chosen_index
+		FROM role_selection_choices c JOIN users u ON u.id = c.user_id
+		WHERE c.game_id = ?`, string(gameID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load choices of game %s: %w", gameID, err)
+	}
+	for choiceRows.Next() {
+		var choice gameEntity.RoleChoice
+		p := &choice.Player
+		if err := choiceRows.Scan(&p.ID, &p.FirstName, &p.LastName, &p.Username, &p.Admin, &choice.ChosenIndex); err != nil {
+			choiceRows.Close()
+			return nil, fmt.Errorf("failed to scan choice of game %s: %w", gameID, err)
+		}
+		selection.Choices[p.ID] = choice
+	}
+	choiceRows.Close()
+	if err := choiceRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate choices of game %s: %w", gameID, err)
+	}
+
+	msgRows, err := q.Query(`SELECT audience, chat_id, message_id FROM role_selection_messages WHERE game_id = ?`, string(gameID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load messages of game %s: %w", gameID, err)
+	}
+	defer msgRows.Close()
+	for msgRows.Next() {
+		var msg gameEntity.RoleSelectionMessage
+		var audience string
+		if err := msgRows.Scan(&audience, &msg.ChatID, &msg.MessageID); err != nil {
+			return nil, fmt.Errorf("failed to scan message of game %s: %w", gameID, err)
+		}
+		msg.Audience = gameEntity.RoleSelectionAudience(audience)
+		selection.Messages = append(selection.Messages, msg)
+	}
+	return selection, msgRows.Err()
+}
@@ -0,0 +1,377 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// Ensure SQLiteRoomRepository implements the roomPort.RoomRepository interface.
+var _ roomPort.RoomRepository = (*SQLiteRoomRepository)(nil)
+
+// SQLiteRoomRepository stores rooms, their players, moderator and descriptions in SQLite
+type SQLiteRoomRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteRoomRepository creates a new SQLite room repository
+func NewSQLiteRoomRepository(db *sql.DB) roomPort.RoomRepository {
+	return &SQLiteRoomRepository{db: db}
+}
+
+// CreateRoom stores a new room
+func (r *SQLiteRoomRepository) CreateRoom(room *roomEntity.Room) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		exists, err := roomExists(tx, room.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("room with ID %s already exists", room.ID)
+		}
+		return saveRoom(tx, room)
+	})
+}
+
+// UpdateRoom replaces the stored state of an existing room
+func (r *SQLiteRoomRepository) UpdateRoom(room *roomEntity.Room) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		exists, err := roomExists(tx, room.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return roomEntity.ErrRoomNotFound
+		}
+		return saveRoom(tx, room)
+	})
+}
+
+// GetRoomByID loads a room with its players, moderator and descriptions
+func (r *SQLiteRoomRepository) GetRoomByID(id roomEntity.RoomID) (*roomEntity.Room, error) {
+	return loadRoom(r.db, id)
+}
+
+// GetRooms returns all rooms ordered by creation time
+func (r *SQLiteRoomRepository) GetRooms() ([]*roomEntity.Room, error) {
+	return r.loadRooms(`SELECT id FROM rooms ORDER BY created_at, id`)
+}
+
+// GetPlayerRooms returns the rooms the player has joined
+func (r *SQLiteRoomRepository) GetPlayerRooms(playerID sharedEntity.UserID) ([]*roomEntity.Room, error) {
+	return r.loadRooms(`
+		SELECT r.id FROM rooms r
+		JOIN room_players p ON p.room_id = r.id
+		WHERE p.user_id = ?
+		ORDER BY r.created_at, r.id`, int64(playerID))
+}
+
+// GetPlayersInRoom returns the players in a specific room
+func (r *SQLiteRoomRepository) GetPlayersInRoom(roomID roomEntity.RoomID) ([]*sharedEntity.User, error) {
+	exists, err := roomExists(r.db, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, roomEntity.ErrRoomNotFound
+	}
+	return loadRoomPlayers(r.db, roomID)
+}
+
+// AddPlayerToRoom appends a player to the room; adding an existing player is a no-op
+func (r *SQLiteRoomRepository) AddPlayerToRoom(roomID roomEntity.RoomID, player *sharedEntity.User) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		exists, err := roomExists(tx, roomID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return roomEntity.ErrRoomNotFound
+		}
+		if err := saveUser(tx, player); err != nil {
+			return err
+		}
+		_, err = tx.Exec(`
+			INSERT INTO room_players (room_id, user_id, position)
+			SELECT ?, ?, COALESCE(MAX(position), -1) + 1 FROM room_players WHERE room_id = ?
+			ON CONFLICT(room_id, user_id) DO NOTHING`,
+			string(roomID), int64(player.ID), string(roomID))
+		if err != nil {
+			return fmt.Errorf("failed to add player %d to room %s: %w", player.ID, roomID, err)
+		}
+		return nil
+	})
+}
+
+// RemovePlayerFromRoom removes a player from the room
+func (r *SQLiteRoomRepository) RemovePlayerFromRoom(roomID roomEntity.RoomID, playerID sharedEntity.UserID) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		exists, err := roomExists(tx, roomID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return roomEntity.ErrRoomNotFound
+		}
+		if _, err := tx.Exec(`DELETE FROM room_players WHERE room_id = ? AND user_id = ?`, string(roomID), int64(playerID)); err != nil {
+			return fmt.Errorf("failed to remove player %d from room %s: %w", playerID, roomID, err)
+		}
+		return nil
+	})
+}
+
+// DeleteRoom deletes a room by ID; players and descriptions are removed by cascade
+func (r *SQLiteRoomRepository) DeleteRoom(roomID roomEntity.RoomID) error {
+	res, err := r.db.Exec(`DELETE FROM rooms WHERE id = ?`, string(roomID))
+	if err != nil {
+		return fmt.Errorf("failed to delete room %s: %w", roomID, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return roomEntity.ErrRoomNotFound
+	}
+	return nil
+}
+
+func (r *SQLiteRoomRepository) loadRooms(query string, args ...interface{}) ([]*roomEntity.Room, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rooms: %w", err)
+	}
+	var ids []roomEntity.RoomID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan room id: %w", err)
+		}
+		ids = append(ids, roomEntity.RoomID(id))
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate rooms: %w", err)
+	}
+
+	rooms := make([]*roomEntity.Room, 0, len(ids))
+	for _, id := range ids {
+		room, err := loadRoom(r.db, id)
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+	return rooms, nil
+}
+
+func roomExists(q queryer, id roomEntity.RoomID) (bool, error) {
+	var n int
+	if err := q.QueryRow(`SELECT COUNT(*) FROM rooms WHERE id = ?`, string(id)).Scan(&n); err != nil {
+		return false, fmt.Errorf("failed to check room %s: %w", id, err)
+	}
+	return n > 0, nil
+}
+
+// saveRoom upserts the room row and rewrites its players, waitlist, spectators and descriptions.
+func saveRoom(q queryer, room *roomEntity.Room) error {
+	var moderatorID sql.NullInt64
+	if room.Moderator != nil {
+		if err := saveUser(q, room.Moderator); err != nil {
+			return err
+		}
+		moderatorID = sql.NullInt64{Int64: int64(room.Moderator.ID), Valid: true}
+	}
+
+	_, err := q.Exec(`
+		INSERT INTO rooms (id, name, created_at, scenario_name, moderator_id, max_players, visibility, password_hash, invite_code)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			name = excluded.name,
+			created_at = excluded.created_at,
+			scenario_name = excluded.scenario_name,
+			moderator_id = excluded.moderator_id,
+			max_players = excluded.max_players,
+			visibility = excluded.visibility,
+			password_hash = excluded.password_hash,
+			invite_code = excluded.invite_code`,
+		string(room.ID), room.Name, room.CreatedAt, room.ScenarioName, moderatorID, room.MaxPlayers,
+		string(room.EffectiveVisibility()), room.PasswordHash, room.InviteCode)
+	if err != nil {
+		return fmt.Errorf("failed to save room %s: %w", room.ID, err)
+	}
+
+	if _, err := q.Exec(`DELETE FROM room_players WHERE room_id = ?`, string(room.ID)); err != nil {
+		return fmt.Errorf("failed to reset players of room %s: %w", room.ID, err)
+	}
+	for i, player := range room.Players {
+		if player == nil {
+			continue
+		}
+		if err := saveUser(q, player); err != nil {
+			return err
+		}
+		if _, err := q.Exec(`INSERT OR IGNORE INTO room_players (room_id, user_id, position) VALUES (?, ?, ?)`,
+			string(room.ID), int64(player.ID), i); err != nil {
+			return fmt.Errorf("failed to save player %d of room %s: %w", player.ID, room.ID, err)
+		}
+	}
+
+	if _, err := q.Exec(`DELETE FROM room_waitlist WHERE room_id = ?`, string(room.ID)); err != nil {
+		return fmt.Errorf("failed to reset waitlist of room %s: %w", room.ID, err)
+	}
+	for i, player := range room.Waitlist {
+		if player == nil {
+			continue
+		}
+		if err := saveUser(q, player); err != nil {
+			return err
+		}
+		if _, err := q.Exec(`INSERT OR IGNORE INTO room_waitlist (room_id, user_id, position) VALUES (?, ?, ?)`,
+			string(room.ID), int64(player.ID), i); err != nil {
+			return fmt.Errorf("failed to save waitlisted player %d of room %s: %w", player.ID, room.ID, err)
+		}
+	}
+
+	if _, err := q.Exec(`DELETE FROM room_spectators WHERE room_id = ?`, string(room.ID)); err != nil {
+		return fmt.Errorf("failed to reset spectators of room %s: %w", room.ID, err)
+	}
+	for i, spectator := range room.Spectators {
+		if spectator == nil {
+			continue
+		}
+		if err := saveUser(q, spectator); err != nil {
+			return err
+		}
+		if _, err := q.Exec(`INSERT OR IGNORE INTO room_spectators (room_id, user_id, position) VALUES (?, ?, ?)`,
+			string(room.ID), int64(spectator.ID), i); err != nil {
+			return fmt.Errorf("failed to save spectator %d of room %s: %w", spectator.ID, room.ID, err)
+		}
+	}
+
+	if _, err := q.Exec(`DELETE FROM room_descriptions WHERE room_id = ?`, string(room.ID)); err != nil {
+		return fmt.Errorf("failed to reset descriptions of room %s: %w", room.ID, err)
+	}
+	for name, text := range room.Description {
+		if _, err := q.Exec(`INSERT INTO room_descriptions (room_id, name, text) VALUES (?, ?, ?)`,
+			string(room.ID), name, text); err != nil {
+			return fmt.Errorf("failed to save description '%s' of room %s: %w", name, room.ID, err)
+		}
+	}
+	return nil
+}
+
+// loadRoom reads a full room aggregate, returning roomEntity.ErrRoomNotFound if missing.
+func loadRoom(q queryer, id roomEntity.RoomID) (*roomEntity.Room, error) {
+	room := &roomEntity.Room{ID: id, Description: make(map[string]string)}
+	var createdAt time.Time
+	var moderatorID sql.NullInt64
+	var visibility string
+	err := q.QueryRow(`SELECT name, created_at, scenario_name, moderator_id, max_players, visibility, password_hash, invite_code FROM rooms WHERE id = ?`, string(id)).
+		Scan(&room.Name, &createdAt, &room.ScenarioName, &moderatorID, &room.MaxPlayers, &visibility, &room.PasswordHash, &room.InviteCode)
+	if err == sql.ErrNoRows {
+		return nil, roomEntity.ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load room %s: %w", id, err)
+	}
+	room.CreatedAt = createdAt
+	room.Visibility = roomEntity.Visibility(visibility)
+
+	if moderatorID.Valid {
+		if room.Moderator, err = loadUser(q, sharedEntity.UserID(moderatorID.Int64)); err != nil {
+			return nil, err
+		}
+	}
+
+	if room.Players, err = loadRoomPlayers(q, id); err != nil {
+		return nil, err
+	}
+	if room.Waitlist, err = loadRoomWaitlist(q, id); err != nil {
+		return nil, err
+	}
+	if room.Spectators, err = loadRoomSpectators(q, id); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.Query(`SELECT name, text FROM room_descriptions WHERE room_id = ?`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load descriptions of room %s: %w", id, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, text string
+		if err := rows.Scan(&name, &text); err != nil {
+			return nil, fmt.Errorf("failed to scan description of room %s: %w", id, err)
+		}
+		room.Description[name] = text
+	}
+	return room, rows.Err()
+}
+
+func loadRoomPlayers(q queryer, id roomEntity.RoomID) ([]*sharedEntity.User, error) {
+	rows, err := q.Query(`
+		SELECT u.id, u.first_name, u.last_name, u.username, u.admin
+		FROM room_players p JOIN users u ON u.id = p.user_id
+		WHERE p.room_id = ?
+		ORDER BY p.position`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load players of room %s: %w", id, err)
+	}
+	defer rows.Close()
+
+	players := make([]*sharedEntity.User, 0)
+	for rows.Next() {
+		user := &sharedEntity.User{}
+		if err := rows.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Username, &user.Admin); err != nil {
+			return nil, fmt.Errorf("failed to scan player of room %s: %w", id, err)
+		}
+		players = append(players, user)
+	}
+	return players, rows.Err()
+}
+
+func loadRoomWaitlist(q queryer, id roomEntity.RoomID) ([]*sharedEntity.User, error) {
+	rows, err := q.Query(`
+		SELECT u.id, u.first_name, u.last_name, u.username, u.admin
+		FROM room_waitlist w JOIN users u ON u.id = w.user_id
+		WHERE w.room_id = ?
+		ORDER BY w.position`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load waitlist of room %s: %w", id, err)
+	}
+	defer rows.Close()
+
+	var waitlist []*sharedEntity.User
+	for rows.Next() {
+		user := &sharedEntity.User{}
+		if err := rows.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Username, &user.Admin); err != nil {
+			return nil, fmt.Errorf("failed to scan waitlisted player of room %s: %w", id, err)
+		}
+		waitlist = append(waitlist, user)
+	}
+	return waitlist, rows.Err()
+}
+
+func loadRoomSpectators(q queryer, id roomEntity.RoomID) ([]*sharedEntity.User, error) {
+	rows, err := q.Query(`
+		SELECT u.id, u.first_name, u.last_name, u.username, u.admin
+		FROM room_spectators s JOIN users u ON u.id = s.user_id
+		WHERE s.room_id = ?
+		ORDER BY s.position`, string(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load spectators of room %s: %w", id, err)
+	}
+	defer rows.Close()
+
+	var spectators []*sharedEntity.User
+	for rows.Next() {
+		user := &sharedEntity.User{}
+		if err := rows.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Username, &user.Admin); err != nil {
+			return nil, fmt.Errorf("failed to scan spectator of room %s: %w", id, err)
+		}
+		spectators = append(spectators, user)
+	}
+	return spectators, rows.Err()
+}
@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+)
+
+// defaultRolePosition marks the row holding a side's default role in scenario_roles.
+const defaultRolePosition = -1
+
+// Ensure SQLiteScenarioRepository implements the scenarioPort.ScenarioRepository interface.
+var _ scenarioPort.ScenarioRepository = (*SQLiteScenarioRepository)(nil)
+
+// SQLiteScenarioRepository stores scenarios with their sides and roles in SQLite
+type SQLiteScenarioRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteScenarioRepository creates a new SQLite scenario repository
+func NewSQLiteScenarioRepository(db *sql.DB) scenarioPort.ScenarioRepository {
+	return &SQLiteScenarioRepository{db: db}
+}
+
+// GetScenarioByID retrieves a scenario by its ID
+func (r *SQLiteScenarioRepository) GetScenarioByID(id string) (*scenarioEntity.Scenario, error) {
+	return loadScenario(r.db, id)
+}
+
+// GetAllScenarios retrieves all scenarios
+func (r *SQLiteScenarioRepository) GetAllScenarios() ([]*scenarioEntity.Scenario, error) {
+	rows, err := r.db.Query(`SELECT id FROM scenarios ORDER BY name, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scenarios: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan scenario id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate scenarios: %w", err)
+	}
+
+	var scenarios []*scenarioEntity.Scenario
+	for _, id := range ids {
+		scenario, err := loadScenario(r.db, id)
+		if err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, scenario)
+	}
+	return scenarios, nil
+}
+
+// CreateScenario adds a new scenario
+func (r *SQLiteScenarioRepository) CreateScenario(scenario *scenarioEntity.Scenario) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		var n int
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM scenarios WHERE id = ?`, scenario.ID).Scan(&n); err != nil {
+			return fmt.Errorf("failed to check scenario %s: %w", scenario.ID, err)
+		}
+		if n > 0 {
+			return fmt.Errorf("scenario with ID %s already exists", scenario.ID)
+		}
+		return saveScenario(tx, scenario)
+	})
+}
+
+// UpdateScenario replaces an existing scenario, rewriting its sides and roles
+func (r *SQLiteScenarioRepository) UpdateScenario(scenario *scenarioEntity.Scenario) error {
+	return withTx(r.db, func(tx *sql.Tx) error {
+		var n int
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM scenarios WHERE id = ?`, scenario.ID).Scan(&n); err != nil {
+			return fmt.Errorf("failed to check scenario %s: %w", scenario.ID, err)
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: cannot update scenario with ID %s", scenarioEntity.ErrScenarioNotFound, scenario.ID)
+		}
+		return saveScenario(tx, scenario)
+	})
+}
+
+// DeleteScenario removes a scenario by its ID; sides and roles are removed by cascade
+func (r *SQLiteScenarioRepository) DeleteScenario(id string) error {
+	res, err := r.db.Exec(`DELETE FROM scenarios WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete scenario %s: %w", id, err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("%w: scenario with ID %s not found for deletion", scenarioEntity.ErrScenarioNotFound, id)
+	}
+	return nil
+}
+
+// saveScenario upserts the scenario row and rewrites its sides and roles.
+func saveScenario(q queryer, scenario *scenarioEntity.Scenario) error {
+	priority, err := json.Marshal(scenario.ActionPriority)
+	if err != nil {
+		return fmt.Errorf("failed to encode action priority of scenario %s: %w", scenario.ID, err)
+	}
+	_, err = q.Exec(`
+		INSERT INTO scenarios (id, name, death_reveal, vote_tie, action_priority, min_players, max_players, seed_checksum) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET name = excluded.name, death_reveal = excluded.death_reveal, vote_tie = excluded.vote_tie,
+			action_priority = excluded.action_priority, min_players = excluded.min_players, max_players = excluded.max_players,
+			seed_checksum = excluded.seed_checksum`,
+		scenario.ID, scenario.Name, string(scenario.DeathReveal), string(scenario.VoteTie), string(priority), scenario.MinPlayers, scenario.MaxPlayers, scenario.SeedChecksum)
+	if err != nil {
+		return fmt.Errorf("failed to save scenario %s: %w", scenario.ID, err)
+	}
+	if _, err := q.Exec(`DELETE FROM scenario_sides WHERE scenario_id = ?`, scenario.ID); err != nil {
+		return fmt.Errorf("failed to reset sides of scenario %s: %w", scenario.ID, err)
+	}
+
+	for sidePos, side := range scenario.Sides {
+		var rate sql.NullFloat64
+		if side.PopulationRate != nil {
+			rate = sql.NullFloat64{Float64: float64(*side.PopulationRate), Valid: true}
+		}
+		conditions, err := json.Marshal(side.WinConditions)
+		if err != nil {
+			return fmt.Errorf("failed to encode win conditions of side '%s': %w", side.Name, err)
+		}
+		if _, err := q.Exec(`INSERT INTO scenario_sides (scenario_id, position, name, description, population_rate, investigated_as, win_conditions) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			scenario.ID, sidePos, side.Name, side.Description, rate, string(side.InvestigatedAs), string(conditions)); err != nil {
+			return fmt.Errorf("failed to save side '%s' of scenario %s: %w", side.Name, scenario.ID, err)
+		}
+		if side.DefaultRole != nil {
+			if err := saveScenarioRole(q, scenario.ID, sidePos, defaultRolePosition, *side.DefaultRole); err != nil {
+				return err
+			}
+		}
+		for rolePos, role := range side.Roles {
+			if err := saveScenarioRole(q, scenario.ID, sidePos, rolePos, role); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func saveScenarioRole(q queryer, scenarioID string, sidePos, rolePos int, role scenarioEntity.Role) error {
+	data, err := json.Marshal(role)
+	if err != nil {
+		return fmt.Errorf("failed to encode role '%s': %w", role.Name, err)
+	}
+	if _, err := q.Exec(`INSERT INTO scenario_roles (scenario_id, side_position, position, name, role_data) VALUES (?, ?, ?, ?, ?)`,
+		scenarioID, sidePos, rolePos, role.Name, string(data)); err != nil {
+		return fmt.Errorf("failed to save role '%s' of scenario %s: %w", role.Name, scenarioID, err)
+	}
+	return nil
+}
+
+// loadScenario reads a full scenario with its sides and roles in their original order.
+func loadScenario(q queryer, id string) (*scenarioEntity.Scenario, error) {
+	scenario := &scenarioEntity.Scenario{ID: id}
+	var deathReveal, voteTie, priority string
+	err := q.QueryRow(`SELECT name, death_reveal, vote_tie, action_priority, min_players, max_players, seed_checksum FROM scenarios WHERE id = ?`, id).
+		Scan(&scenario.Name, &deathReveal, &voteTie, &priority, &scenario.MinPlayers, &scenario.MaxPlayers, &scenario.SeedChecksum)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: scenario with ID %s not found", scenarioEntity.ErrScenarioNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scenario %s: %w", id, err)
+	}
+	scenario.DeathReveal = scenarioEntity.DeathReveal(deathReveal)
+	scenario.VoteTie = scenarioEntity.VoteTieRule(voteTie)
+	if err := json.Unmarshal([]byte(priority), &scenario.ActionPriority); err != nil {
+		return nil, fmt.Errorf("failed to decode action priority of scenario %s: %w", id, err)
+	}
+	if len(scenario.ActionPriority) == 0 {
+		scenario.ActionPriority = nil
+	}
+
+	sideRows, err := q.Query(`SELECT name, description, population_rate, investigated_as, win_conditions FROM scenario_sides WHERE scenario_id = ? ORDER BY position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sides of scenario %s: %w", id, err)
+	}
+	for sideRows.Next() {
+		var side scenarioEntity.Side
+		var rate sql.NullFloat64
+		var investigatedAs, conditions string
+		if err := sideRows.Scan(&side.Name, &side.Description, &rate, &investigatedAs, &conditions); err != nil {
+			sideRows.Close()
+			return nil, fmt.Errorf("failed to scan side of scenario %s: %w", id, err)
+		}
+		if rate.Valid {
+			r := float32(rate.Float64)
+			side.PopulationRate = &r
+		}
+		side.InvestigatedAs = scenarioEntity.InvestigationResult(investigatedAs)
+		if err := json.Unmarshal([]byte(conditions), &side.WinConditions); err != nil {
+			sideRows.Close()
+			return nil, fmt.Errorf("failed to decode win conditions of side '%s': %w", side.Name, err)
+		}
+		if len(side.WinConditions) == 0 {
+			side.WinConditions = nil
+		}
+		scenario.Sides = append(scenario.Sides, side)
+	}
+	sideRows.Close()
+	if err := sideRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sides of scenario %s: %w", id, err)
+	}
+
+	roleRows, err := q.Query(`SELECT side_position, position, role_data FROM scenario_roles WHERE scenario_id = ? ORDER BY side_position, position`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load roles of scenario %s: %w", id, err)
+	}
+	defer roleRows.Close()
+	for roleRows.Next() {
+		var sidePos, rolePos int
+		var data string
+		if err := roleRows.Scan(&sidePos, &rolePos, &data); err != nil {
+			return nil, fmt.Errorf("failed to scan role of scenario %s: %w", id, err)
+		}
+		if sidePos < 0 || sidePos >= len(scenario.Sides) {
+			return nil, fmt.Errorf("role of scenario %s references unknown side %d", id, sidePos)
+		}
+		var role scenarioEntity.Role
+		if err := json.Unmarshal([]byte(data), &role); err != nil {
+			return nil, fmt.Errorf("failed to decode role of scenario %s: %w", id, err)
+		}
+		side := &scenario.Sides[sidePos]
+		if rolePos == defaultRolePosition {
+			side.DefaultRole = &role
+		} else {
+			side.Roles = append(side.Roles, role)
+		}
+	}
+	return scenario, roleRows.Err()
+}
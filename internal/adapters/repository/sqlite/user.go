@@ -0,0 +1,41 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// saveUser inserts the user or refreshes its profile fields if it already exists.
+func saveUser(q queryer, user *sharedEntity.User) error {
+	if user == nil {
+		return nil
+	}
+	_, err := q.Exec(`
+		INSERT INTO users (id, first_name, last_name, username, admin) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			first_name = excluded.first_name,
+			last_name = excluded.last_name,
+			username = excluded.username,
+			admin = excluded.admin`,
+		int64(user.ID), user.FirstName, user.LastName, user.Username, user.Admin)
+	if err != nil {
+		return fmt.Errorf("failed to save user %d: %w", user.ID, err)
+	}
+	return nil
+}
+
+// loadUser returns the stored user, or nil if the ID is unknown.
+func loadUser(q queryer, id sharedEntity.UserID) (*sharedEntity.User, error) {
+	user := &sharedEntity.User{}
+	err := q.QueryRow(`SELECT id, first_name, last_name, username, admin FROM users WHERE id = ?`, int64(id)).
+		Scan(&user.ID, &user.FirstName, &user.LastName, &user.Username, &user.Admin)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user %d: %w", id, err)
+	}
+	return user, nil
+}
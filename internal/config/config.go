@@ -9,10 +9,72 @@ import (
 	"strings"
 )
 
+// Storage drivers supported by the composition root
+const (
+	StorageMemory = "memory"
+	StorageSQLite = "sqlite"
+)
+
+// Default values for optional settings
+const (
+	DefaultStorageDriver = StorageMemory
+	DefaultDatabasePath  = "telemafia.db"
+	DefaultScenarioDir   = "resources/scenario"
+	DefaultMediaDir      = "resources"
+)
+
 // Config holds the application configuration
 type Config struct {
 	TelegramBotToken string   `json:"telegram_bot_token"`
 	AdminUsernames   []string `json:"admin_usernames"`
+	StorageDriver    string   `json:"storage_driver,omitempty"`   // "memory" (default) or "sqlite"
+	DatabasePath     string   `json:"database_path,omitempty"`    // SQLite file, used when StorageDriver is "sqlite"
+	ScenarioDir      string   `json:"scenario_dir,omitempty"`     // Directory of bundled scenario files loaded at startup
+	MediaDir         string   `json:"media_dir,omitempty"`        // Directory that scenario image paths are relative to
+	ReseedScenarios  bool     `json:"reseed_scenarios,omitempty"` // Overwrite stored scenarios with the files of ScenarioDir at startup
+}
+
+// applyDefaults fills optional settings that were not provided.
+func (c *Config) applyDefaults() {
+	if c.StorageDriver == "" {
+		c.StorageDriver = DefaultStorageDriver
+	}
+	if c.DatabasePath == "" {
+		c.DatabasePath = DefaultDatabasePath
+	}
+	if c.ScenarioDir == "" {
+		c.ScenarioDir = DefaultScenarioDir
+	}
+	if c.MediaDir == "" {
+		c.MediaDir = DefaultMediaDir
+	}
+}
+
+// Validate checks the optional settings for unsupported values.
+func (c *Config) Validate() error {
+	switch c.StorageDriver {
+	case StorageMemory, StorageSQLite:
+		return nil
+	default:
+		return fmt.Errorf("❌ Error: unsupported storage_driver '%s' (expected '%s' or '%s')", c.StorageDriver, StorageMemory, StorageSQLite)
+	}
+}
+
+// LoadStorageConfig reads only the storage settings from a JSON file, without requiring a bot token.
+// A missing file yields the defaults; it is used by maintenance commands such as `telemafia migrate`.
+func LoadStorageConfig(filename string) (*Config, error) {
+	cfg := &Config{}
+	data, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read config file '%s': %w", filename, err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config file '%s': %w", filename, err)
+		}
+	}
+	cfg.applyDefaults()
+	return cfg, cfg.Validate()
 }
 
 // LoadConfig reads the bot token and admin usernames from CLI arguments first, then falls back to a JSON file if needed.
@@ -20,6 +82,11 @@ func LoadConfig(filename string) (*Config, error) {
 	// Define flags locally, don't rely on global state if possible
 	t := flag.String("token", "", "Telegram bot token")
 	admins := flag.String("admins", "", "Comma-separated list of admin usernames")
+	storage := flag.String("storage", "", "Storage driver: memory or sqlite")
+	dbPath := flag.String("db", "", "Path to the SQLite database file")
+	scenarioDir := flag.String("scenarios", "", "Directory of scenario files to load at startup")
+	mediaDir := flag.String("media", "", "Directory that scenario image paths are relative to")
+	reseed := flag.Bool("reseed-scenarios", false, "Overwrite stored scenarios with the scenario files at startup")
 	// Consider making filename a flag too: configFile := flag.String("config", "config.json", "Path to JSON config file")
 	flag.Parse()
 
@@ -30,7 +97,13 @@ func LoadConfig(filename string) (*Config, error) {
 		fmt.Println("✅ Loaded configuration from command-line arguments")
 		cfg.TelegramBotToken = *t
 		cfg.AdminUsernames = strings.Split(*admins, ",")
-		return cfg, nil
+		cfg.StorageDriver = *storage
+		cfg.DatabasePath = *dbPath
+		cfg.ScenarioDir = *scenarioDir
+		cfg.MediaDir = *mediaDir
+		cfg.ReseedScenarios = *reseed
+		cfg.applyDefaults()
+		return cfg, cfg.Validate()
 	}
 
 	// If CLI arguments are missing, try to load from config.json
@@ -45,7 +118,24 @@ func LoadConfig(filename string) (*Config, error) {
 			if cfg.AdminUsernames == nil {
 				cfg.AdminUsernames = []string{}
 			}
-			return cfg, nil
+			// Storage flags still override the file so a deployment can switch backends without editing it
+			if *storage != "" {
+				cfg.StorageDriver = *storage
+			}
+			if *dbPath != "" {
+				cfg.DatabasePath = *dbPath
+			}
+			if *scenarioDir != "" {
+				cfg.ScenarioDir = *scenarioDir
+			}
+			if *mediaDir != "" {
+				cfg.MediaDir = *mediaDir
+			}
+			if *reseed {
+				cfg.ReseedScenarios = true
+			}
+			cfg.applyDefaults()
+			return cfg, cfg.Validate()
 		}
 		// Log error if decoding fails but file exists?
 		// log.Printf("Warn: Could not decode config file '%s': %v", filename, err)
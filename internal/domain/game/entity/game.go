@@ -11,11 +11,21 @@ type GameID string
 
 // Game represents a game entity with a scenario, room, and role assignments
 type Game struct {
-	ID          GameID
-	State       GameState
-	Room        *roomEntity.Room                            // Use imported Room type
-	Scenario    *scenarioEntity.Scenario                    // Use imported Scenario type
-	Assignments map[sharedEntity.UserID]scenarioEntity.Role // Use imported UserID and Role types
+	ID            GameID
+	State         GameState
+	Room          *roomEntity.Room                            // Use imported Room type
+	Scenario      *scenarioEntity.Scenario                    // Use imported Scenario type
+	Assignments   map[sharedEntity.UserID]scenarioEntity.Role // Use imported UserID and Role types
+	Seats         []sharedEntity.UserID                       // Players in seat order, taken from the room when roles are assigned
+	Phase         Phase                                       // Current step of the day/night cycle
+	Statuses      map[sharedEntity.UserID]PlayerStatus        // Dead players; everyone else with a role is alive
+	Vote          *Vote                                       // Current or last day vote, nil before the first one
+	NightActions  []NightAction                               // Abilities used so far, across all nights
+	Timer         *PhaseTimer                                 // Countdown of the current phase, nil when the phase is not timed
+	Turns         *SpeakingTurns                              // Talk order of the current day or defense, nil when not started
+	Winners       []string                                    // Sides that won, set when the game finishes with a winner
+	ResolvedNight int                                         // Last night whose actions were resolved, 0 before the first one
+	ReadyCheck    *ReadyCheck                                 // Confirmation asked of the players before roles are handed out, nil if none
 }
 
 // GameState represents the current state of a game
@@ -42,9 +52,12 @@ func (g *Game) AssignRole(userID sharedEntity.UserID, role scenarioEntity.Role)
 	g.Assignments[userID] = role
 }
 
-// SetRolesAssigned updates the game state to roles assigned
+// SetRolesAssigned updates the game state to roles assigned, ends the ready-check and seats the players as they sit in the room
 func (g *Game) SetRolesAssigned() {
 	g.State = GameStateRolesAssigned
+	g.ReadyCheck = nil
+	// The seats are taken from the room now, so later seat changes in the room do not move anyone
+	g.Seats = g.seatOrderOf(g.roomSeats())
 }
 
 // StartGame updates the game state to in progress
@@ -56,3 +69,60 @@ func (g *Game) StartGame() {
 func (g *Game) FinishGame() {
 	g.State = GameStateFinished
 }
+
+// Clone returns a copy of the game whose state can be changed without touching the original.
+// The room and scenario are shared: they belong to their own repositories and are not changed through the game.
+func (g *Game) Clone() *Game {
+	clone := *g
+	if g.Assignments != nil {
+		clone.Assignments = make(map[sharedEntity.UserID]scenarioEntity.Role, len(g.Assignments))
+		for id, role := range g.Assignments {
+			clone.Assignments[id] = role
+		}
+	}
+	clone.Seats = append([]sharedEntity.UserID(nil), g.Seats...)
+	if g.Statuses != nil {
+		clone.Statuses = make(map[sharedEntity.UserID]PlayerStatus, len(g.Statuses))
+		for id, status := range g.Statuses {
+			clone.Statuses[id] = status
+		}
+	}
+	if g.Vote != nil {
+		vote := *g.Vote
+		vote.Candidates = append([]sharedEntity.UserID(nil), g.Vote.Candidates...)
+		vote.Leaders = append([]sharedEntity.UserID(nil), g.Vote.Leaders...)
+		if g.Vote.Ballots != nil {
+			vote.Ballots = make(map[sharedEntity.UserID]sharedEntity.UserID, len(g.Vote.Ballots))
+			for voter, target := range g.Vote.Ballots {
+				vote.Ballots[voter] = target
+			}
+		}
+		clone.Vote = &vote
+	}
+	if g.NightActions != nil {
+		clone.NightActions = make([]NightAction, len(g.NightActions))
+		for i, action := range g.NightActions {
+			action.Targets = append([]sharedEntity.UserID(nil), action.Targets...)
+			clone.NightActions[i] = action
+		}
+	}
+	if g.Timer != nil {
+		timer := *g.Timer
+		clone.Timer = &timer
+	}
+	if g.Turns != nil {
+		turns := *g.Turns
+		turns.Order = append([]sharedEntity.UserID(nil), g.Turns.Order...)
+		turns.Skipped = append([]sharedEntity.UserID(nil), g.Turns.Skipped...)
+		turns.Requests = append([]sharedEntity.UserID(nil), g.Turns.Requests...)
+		clone.Turns = &turns
+	}
+	clone.Winners = append([]string(nil), g.Winners...)
+	if g.ReadyCheck != nil {
+		check := *g.ReadyCheck
+		check.Players = append([]sharedEntity.UserID(nil), g.ReadyCheck.Players...)
+		check.Ready = append([]sharedEntity.UserID(nil), g.ReadyCheck.Ready...)
+		clone.ReadyCheck = &check
+	}
+	return &clone
+}
@@ -0,0 +1,169 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var (
+	ErrNotNightPhase      = errors.New("night actions can only be submitted during the night")
+	ErrActorNotAlive      = errors.New("only alive players can act at night")
+	ErrUnknownAbility     = errors.New("the role has no such ability")
+	ErrAbilityUsedUp      = errors.New("the ability has no uses left")
+	ErrInvalidNightTarget = errors.New("invalid night action target")
+)
+
+// NightAction is the use of an ability by a role holder during a night
+type NightAction struct {
+	Night   int
+	Actor   sharedEntity.UserID
+	Ability string
+	Targets []sharedEntity.UserID
+}
+
+// RemainingUses returns how many more nights the actor may use an ability, or -1 when it is unlimited.
+// An action submitted tonight does not count, since it can still be changed.
+func (g *Game) RemainingUses(actor sharedEntity.UserID, ability scenarioEntity.Ability) int {
+	if ability.Uses == 0 {
+		return -1
+	}
+	used := 0
+	for _, a := range g.NightActions {
+		if a.Actor == actor && a.Ability == ability.Name && a.Night != g.Phase.Number {
+			used++
+		}
+	}
+	if used >= ability.Uses {
+		return 0
+	}
+	return ability.Uses - used
+}
+
+// UsableAbilities returns the abilities an alive player may use tonight
+func (g *Game) UsableAbilities(actor sharedEntity.UserID) []scenarioEntity.Ability {
+	if g.Phase.Type != PhaseNight || !g.IsAlive(actor) {
+		return nil
+	}
+	var usable []scenarioEntity.Ability
+	for _, a := range g.Assignments[actor].Abilities {
+		if g.RemainingUses(actor, a) != 0 {
+			usable = append(usable, a)
+		}
+	}
+	return usable
+}
+
+// NightActors returns the players with an ability to use tonight, in seat order
+func (g *Game) NightActors() []sharedEntity.UserID {
+	var actors []sharedEntity.UserID
+	for _, userID := range g.SeatOrder() {
+		if len(g.UsableAbilities(userID)) > 0 {
+			actors = append(actors, userID)
+		}
+	}
+	return actors
+}
+
+// ValidNightTargets returns the players the actor may target with an ability, in seat order
+func (g *Game) ValidNightTargets(actor sharedEntity.UserID, ability scenarioEntity.Ability) []sharedEntity.UserID {
+	actorSide := g.Assignments[actor].Side
+	var targets []sharedEntity.UserID
+	for _, userID := range g.SeatOrder() {
+		role := g.Assignments[userID]
+		alive := g.Status(userID).Alive
+		switch {
+		case ability.Has(scenarioEntity.TargetDead) && alive:
+			continue
+		case !ability.Has(scenarioEntity.TargetDead) && !alive:
+			continue
+		case ability.Has(scenarioEntity.TargetNonSelf) && userID == actor:
+			continue
+		case ability.Has(scenarioEntity.TargetNonTeammate) && userID != actor && role.Side == actorSide:
+			continue
+		}
+		targets = append(targets, userID)
+	}
+	return targets
+}
+
+// SubmitNightAction records tonight's use of an ability, replacing an earlier choice for the same ability
+func (g *Game) SubmitNightAction(actor sharedEntity.UserID, abilityName string, targets []sharedEntity.UserID) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	if g.Phase.Type != PhaseNight {
+		return ErrNotNightPhase
+	}
+	// Choices made after the resolution would never take effect
+	if g.ResolvedNight >= g.Phase.Number {
+		return ErrNightAlreadyResolved
+	}
+	if !g.IsAlive(actor) {
+		return ErrActorNotAlive
+	}
+	ability, ok := g.Assignments[actor].Ability(abilityName)
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownAbility, abilityName)
+	}
+	if g.RemainingUses(actor, ability) == 0 {
+		return ErrAbilityUsedUp
+	}
+	if len(targets) != ability.Count() {
+		return fmt.Errorf("%w: %s needs %d target(s), got %d", ErrInvalidNightTarget, ability.Name, ability.Count(), len(targets))
+	}
+	valid := make(map[sharedEntity.UserID]bool)
+	for _, t := range g.ValidNightTargets(actor, ability) {
+		valid[t] = true
+	}
+	seen := make(map[sharedEntity.UserID]bool, len(targets))
+	for _, t := range targets {
+		if !valid[t] || seen[t] {
+			return fmt.Errorf("%w: %d", ErrInvalidNightTarget, t)
+		}
+		seen[t] = true
+	}
+
+	action := NightAction{Night: g.Phase.Number, Actor: actor, Ability: ability.Name, Targets: append([]sharedEntity.UserID(nil), targets...)}
+	for i, a := range g.NightActions {
+		if a.Night == action.Night && a.Actor == actor && a.Ability == ability.Name {
+			g.NightActions[i] = action
+			return nil
+		}
+	}
+	g.NightActions = append(g.NightActions, action)
+	return nil
+}
+
+// TonightAction returns the action the actor submitted tonight for an ability, if any
+func (g *Game) TonightAction(actor sharedEntity.UserID, abilityName string) (NightAction, bool) {
+	if g.Phase.Type != PhaseNight {
+		return NightAction{}, false
+	}
+	for _, a := range g.NightActions {
+		if a.Night == g.Phase.Number && a.Actor == actor && a.Ability == abilityName {
+			return a, true
+		}
+	}
+	return NightAction{}, false
+}
+
+// ActionsOfNight returns the actions submitted during a night, ordered by actor and ability
+func (g *Game) ActionsOfNight(night int) []NightAction {
+	var actions []NightAction
+	for _, a := range g.NightActions {
+		if a.Night == night {
+			actions = append(actions, a)
+		}
+	}
+	sort.SliceStable(actions, func(i, j int) bool {
+		if actions[i].Actor != actions[j].Actor {
+			return actions[i].Actor < actions[j].Actor
+		}
+		return actions[i].Ability < actions[j].Ability
+	})
+	return actions
+}
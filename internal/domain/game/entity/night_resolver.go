@@ -0,0 +1,205 @@
+package entity
+
+import (
+	"errors"
+	"sort"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var ErrNightAlreadyResolved = errors.New("tonight's actions are already resolved")
+
+// PrivateResult is something only one player learns from the night
+type PrivateResult struct {
+	Recipient     sharedEntity.UserID
+	Ability       string
+	Target        sharedEntity.UserID                // Investigated player; zero for blocked results
+	Blocked       bool                               // The recipient was blocked and the ability had no effect
+	Investigation scenarioEntity.InvestigationResult // Set for investigations
+}
+
+// NightResolution is the outcome of a night: who died, who was saved or blocked, and what players learn in private
+type NightResolution struct {
+	Night   int
+	Deaths  []sharedEntity.UserID // Players killed tonight, in ascending order
+	Saved   []sharedEntity.UserID // Players a kill was stopped on, in ascending order
+	Blocked []sharedEntity.UserID // Players whose abilities were blocked, in ascending order
+	Results []PrivateResult       // In resolution order
+}
+
+// NightState is the state built up while the actions of a night are applied one by one
+type NightState struct {
+	Game       *Game // Read-only; the game is changed only once the night is resolved
+	Night      int
+	blocked    map[sharedEntity.UserID]bool
+	saved      map[sharedEntity.UserID]bool
+	dead       map[sharedEntity.UserID]bool
+	resolution NightResolution
+}
+
+// Block stops a player's abilities for the rest of the night
+func (s *NightState) Block(target sharedEntity.UserID) {
+	s.blocked[target] = true
+}
+
+// Save protects a player from kills applied later in the night
+func (s *NightState) Save(target sharedEntity.UserID) {
+	s.saved[target] = true
+}
+
+// Kill kills a player unless they were saved; players who are already dead are left alone
+func (s *NightState) Kill(target sharedEntity.UserID) {
+	switch {
+	case !s.Game.IsAlive(target) || s.dead[target]:
+	case s.saved[target]:
+		s.resolution.Saved = appendUnique(s.resolution.Saved, target)
+	default:
+		s.dead[target] = true
+		s.resolution.Deaths = append(s.resolution.Deaths, target)
+	}
+}
+
+// IsBlocked reports whether a player was blocked so far tonight
+func (s *NightState) IsBlocked(userID sharedEntity.UserID) bool {
+	return s.blocked[userID]
+}
+
+// Report adds a private result
+func (s *NightState) Report(result PrivateResult) {
+	s.resolution.Results = append(s.resolution.Results, result)
+}
+
+// EffectRule applies one night action to the state
+type EffectRule func(state *NightState, action NightAction)
+
+// NightResolver applies the actions of a night in the order of the scenario's action_priority.
+// Actions with the same effect are applied in ascending actor order, so resolution is deterministic.
+type NightResolver struct {
+	rules map[scenarioEntity.AbilityEffect]EffectRule
+}
+
+// NewNightResolver creates a resolver with the default rules:
+// blocks stop later abilities of their targets, saves cancel later kills and
+// investigations report the target's investigated_as result.
+func NewNightResolver() *NightResolver {
+	return &NightResolver{rules: map[scenarioEntity.AbilityEffect]EffectRule{
+		scenarioEntity.EffectBlock: func(s *NightState, a NightAction) {
+			for _, t := range a.Targets {
+				s.Block(t)
+			}
+		},
+		scenarioEntity.EffectSave: func(s *NightState, a NightAction) {
+			for _, t := range a.Targets {
+				s.Save(t)
+			}
+		},
+		scenarioEntity.EffectKill: func(s *NightState, a NightAction) {
+			for _, t := range a.Targets {
+				s.Kill(t)
+			}
+		},
+		scenarioEntity.EffectInvestigate: func(s *NightState, a NightAction) {
+			for _, t := range a.Targets {
+				s.Report(PrivateResult{Recipient: a.Actor, Ability: a.Ability, Target: t, Investigation: s.Game.investigationOf(t)})
+			}
+		},
+	}}
+}
+
+// WithRule replaces the rule of an effect and returns the resolver
+func (r *NightResolver) WithRule(effect scenarioEntity.AbilityEffect, rule EffectRule) *NightResolver {
+	r.rules[effect] = rule
+	return r
+}
+
+// Resolve works out the outcome of a night without changing the game.
+// Abilities without an effect are left to the moderator. Actors blocked before their
+// effect comes up learn that their ability failed.
+func (r *NightResolver) Resolve(g *Game, night int) NightResolution {
+	state := &NightState{
+		Game:       g,
+		Night:      night,
+		blocked:    make(map[sharedEntity.UserID]bool),
+		saved:      make(map[sharedEntity.UserID]bool),
+		dead:       make(map[sharedEntity.UserID]bool),
+		resolution: NightResolution{Night: night},
+	}
+	actions := g.ActionsOfNight(night)
+	for _, effect := range g.effectOrder() {
+		rule, ok := r.rules[effect]
+		if !ok {
+			continue
+		}
+		for _, action := range actions {
+			ability, ok := g.Assignments[action.Actor].Ability(action.Ability)
+			if !ok || ability.Effect != effect {
+				continue
+			}
+			if state.blocked[action.Actor] {
+				state.resolution.Blocked = appendUnique(state.resolution.Blocked, action.Actor)
+				state.Report(PrivateResult{Recipient: action.Actor, Ability: action.Ability, Blocked: true})
+				continue
+			}
+			rule(state, action)
+		}
+	}
+
+	res := state.resolution
+	for _, ids := range [][]sharedEntity.UserID{res.Deaths, res.Saved, res.Blocked} {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	}
+	return res
+}
+
+// ResolveNight resolves tonight's actions and kills the players who died,
+// then finishes the game if a side has won. Each night can be resolved once.
+func (g *Game) ResolveNight(resolver *NightResolver) (NightResolution, error) {
+	if err := g.requireRunning(); err != nil {
+		return NightResolution{}, err
+	}
+	if g.Phase.Type != PhaseNight {
+		return NightResolution{}, ErrNotNightPhase
+	}
+	if g.ResolvedNight >= g.Phase.Number {
+		return NightResolution{}, ErrNightAlreadyResolved
+	}
+	res := resolver.Resolve(g, g.Phase.Number)
+	for _, userID := range res.Deaths {
+		if err := g.eliminate(userID, DeathCauseKilled); err != nil {
+			return NightResolution{}, err
+		}
+	}
+	g.ResolvedNight = g.Phase.Number
+	g.checkWinConditions()
+	return res, nil
+}
+
+// effectOrder returns the scenario's effect order, or the default one
+func (g *Game) effectOrder() []scenarioEntity.AbilityEffect {
+	if g.Scenario == nil {
+		return scenarioEntity.DefaultActionPriority
+	}
+	return g.Scenario.EffectOrder()
+}
+
+// investigationOf returns what an investigation reveals about a player
+func (g *Game) investigationOf(userID sharedEntity.UserID) scenarioEntity.InvestigationResult {
+	role := g.Assignments[userID]
+	if g.Scenario != nil {
+		return g.Scenario.InvestigationOf(role)
+	}
+	if role.InvestigatedAs != "" {
+		return role.InvestigatedAs
+	}
+	return scenarioEntity.InvestigationInnocent
+}
+
+func appendUnique(ids []sharedEntity.UserID, id sharedEntity.UserID) []sharedEntity.UserID {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
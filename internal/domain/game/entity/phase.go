@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PhaseType represents the part of the day/night cycle a game is in
+type PhaseType string
+
+const (
+	// PhaseNone means the cycle has not started yet
+	PhaseNone PhaseType = ""
+	// PhaseNight is the night; roles with night abilities act
+	PhaseNight PhaseType = "night"
+	// PhaseDay is the open discussion of the day
+	PhaseDay PhaseType = "day"
+	// PhaseVoting is the day vote
+	PhaseVoting PhaseType = "voting"
+	// PhaseDefense is the defense speech of the players put on trial by the vote
+	PhaseDefense PhaseType = "defense"
+)
+
+var (
+	ErrInvalidPhaseTransition = errors.New("invalid phase transition")
+	ErrGameNotStarted         = errors.New("game has not started")
+	ErrGameFinished           = errors.New("game is already finished")
+)
+
+// Phase is the current step of the day/night cycle.
+// Night N is followed by day N; the first phase may be night 1 or the introduction day 0.
+type Phase struct {
+	Type      PhaseType
+	Number    int
+	StartedAt time.Time
+}
+
+// allowedPhaseTransitions lists which phases may follow each phase
+var allowedPhaseTransitions = map[PhaseType][]PhaseType{
+	PhaseNone:    {PhaseNight, PhaseDay},
+	PhaseNight:   {PhaseDay},
+	PhaseDay:     {PhaseVoting, PhaseNight},
+	PhaseVoting:  {PhaseDefense, PhaseNight},
+	PhaseDefense: {PhaseVoting, PhaseNight},
+}
+
+// CanTransitionTo reports whether the cycle may move from the current phase to next
+func (g *Game) CanTransitionTo(next PhaseType) bool {
+	if g.State != GameStateRolesAssigned && g.State != GameStateInProgress {
+		return false
+	}
+	for _, allowed := range allowedPhaseTransitions[g.Phase.Type] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedTransitions returns the phases that may follow the current phase, in display order
+func (g *Game) AllowedTransitions() []PhaseType {
+	if g.State != GameStateRolesAssigned && g.State != GameStateInProgress {
+		return nil
+	}
+	return append([]PhaseType(nil), allowedPhaseTransitions[g.Phase.Type]...)
+}
+
+// AdvancePhase moves the game into the next phase.
+// The first transition starts the game; nights bump the phase number; a vote left open is cancelled.
+func (g *Game) AdvancePhase(next PhaseType, now time.Time) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	if !g.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidPhaseTransition, g.Phase.Label(), next)
+	}
+
+	number := g.Phase.Number
+	if next == PhaseNight {
+		number++
+	}
+	g.cancelOpenVote()
+	g.Timer = nil
+	g.Turns = nil
+	g.Phase = Phase{Type: next, Number: number, StartedAt: now}
+	g.StartGame()
+	return nil
+}
+
+// EndGame finishes the game at any point after roles were assigned
+func (g *Game) EndGame() error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	g.cancelOpenVote()
+	g.Timer = nil
+	g.Turns = nil
+	g.FinishGame()
+	return nil
+}
+
+// Label renders the phase for logs and errors, e.g. "night 2"
+func (p Phase) Label() string {
+	if p.Type == PhaseNone {
+		return "not started"
+	}
+	return fmt.Sprintf("%s %d", p.Type, p.Number)
+}
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// DeathCause records how a player left the game
+type DeathCause string
+
+const (
+	// DeathCauseKilled means the player was killed during the night
+	DeathCauseKilled DeathCause = "killed"
+	// DeathCauseLynched means the player was voted out during the day
+	DeathCauseLynched DeathCause = "lynched"
+	// DeathCauseRemoved means the moderator took the player out of the game
+	DeathCauseRemoved DeathCause = "removed"
+)
+
+var (
+	ErrPlayerNotInGame   = errors.New("player has no role in this game")
+	ErrPlayerAlreadyDead = errors.New("player is already dead")
+	ErrPlayerNotDead     = errors.New("player is not dead")
+	ErrInvalidDeathCause = errors.New("invalid death cause")
+)
+
+// PlayerStatus is the life status of a player with an assigned role
+type PlayerStatus struct {
+	Alive    bool
+	Cause    DeathCause // Set when the player is dead
+	DiedIn   Phase      // Phase in which the player died (type and number only)
+	Revealed bool       // Whether the room learns the role (or side) of the dead player
+}
+
+// Status returns the status of a player; players without a recorded status are alive
+func (g *Game) Status(userID sharedEntity.UserID) PlayerStatus {
+	if status, ok := g.Statuses[userID]; ok {
+		return status
+	}
+	return PlayerStatus{Alive: true}
+}
+
+// IsAlive reports whether a player with a role is still in the game
+func (g *Game) IsAlive(userID sharedEntity.UserID) bool {
+	_, assigned := g.Assignments[userID]
+	return assigned && g.Status(userID).Alive
+}
+
+// AlivePlayers returns the IDs of the players still in the game, in seat order
+func (g *Game) AlivePlayers() []sharedEntity.UserID {
+	var alive []sharedEntity.UserID
+	for _, userID := range g.SeatOrder() {
+		if g.Status(userID).Alive {
+			alive = append(alive, userID)
+		}
+	}
+	return alive
+}
+
+// Eliminate marks a player as dead in the current phase and finishes the game if a side has won.
+// Whether the role is revealed follows the scenario's death_reveal rule.
+func (g *Game) Eliminate(userID sharedEntity.UserID, cause DeathCause) error {
+	if err := g.eliminate(userID, cause); err != nil {
+		return err
+	}
+	g.checkWinConditions()
+	return nil
+}
+
+// eliminate marks a player as dead without looking at the win conditions
+func (g *Game) eliminate(userID sharedEntity.UserID, cause DeathCause) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	switch cause {
+	case DeathCauseKilled, DeathCauseLynched, DeathCauseRemoved:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidDeathCause, cause)
+	}
+	if _, assigned := g.Assignments[userID]; !assigned {
+		return ErrPlayerNotInGame
+	}
+	if !g.Status(userID).Alive {
+		return ErrPlayerAlreadyDead
+	}
+
+	if g.Statuses == nil {
+		g.Statuses = make(map[sharedEntity.UserID]PlayerStatus)
+	}
+	g.Statuses[userID] = PlayerStatus{
+		Alive:    false,
+		Cause:    cause,
+		DiedIn:   Phase{Type: g.Phase.Type, Number: g.Phase.Number},
+		Revealed: g.Scenario != nil && g.Scenario.DeathReveal.Reveals(),
+	}
+	return nil
+}
+
+// Revive brings a dead player back into the game
+func (g *Game) Revive(userID sharedEntity.UserID) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	if _, assigned := g.Assignments[userID]; !assigned {
+		return ErrPlayerNotInGame
+	}
+	if g.Status(userID).Alive {
+		return ErrPlayerNotDead
+	}
+	delete(g.Statuses, userID)
+	return nil
+}
+
+// RevealedIdentity returns what the room may know about a dead player: the role name,
+// the side, or nothing, depending on the scenario rule at the time of death.
+func (g *Game) RevealedIdentity(userID sharedEntity.UserID) (string, bool) {
+	status := g.Status(userID)
+	role, assigned := g.Assignments[userID]
+	if status.Alive || !status.Revealed || !assigned {
+		return "", false
+	}
+	if g.Scenario != nil && g.Scenario.DeathReveal == scenarioEntity.DeathRevealSide {
+		return role.Side, true
+	}
+	return role.Name, true
+}
+
+// requireRunning fails unless roles have been handed out and the game has not finished
+func (g *Game) requireRunning() error {
+	switch g.State {
+	case GameStateRolesAssigned, GameStateInProgress:
+		return nil
+	case GameStateFinished:
+		return ErrGameFinished
+	default:
+		return ErrGameNotStarted
+	}
+}
@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var (
+	ErrReadyCheckNotAllowed = errors.New("the ready-check runs before roles are handed out")
+	ErrNoReadyCheck         = errors.New("there is no ready-check")
+	ErrNotInReadyCheck      = errors.New("the player is not part of the ready-check")
+	ErrNobodyToCheck        = errors.New("there are no players to check")
+	ErrInvalidReadyTimeout  = errors.New("ready-check timeout cannot be negative")
+	ErrPlayersNotReady      = errors.New("not every player is ready")
+)
+
+// ReadyCheck asks the players of a room to confirm they are at the table before roles are handed out.
+// Only the deadline is stored, so a timed check survives restarts.
+type ReadyCheck struct {
+	Players  []sharedEntity.UserID // Players asked, in seat order
+	Ready    []sharedEntity.UserID // Players who confirmed, in confirmation order
+	Deadline time.Time             // When non-responders are dropped; zero without a timeout
+	TimedOut bool                  // The deadline passed and non-responders were dropped
+}
+
+// IsReady reports whether a player confirmed
+func (r *ReadyCheck) IsReady(userID sharedEntity.UserID) bool {
+	return r != nil && containsUser(r.Ready, userID)
+}
+
+// Waiting returns the players who did not confirm yet, in seat order
+func (r *ReadyCheck) Waiting() []sharedEntity.UserID {
+	if r == nil {
+		return nil
+	}
+	var waiting []sharedEntity.UserID
+	for _, userID := range r.Players {
+		if !r.IsReady(userID) {
+			waiting = append(waiting, userID)
+		}
+	}
+	return waiting
+}
+
+// AllReady reports whether every player confirmed
+func (r *ReadyCheck) AllReady() bool {
+	return r != nil && len(r.Players) > 0 && len(r.Waiting()) == 0
+}
+
+// IsTimed reports whether non-responders will still be dropped when the deadline passes
+func (r *ReadyCheck) IsTimed() bool {
+	return r != nil && !r.Deadline.IsZero() && !r.TimedOut
+}
+
+// Remaining returns the time left before the deadline, never below zero
+func (r *ReadyCheck) Remaining(now time.Time) time.Duration {
+	if !r.IsTimed() || !now.Before(r.Deadline) {
+		return 0
+	}
+	return r.Deadline.Sub(now)
+}
+
+// StartReadyCheck asks the given players, in seat order, to confirm. A zero timeout waits for the moderator.
+func (g *Game) StartReadyCheck(players []sharedEntity.UserID, now time.Time, timeout time.Duration) error {
+	if g.State != GameStateWaitingForPlayers {
+		return ErrReadyCheckNotAllowed
+	}
+	if timeout < 0 {
+		return ErrInvalidReadyTimeout
+	}
+	if len(players) == 0 {
+		return ErrNobodyToCheck
+	}
+	check := &ReadyCheck{Players: append([]sharedEntity.UserID(nil), players...)}
+	if timeout > 0 {
+		check.Deadline = now.Add(timeout)
+	}
+	g.ReadyCheck = check
+	return nil
+}
+
+// MarkReady records that a player confirmed; confirming twice is harmless
+func (g *Game) MarkReady(userID sharedEntity.UserID) error {
+	check := g.ReadyCheck
+	if check == nil || g.State != GameStateWaitingForPlayers {
+		return ErrNoReadyCheck
+	}
+	if !containsUser(check.Players, userID) {
+		return ErrNotInReadyCheck
+	}
+	if !check.IsReady(userID) {
+		check.Ready = append(check.Ready, userID)
+	}
+	return nil
+}
+
+// AddToReadyCheck asks a player who took a seat during the check to confirm too
+func (g *Game) AddToReadyCheck(userID sharedEntity.UserID) {
+	if g.ReadyCheck != nil && !containsUser(g.ReadyCheck.Players, userID) {
+		g.ReadyCheck.Players = append(g.ReadyCheck.Players, userID)
+	}
+}
+
+// RemoveFromReadyCheck forgets a player who left the room
+func (g *Game) RemoveFromReadyCheck(userID sharedEntity.UserID) {
+	if g.ReadyCheck == nil {
+		return
+	}
+	g.ReadyCheck.Players = withoutUser(g.ReadyCheck.Players, userID)
+	g.ReadyCheck.Ready = withoutUser(g.ReadyCheck.Ready, userID)
+}
+
+// ExpireReadyCheck reports, once, that the deadline of a timed check passed
+func (g *Game) ExpireReadyCheck(now time.Time) bool {
+	check := g.ReadyCheck
+	if !check.IsTimed() || now.Before(check.Deadline) {
+		return false
+	}
+	check.TimedOut = true
+	return true
+}
+
+// RequireReady checks that every given player confirmed. Games started without a ready-check are not held up.
+func (g *Game) RequireReady(players []sharedEntity.UserID) error {
+	if g.ReadyCheck == nil {
+		return nil
+	}
+	for _, userID := range players {
+		if !g.ReadyCheck.IsReady(userID) {
+			return ErrPlayersNotReady
+		}
+	}
+	return nil
+}
+
+func withoutUser(ids []sharedEntity.UserID, id sharedEntity.UserID) []sharedEntity.UserID {
+	var kept []sharedEntity.UserID
+	for _, existing := range ids {
+		if existing != id {
+			kept = append(kept, existing)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// RoleSelectionAudience tells who a tracked role selection message was sent to
+type RoleSelectionAudience string
+
+const (
+	// RoleSelectionAudiencePlayer marks a player's card picker message
+	RoleSelectionAudiencePlayer RoleSelectionAudience = "player"
+	// RoleSelectionAudienceAdmin marks the moderator's assignment tracking message
+	RoleSelectionAudienceAdmin RoleSelectionAudience = "admin"
+)
+
+// RoleSelection is the durable snapshot of an interactive "Choose Card" round,
+// kept so the round can continue after the bot restarts.
+type RoleSelection struct {
+	GameID        GameID
+	ShuffledRoles []scenarioEntity.Role
+	Choices       map[sharedEntity.UserID]RoleChoice // playerID -> picked card
+	Messages      []RoleSelectionMessage             // messages kept up to date while the round runs
+}
+
+// RoleChoice records which card (1-based index) a player picked
+type RoleChoice struct {
+	ChosenIndex int
+	Player      sharedEntity.User
+}
+
+// RoleSelectionMessage identifies a chat message tracked during the round
+type RoleSelectionMessage struct {
+	Audience  RoleSelectionAudience
+	ChatID    int64
+	MessageID int
+}
+
+// TakenIndices returns the set of card indices already picked.
+func (s *RoleSelection) TakenIndices() map[int]bool {
+	taken := make(map[int]bool, len(s.Choices))
+	for _, choice := range s.Choices {
+		taken[choice.ChosenIndex] = true
+	}
+	return taken
+}
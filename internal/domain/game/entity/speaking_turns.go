@@ -0,0 +1,218 @@
+package entity
+
+import (
+	"errors"
+	"sort"
+
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var (
+	ErrNotSpeakingPhase          = errors.New("speaking turns run during the day and defense phases")
+	ErrNoSpeakers                = errors.New("nobody is left to speak")
+	ErrNoSpeakingTurns           = errors.New("speaking turns are not running")
+	ErrChallengeNotAllowed       = errors.New("only alive players other than the speaker can ask for a challenge")
+	ErrChallengeAlreadyRequested = errors.New("the challenge was already requested")
+	ErrChallengeAlreadyGranted   = errors.New("the speaker already granted a challenge this turn")
+	ErrNoChallengeRequest        = errors.New("the player did not ask for a challenge")
+)
+
+// SpeakingTurns is the talk order of a day or a defense. Players speak one after another in seat order;
+// while someone speaks, the others may ask them for a challenge and the moderator may grant one.
+type SpeakingTurns struct {
+	Phase      Phase                 // Type and number of the phase the turns belong to
+	Order      []sharedEntity.UserID // Speakers in seat order
+	Current    int                   // Index of the current speaker in Order; len(Order) once everyone has spoken
+	Skipped    []sharedEntity.UserID // Speakers the moderator skipped
+	Requests   []sharedEntity.UserID // Players asking the current speaker for a challenge, in request order
+	Challenger sharedEntity.UserID   // Player granted the challenge of the current turn, 0 if none
+}
+
+// IsRunning reports whether someone still has to speak
+func (t *SpeakingTurns) IsRunning() bool {
+	return t != nil && t.Current < len(t.Order)
+}
+
+// Speaker returns the current speaker
+func (t *SpeakingTurns) Speaker() (sharedEntity.UserID, bool) {
+	if !t.IsRunning() {
+		return 0, false
+	}
+	return t.Order[t.Current], true
+}
+
+// HasSpoken reports whether a player's turn is over, spoken or skipped
+func (t *SpeakingTurns) HasSpoken(userID sharedEntity.UserID) bool {
+	if t == nil {
+		return false
+	}
+	for i := 0; i < t.Current && i < len(t.Order); i++ {
+		if t.Order[i] == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// WasSkipped reports whether the moderator skipped a player's turn
+func (t *SpeakingTurns) WasSkipped(userID sharedEntity.UserID) bool {
+	return t != nil && containsUser(t.Skipped, userID)
+}
+
+// HasRequested reports whether a player asked the current speaker for a challenge
+func (t *SpeakingTurns) HasRequested(userID sharedEntity.UserID) bool {
+	return t != nil && containsUser(t.Requests, userID)
+}
+
+// SeatOrder returns the players of the game in the order they sit: the seats taken when roles were
+// assigned (or the room's order before that), followed by assigned players without a seat
+func (g *Game) SeatOrder() []sharedEntity.UserID {
+	if len(g.Seats) == 0 {
+		return g.seatOrderOf(g.roomSeats())
+	}
+	return g.seatOrderOf(g.Seats)
+}
+
+// roomSeats lists the players of the game's room in their seat order
+func (g *Game) roomSeats() []sharedEntity.UserID {
+	if g.Room == nil {
+		return nil
+	}
+	var seats []sharedEntity.UserID
+	for _, p := range g.Room.Players {
+		if p != nil {
+			seats = append(seats, p.ID)
+		}
+	}
+	return seats
+}
+
+// seatOrderOf keeps the players with a role in the given order and appends those missing from it by ID
+func (g *Game) seatOrderOf(seats []sharedEntity.UserID) []sharedEntity.UserID {
+	var order []sharedEntity.UserID
+	seated := make(map[sharedEntity.UserID]bool, len(g.Assignments))
+	for _, userID := range seats {
+		if _, assigned := g.Assignments[userID]; assigned && !seated[userID] {
+			order = append(order, userID)
+			seated[userID] = true
+		}
+	}
+	var rest []sharedEntity.UserID
+	for userID := range g.Assignments {
+		if !seated[userID] {
+			rest = append(rest, userID)
+		}
+	}
+	sort.Slice(rest, func(i, j int) bool { return rest[i] < rest[j] })
+	return append(order, rest...)
+}
+
+// StartSpeakingTurns starts, or restarts, the talk order of the current phase. During the day every alive
+// player speaks in seat order; during a defense only the players on trial do.
+func (g *Game) StartSpeakingTurns() error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	var speakers []sharedEntity.UserID
+	switch g.Phase.Type {
+	case PhaseDay:
+		speakers = g.AlivePlayers()
+	case PhaseDefense:
+		if g.Vote != nil && g.Vote.Day == g.Phase.Number {
+			speakers = g.aliveAmong(g.Vote.Leaders)
+		}
+	default:
+		return ErrNotSpeakingPhase
+	}
+
+	var order []sharedEntity.UserID
+	for _, userID := range g.SeatOrder() {
+		if containsUser(speakers, userID) {
+			order = append(order, userID)
+		}
+	}
+	if len(order) == 0 {
+		return ErrNoSpeakers
+	}
+	g.Turns = &SpeakingTurns{Phase: Phase{Type: g.Phase.Type, Number: g.Phase.Number}, Order: order}
+	return nil
+}
+
+// NextSpeaker ends the current turn, as spoken or skipped, and moves on to the next alive speaker.
+// It returns the new speaker, or false once everyone has spoken.
+func (g *Game) NextSpeaker(skip bool) (sharedEntity.UserID, bool, error) {
+	if err := g.requireRunning(); err != nil {
+		return 0, false, err
+	}
+	turns := g.Turns
+	if !turns.IsRunning() {
+		return 0, false, ErrNoSpeakingTurns
+	}
+	if skip {
+		turns.Skipped = append(turns.Skipped, turns.Order[turns.Current])
+	}
+	turns.Requests = nil
+	turns.Challenger = 0
+	turns.Current++
+	// Players who died during the turns lose theirs
+	for turns.Current < len(turns.Order) && !g.IsAlive(turns.Order[turns.Current]) {
+		turns.Current++
+	}
+	speaker, ok := turns.Speaker()
+	return speaker, ok, nil
+}
+
+// RequestChallenge records that a player asks the current speaker for a challenge
+func (g *Game) RequestChallenge(userID sharedEntity.UserID) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	turns := g.Turns
+	speaker, ok := turns.Speaker()
+	if !ok {
+		return ErrNoSpeakingTurns
+	}
+	if userID == speaker || !g.IsAlive(userID) {
+		return ErrChallengeNotAllowed
+	}
+	if turns.Challenger != 0 {
+		return ErrChallengeAlreadyGranted
+	}
+	if turns.HasRequested(userID) {
+		return ErrChallengeAlreadyRequested
+	}
+	turns.Requests = append(turns.Requests, userID)
+	return nil
+}
+
+// GrantChallenge gives the challenge of the current turn to one of the players who asked for it
+func (g *Game) GrantChallenge(userID sharedEntity.UserID) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	turns := g.Turns
+	if !turns.IsRunning() {
+		return ErrNoSpeakingTurns
+	}
+	if turns.Challenger != 0 {
+		return ErrChallengeAlreadyGranted
+	}
+	if !turns.HasRequested(userID) {
+		return ErrNoChallengeRequest
+	}
+	if !g.IsAlive(userID) {
+		return ErrChallengeNotAllowed
+	}
+	turns.Challenger = userID
+	turns.Requests = nil
+	return nil
+}
+
+func containsUser(ids []sharedEntity.UserID, id sharedEntity.UserID) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
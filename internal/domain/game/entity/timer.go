@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidTimerDuration = errors.New("timer duration must be positive")
+	ErrPhaseNotTimed        = errors.New("timers run during the night, day and defense phases")
+	ErrNoTimer              = errors.New("there is no running timer")
+)
+
+// TimerWarnings are the remaining times at which the room is warned, largest first.
+// Warnings longer than the timer itself are skipped.
+var TimerWarnings = []time.Duration{30 * time.Second, 10 * time.Second}
+
+// PhaseTimer counts down the current phase. Only the deadline is stored, so a timer survives restarts.
+type PhaseTimer struct {
+	Phase       Phase // Type and number of the timed phase
+	Duration    time.Duration
+	Deadline    time.Time
+	AutoAdvance bool          // Move on to the next phase when the time is up
+	Warned      time.Duration // Smallest warning already sent; 0 before the first one
+	Expired     bool          // The time is up and the phase was not moved on
+}
+
+// TimerTick is what happened to a timer since the last tick
+type TimerTick struct {
+	Warning  time.Duration // Warning threshold reached by this tick, 0 if none
+	Expired  bool          // The time ran out on this tick
+	Advanced bool          // The phase was moved on because the time ran out
+}
+
+// IsRunning reports whether the timer is still counting down
+func (t *PhaseTimer) IsRunning() bool {
+	return t != nil && !t.Expired
+}
+
+// Remaining returns the time left, never below zero
+func (t *PhaseTimer) Remaining(now time.Time) time.Duration {
+	if !t.IsRunning() || !now.Before(t.Deadline) {
+		return 0
+	}
+	return t.Deadline.Sub(now)
+}
+
+// StartTimer starts, or restarts, the countdown of the current phase
+func (g *Game) StartTimer(now time.Time, duration time.Duration, autoAdvance bool) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	switch g.Phase.Type {
+	case PhaseNight, PhaseDay, PhaseDefense:
+	default:
+		return ErrPhaseNotTimed
+	}
+	if duration <= 0 {
+		return ErrInvalidTimerDuration
+	}
+	g.Timer = &PhaseTimer{
+		Phase:       Phase{Type: g.Phase.Type, Number: g.Phase.Number},
+		Duration:    duration,
+		Deadline:    now.Add(duration),
+		AutoAdvance: autoAdvance,
+	}
+	return nil
+}
+
+// StopTimer cancels the countdown of the current phase
+func (g *Game) StopTimer() error {
+	if !g.Timer.IsRunning() {
+		return ErrNoTimer
+	}
+	g.Timer = nil
+	return nil
+}
+
+// AutoAdvancePhase returns the phase a timed phase moves on to when its time is up
+func (g *Game) AutoAdvancePhase() PhaseType {
+	switch g.Phase.Type {
+	case PhaseNight:
+		return PhaseDay
+	case PhaseDay, PhaseDefense:
+		return PhaseVoting
+	default:
+		return PhaseNone
+	}
+}
+
+// TickTimer moves the timer forward to now. It reports the warning that became due, if any
+// (only the smallest when several were crossed at once), and whether the time ran out.
+// An expired auto-advance timer moves the game to the next phase.
+func (g *Game) TickTimer(now time.Time) (TimerTick, error) {
+	var tick TimerTick
+	timer := g.Timer
+	if !timer.IsRunning() {
+		return tick, nil
+	}
+
+	remaining := timer.Remaining(now)
+	if remaining == 0 {
+		tick.Expired = true
+		timer.Expired = true
+		if next := g.AutoAdvancePhase(); timer.AutoAdvance && g.CanTransitionTo(next) {
+			if err := g.AdvancePhase(next, now); err != nil {
+				return TimerTick{}, err
+			}
+			tick.Advanced = true
+		}
+		return tick, nil
+	}
+
+	for _, threshold := range TimerWarnings {
+		if threshold >= timer.Duration || remaining > threshold {
+			continue
+		}
+		if timer.Warned == 0 || threshold < timer.Warned {
+			tick.Warning = threshold
+		}
+	}
+	if tick.Warning > 0 {
+		timer.Warned = tick.Warning
+	}
+	return tick, nil
+}
@@ -0,0 +1,245 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// VoteOutcome records how a day vote ended
+type VoteOutcome string
+
+const (
+	// VoteOutcomeLynch means a single player got the most votes and was lynched
+	VoteOutcomeLynch VoteOutcome = "lynch"
+	// VoteOutcomeRevote means the vote tied and a new round among the leaders was opened
+	VoteOutcomeRevote VoteOutcome = "revote"
+	// VoteOutcomeDefense means the vote tied and the leaders were put on trial
+	VoteOutcomeDefense VoteOutcome = "defense"
+	// VoteOutcomeNoLynch means nobody was lynched (no votes, or a tie that is not broken)
+	VoteOutcomeNoLynch VoteOutcome = "no_lynch"
+	// VoteOutcomeCancelled means the phase moved on before the vote was closed
+	VoteOutcomeCancelled VoteOutcome = "cancelled"
+)
+
+var (
+	ErrNotVotingPhase      = errors.New("votes can only be opened during the voting phase")
+	ErrVoteAlreadyOpen     = errors.New("a vote is already open")
+	ErrVoteAlreadyDecided  = errors.New("today's vote is already decided")
+	ErrInvalidVoteDuration = errors.New("vote duration must be positive")
+	ErrNoOpenVote          = errors.New("there is no open vote")
+	ErrVoteClosed          = errors.New("the vote is closed")
+	ErrVoterNotAlive       = errors.New("only alive players can vote")
+	ErrInvalidVoteTarget   = errors.New("invalid vote target")
+)
+
+// Vote is a day vote. A game keeps its current vote until the next one is opened.
+type Vote struct {
+	Day        int                                         // Number of the day the vote belongs to
+	Round      int                                         // 1 for the first vote of the day; bumped by revotes and votes after a defense
+	Candidates []sharedEntity.UserID                       // Players who may be voted for
+	Ballots    map[sharedEntity.UserID]sharedEntity.UserID // Voter -> target; a voter may change their ballot until the vote closes
+	Duration   time.Duration
+	OpenedAt   time.Time
+	ClosesAt   time.Time
+	Closed     bool
+	Outcome    VoteOutcome           // Set when the vote is closed
+	Leaders    []sharedEntity.UserID // Players with the most votes, set when the vote is closed
+}
+
+// VoteCount is the tally of one candidate
+type VoteCount struct {
+	Target sharedEntity.UserID
+	Voters []sharedEntity.UserID
+}
+
+// IsOpen reports whether ballots are still accepted
+func (v *Vote) IsOpen() bool {
+	return v != nil && !v.Closed
+}
+
+// Expired reports whether an open vote has run out of time
+func (v *Vote) Expired(now time.Time) bool {
+	return v.IsOpen() && !now.Before(v.ClosesAt)
+}
+
+// IsCandidate reports whether a player may be voted for
+func (v *Vote) IsCandidate(userID sharedEntity.UserID) bool {
+	for _, c := range v.Candidates {
+		if c == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Tally counts the ballots of every candidate, most voted first
+func (v *Vote) Tally() []VoteCount {
+	counts := make([]VoteCount, 0, len(v.Candidates))
+	index := make(map[sharedEntity.UserID]int, len(v.Candidates))
+	for _, c := range v.Candidates {
+		index[c] = len(counts)
+		counts = append(counts, VoteCount{Target: c})
+	}
+	for voter, target := range v.Ballots {
+		if i, ok := index[target]; ok {
+			counts[i].Voters = append(counts[i].Voters, voter)
+		}
+	}
+	for i := range counts {
+		sort.Slice(counts[i].Voters, func(a, b int) bool { return counts[i].Voters[a] < counts[i].Voters[b] })
+	}
+	sort.SliceStable(counts, func(i, j int) bool { return len(counts[i].Voters) > len(counts[j].Voters) })
+	return counts
+}
+
+// leaders returns the candidates with the most votes, or none when nobody voted
+func (v *Vote) leaders() []sharedEntity.UserID {
+	var leaders []sharedEntity.UserID
+	tally := v.Tally()
+	if len(tally) == 0 || len(tally[0].Voters) == 0 {
+		return nil
+	}
+	for _, count := range tally {
+		if len(count.Voters) != len(tally[0].Voters) {
+			break
+		}
+		leaders = append(leaders, count.Target)
+	}
+	sort.Slice(leaders, func(i, j int) bool { return leaders[i] < leaders[j] })
+	return leaders
+}
+
+// OpenVote starts the day vote. All alive players are candidates, except after a defense,
+// when only the players on trial are.
+func (g *Game) OpenVote(now time.Time, duration time.Duration) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	if g.Phase.Type != PhaseVoting {
+		return ErrNotVotingPhase
+	}
+	if g.Vote.IsOpen() {
+		return ErrVoteAlreadyOpen
+	}
+	if duration <= 0 {
+		return ErrInvalidVoteDuration
+	}
+
+	vote := &Vote{Day: g.Phase.Number, Round: 1, Candidates: g.AlivePlayers()}
+	if prev := g.Vote; prev != nil && prev.Day == g.Phase.Number {
+		switch prev.Outcome {
+		case VoteOutcomeLynch, VoteOutcomeNoLynch:
+			return ErrVoteAlreadyDecided
+		case VoteOutcomeDefense:
+			vote.Round = prev.Round + 1
+			vote.Candidates = g.aliveAmong(prev.Leaders)
+		}
+	}
+	g.startVote(vote, now, duration)
+	return nil
+}
+
+// CastVote records or changes the ballot of an alive player
+func (g *Game) CastVote(voter, target sharedEntity.UserID, now time.Time) error {
+	if !g.Vote.IsOpen() {
+		return ErrNoOpenVote
+	}
+	if g.Vote.Expired(now) {
+		return ErrVoteClosed
+	}
+	if !g.IsAlive(voter) {
+		return ErrVoterNotAlive
+	}
+	if voter == target || !g.Vote.IsCandidate(target) || !g.IsAlive(target) {
+		return fmt.Errorf("%w: %d", ErrInvalidVoteTarget, target)
+	}
+	g.Vote.Ballots[voter] = target
+	return nil
+}
+
+// CloseVote ends the open vote and applies its result.
+// A single leader is lynched. A tie in the first round follows the scenario's vote_tie rule:
+// a revote among the leaders starts right away, a defense puts them on trial, or nobody is lynched.
+// A tie in any later round means nobody is lynched. The closed vote is returned.
+func (g *Game) CloseVote(now time.Time) (*Vote, error) {
+	if err := g.requireRunning(); err != nil {
+		return nil, err
+	}
+	if !g.Vote.IsOpen() {
+		return nil, ErrNoOpenVote
+	}
+
+	vote := g.Vote
+	vote.Closed = true
+	vote.Leaders = vote.leaders()
+	switch {
+	case len(vote.Leaders) == 0:
+		vote.Outcome = VoteOutcomeNoLynch
+	case len(vote.Leaders) == 1:
+		vote.Outcome = VoteOutcomeLynch
+		if err := g.Eliminate(vote.Leaders[0], DeathCauseLynched); err != nil {
+			return nil, err
+		}
+	case vote.Round > 1:
+		vote.Outcome = VoteOutcomeNoLynch
+	default:
+		vote.Outcome = g.tieOutcome()
+	}
+
+	switch vote.Outcome {
+	case VoteOutcomeRevote:
+		g.startVote(&Vote{Day: vote.Day, Round: vote.Round + 1, Candidates: vote.Leaders}, now, vote.Duration)
+	case VoteOutcomeDefense:
+		if err := g.AdvancePhase(PhaseDefense, now); err != nil {
+			return nil, err
+		}
+	}
+	return vote, nil
+}
+
+// cancelOpenVote closes a vote that was left open when the phase moved on
+func (g *Game) cancelOpenVote() {
+	if g.Vote.IsOpen() {
+		g.Vote.Closed = true
+		g.Vote.Outcome = VoteOutcomeCancelled
+	}
+}
+
+func (g *Game) startVote(vote *Vote, now time.Time, duration time.Duration) {
+	vote.Ballots = make(map[sharedEntity.UserID]sharedEntity.UserID)
+	vote.Duration = duration
+	vote.OpenedAt = now
+	vote.ClosesAt = now.Add(duration)
+	g.Vote = vote
+}
+
+// aliveAmong keeps the players of ids that are still alive
+func (g *Game) aliveAmong(ids []sharedEntity.UserID) []sharedEntity.UserID {
+	var alive []sharedEntity.UserID
+	for _, id := range ids {
+		if g.IsAlive(id) {
+			alive = append(alive, id)
+		}
+	}
+	return alive
+}
+
+// tieOutcome maps the scenario's vote_tie rule to the outcome of a first-round tie; defense is the default
+func (g *Game) tieOutcome() VoteOutcome {
+	if g.Scenario == nil {
+		return VoteOutcomeDefense
+	}
+	switch g.Scenario.VoteTie {
+	case scenarioEntity.VoteTieRevote:
+		return VoteOutcomeRevote
+	case scenarioEntity.VoteTieNoLynch:
+		return VoteOutcomeNoLynch
+	default:
+		return VoteOutcomeDefense
+	}
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+)
+
+var ErrUnknownSide = errors.New("the scenario has no such side")
+
+// HasWinner reports whether the game finished with at least one winning side
+func (g *Game) HasWinner() bool {
+	return len(g.Winners) > 0
+}
+
+// SideWon reports whether a side is among the winners
+func (g *Game) SideWon(side string) bool {
+	for _, w := range g.Winners {
+		if w == side {
+			return true
+		}
+	}
+	return false
+}
+
+// DeclareWinner finishes the game with a side the moderator judged to have won, e.g. a neutral
+// who reached a personal goal. Sides that survive to the end win alongside it.
+func (g *Game) DeclareWinner(side string) error {
+	if err := g.requireRunning(); err != nil {
+		return err
+	}
+	if g.Scenario == nil || !g.Scenario.HasSide(side) {
+		return fmt.Errorf("%w: %q", ErrUnknownSide, side)
+	}
+	g.finishWith([]string{side})
+	return nil
+}
+
+// checkWinConditions finishes the game if a side has met one of its eliminate or parity conditions.
+// Sides that meet a condition at the same time all win.
+func (g *Game) checkWinConditions() {
+	if g.Scenario == nil || g.requireRunning() != nil {
+		return
+	}
+	alive, assigned := g.sideCounts()
+	totalAlive := 0
+	for _, n := range alive {
+		totalAlive += n
+	}
+
+	var winners []string
+	for _, side := range g.Scenario.Sides {
+		for _, condition := range side.WinConditions {
+			if g.conditionMet(condition, side.Name, alive, assigned, totalAlive) {
+				winners = append(winners, side.Name)
+				break
+			}
+		}
+	}
+	if len(winners) > 0 {
+		g.finishWith(winners)
+	}
+}
+
+// conditionMet evaluates the conditions that can be decided from who is alive
+func (g *Game) conditionMet(condition scenarioEntity.WinCondition, side string, alive, assigned map[string]int, totalAlive int) bool {
+	switch condition.Type {
+	case scenarioEntity.WinEliminate:
+		// Sides nobody was dealt into do not count, but at least one listed side must have been in the game
+		played := false
+		for _, target := range condition.Sides {
+			if alive[target] > 0 {
+				return false
+			}
+			played = played || assigned[target] > 0
+		}
+		return played
+	case scenarioEntity.WinParity:
+		return alive[side] > 0 && alive[side] >= totalAlive-alive[side]
+	default:
+		return false
+	}
+}
+
+// finishWith ends the game with the given winners plus every survive side that still has alive players
+func (g *Game) finishWith(winners []string) {
+	alive, _ := g.sideCounts()
+	for _, side := range g.Scenario.Sides {
+		if alive[side.Name] == 0 || contains(winners, side.Name) {
+			continue
+		}
+		for _, condition := range side.WinConditions {
+			if condition.Type == scenarioEntity.WinSurvive {
+				winners = append(winners, side.Name)
+				break
+			}
+		}
+	}
+	g.Winners = winners
+	g.cancelOpenVote()
+	g.Timer = nil
+	g.Turns = nil
+	g.FinishGame()
+}
+
+// sideCounts counts the alive and the assigned players of every side
+func (g *Game) sideCounts() (alive, assigned map[string]int) {
+	alive = make(map[string]int)
+	assigned = make(map[string]int)
+	for userID, role := range g.Assignments {
+		assigned[role.Side]++
+		if g.Status(userID).Alive {
+			alive[role.Side]++
+		}
+	}
+	return alive, assigned
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package port
+
+import (
+	gameEntity "telemafia/internal/domain/game/entity"
+)
+
+// RoleSelectionReader defines the interface for reading saved "Choose Card" rounds
+type RoleSelectionReader interface {
+	// GetRoleSelection gets the saved round of a game
+	GetRoleSelection(gameID gameEntity.GameID) (*gameEntity.RoleSelection, error)
+
+	// GetAllRoleSelections gets every saved round, used to resume them on startup
+	GetAllRoleSelections() ([]*gameEntity.RoleSelection, error)
+}
+
+// RoleSelectionWriter defines the interface for writing "Choose Card" rounds
+type RoleSelectionWriter interface {
+	// SaveRoleSelection creates or replaces the saved round of a game
+	SaveRoleSelection(selection *gameEntity.RoleSelection) error
+
+	// DeleteRoleSelection removes the saved round of a game, if any
+	DeleteRoleSelection(gameID gameEntity.GameID) error
+}
+
+// RoleSelectionRepository defines the interface for "Choose Card" round persistence
+type RoleSelectionRepository interface {
+	RoleSelectionReader
+	RoleSelectionWriter
+}
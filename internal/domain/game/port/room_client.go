@@ -1,7 +1,10 @@
 package port
 
 import (
+	"context"
+
 	roomEntity "telemafia/internal/domain/room/entity"
+	sharedEntity "telemafia/internal/shared/entity"
 )
 
 // RoomClient defines an interface for the Game domain to fetch Room data.
@@ -9,3 +12,9 @@ import (
 type RoomClient interface {
 	FetchRoom(id roomEntity.RoomID) (*roomEntity.Room, error)
 }
+
+// RoomSeatClient defines an interface for the Game domain to take players out of a room.
+// The Room domain seats waitlisted users in their place and returns the saved room.
+type RoomSeatClient interface {
+	DropPlayers(ctx context.Context, roomID roomEntity.RoomID, playerIDs []sharedEntity.UserID) (room *roomEntity.Room, dropped []sharedEntity.UserID, promoted []*sharedEntity.User, err error)
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"sort"
 	gameEntity "telemafia/internal/domain/game/entity"
 	gamePort "telemafia/internal/domain/game/port"
 	roomPort "telemafia/internal/domain/room/port" // Use imported roomPort
@@ -39,6 +38,8 @@ func NewAssignRolesHandler(gameRepo gamePort.GameRepository, scenarioRepo scenar
 
 // Handle processes the assign roles command
 func (h *AssignRolesHandler) Handle(ctx context.Context, cmd AssignRolesCommand) (map[sharedEntity.User]scenarioEntity.Role, error) { // Updated return type
+	defer lockGame(cmd.GameID)()
+
 	// Get the game by ID
 	game, err := h.gameRepo.GetGameByID(cmd.GameID)
 	if err != nil {
@@ -69,27 +70,35 @@ func (h *AssignRolesHandler) Handle(ctx context.Context, cmd AssignRolesCommand)
 		return nil, fmt.Errorf("error fetching scenario '%s': %w", game.Scenario.ID, err)
 	}
 
-	// Fetch players from the game's room
+	// Fetch the room as it is now: roles are dealt from seat 1 onwards and the game keeps these seats
 	// Room presence already checked above
-	players, err := h.roomRepo.GetPlayersInRoom(game.Room.ID)
+	room, err := h.roomRepo.GetRoomByID(game.Room.ID)
 	if err != nil {
-		log.Printf("Error fetching players for room '%s': %v", game.Room.ID, err)
-		return nil, fmt.Errorf("error fetching players for room '%s': %w", game.Room.ID, err)
+		log.Printf("Error fetching room '%s': %v", game.Room.ID, err)
+		return nil, fmt.Errorf("error fetching room '%s': %w", game.Room.ID, err)
 	}
-	// Convert []*User to []User if needed by sorting/assignment logic
-	// Assuming the repository returns []*sharedEntity.User
-	users := make([]sharedEntity.User, 0, len(players))
-	for _, p := range players {
-		if p != nil { // Add nil check for safety
-			users = append(users, *p)
-		}
+	game.Room = room
+	users := make([]sharedEntity.User, 0, len(room.Players))
+	for _, p := range room.SeatOrder() {
+		users = append(users, *p)
+	}
+
+	// A ready-check, if one was run, must be complete
+	readyIDs := make([]sharedEntity.UserID, 0, len(users))
+	for _, user := range users {
+		readyIDs = append(readyIDs, user.ID)
+	}
+	if err := game.RequireReady(readyIDs); err != nil {
+		return nil, fmt.Errorf("assign roles: %w", err)
 	}
 
-	sort.Slice(users, func(i, j int) bool {
-		return users[i].ID < users[j].ID
-	})
 	log.Printf("Found %d players in room '%s'", len(users), game.Room.ID)
 
+	// The room may have changed since the scenario was picked
+	if err := scenario.CheckPlayerCount(len(users)); err != nil {
+		return nil, fmt.Errorf("assign roles: %w", err)
+	}
+
 	rolesToAssign := scenario.GetShuffledRoles(len(users))
 	// Ensure we have enough roles for the players
 	if len(rolesToAssign) != len(users) {
@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// CastVoteCommand records the ballot of the requester
+type CastVoteCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	TargetID  sharedEntity.UserID
+}
+
+// CastVoteHandler handles ballots
+type CastVoteHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewCastVoteHandler creates a new CastVoteHandler
+func NewCastVoteHandler(repo gamePort.GameRepository) *CastVoteHandler {
+	return &CastVoteHandler{gameRepo: repo}
+}
+
+// Handle records or changes the requester's ballot and returns the updated game
+func (h *CastVoteHandler) Handle(ctx context.Context, cmd CastVoteCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("cast vote: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("cast vote: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := game.CastVote(cmd.Requester.ID, cmd.TargetID, time.Now()); err != nil {
+		return nil, fmt.Errorf("cast vote: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("cast vote: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
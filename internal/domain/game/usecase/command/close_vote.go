@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// CloseVoteCommand closes the open day vote and applies its result
+type CloseVoteCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// CloseVoteHandler handles closing day votes
+type CloseVoteHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewCloseVoteHandler creates a new CloseVoteHandler
+func NewCloseVoteHandler(repo gamePort.GameRepository) *CloseVoteHandler {
+	return &CloseVoteHandler{gameRepo: repo}
+}
+
+// Handle closes the vote and returns the updated game together with the closed vote.
+// The moderator may close a vote early; once it has expired anyone may close it, which is how the refresh ticker ends votes.
+func (h *CloseVoteHandler) Handle(ctx context.Context, cmd CloseVoteCommand) (*gameEntity.Game, *gameEntity.Vote, error) {
+	if cmd.GameID == "" {
+		return nil, nil, errors.New("close vote: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("close vote: game '%s' not found: %w", cmd.GameID, err)
+	}
+	now := time.Now()
+	if !game.Vote.Expired(now) {
+		if err := requireGameModerator(game, cmd.Requester, "close vote"); err != nil {
+			return nil, nil, err
+		}
+	}
+	vote, err := game.CloseVote(now)
+	if err != nil {
+		return nil, nil, fmt.Errorf("close vote: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, nil, fmt.Errorf("close vote: failed to update game %s: %w", game.ID, err)
+	}
+	return game, vote, nil
+}
@@ -58,6 +58,10 @@ func (h *CreateGameHandler) Handle(ctx context.Context, cmd CreateGameCommand) (
 	if !cmd.Requester.Admin && !isRoomModerator {
 		return nil, errors.New("create game: permission denied (requires admin or room moderator)")
 	}
+	// The scenario must fit the room as it is now; roles are checked again when they are dealt
+	if err := scenario.CheckPlayerCount(len(room.SeatOrder())); err != nil {
+		return nil, fmt.Errorf("create game: %w", err)
+	}
 
 	// Create a new game entity
 	game := &gameEntity.Game{
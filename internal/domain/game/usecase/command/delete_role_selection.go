@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+)
+
+// DeleteRoleSelectionCommand forgets the saved "Choose Card" round of a game
+type DeleteRoleSelectionCommand struct {
+	GameID gameEntity.GameID
+}
+
+// DeleteRoleSelectionHandler handles removing saved "Choose Card" rounds
+type DeleteRoleSelectionHandler struct {
+	selectionRepo gamePort.RoleSelectionWriter
+}
+
+// NewDeleteRoleSelectionHandler creates a new DeleteRoleSelectionHandler
+func NewDeleteRoleSelectionHandler(repo gamePort.RoleSelectionWriter) *DeleteRoleSelectionHandler {
+	return &DeleteRoleSelectionHandler{selectionRepo: repo}
+}
+
+// Handle removes the saved round of the game; a game without one is not an error
+func (h *DeleteRoleSelectionHandler) Handle(ctx context.Context, cmd DeleteRoleSelectionCommand) error {
+	if cmd.GameID == "" {
+		return errors.New("delete role selection: game ID cannot be empty")
+	}
+	if err := h.selectionRepo.DeleteRoleSelection(cmd.GameID); err != nil {
+		return fmt.Errorf("delete role selection: game %s: %w", cmd.GameID, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// EliminatePlayerCommand marks a player of a running game as dead
+type EliminatePlayerCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	PlayerID  sharedEntity.UserID
+	Cause     gameEntity.DeathCause
+}
+
+// EliminatePlayerHandler handles player eliminations
+type EliminatePlayerHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewEliminatePlayerHandler creates a new EliminatePlayerHandler
+func NewEliminatePlayerHandler(repo gamePort.GameRepository) *EliminatePlayerHandler {
+	return &EliminatePlayerHandler{gameRepo: repo}
+}
+
+// Handle records the death of the player in the current phase and returns the updated game
+func (h *EliminatePlayerHandler) Handle(ctx context.Context, cmd EliminatePlayerCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("eliminate player: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("eliminate player: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "eliminate player"); err != nil {
+		return nil, err
+	}
+	if err := game.Eliminate(cmd.PlayerID, cmd.Cause); err != nil {
+		return nil, fmt.Errorf("eliminate player %d: %w", cmd.PlayerID, err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("eliminate player: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// EndGameCommand finishes a running game
+type EndGameCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	Winner    string // Side the moderator declares as the winner; empty ends the game without one
+}
+
+// EndGameHandler handles ending games
+type EndGameHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewEndGameHandler creates a new EndGameHandler
+func NewEndGameHandler(repo gamePort.GameRepository) *EndGameHandler {
+	return &EndGameHandler{gameRepo: repo}
+}
+
+// Handle moves the game to GameStateFinished, with the declared winner if any, and returns the updated game
+func (h *EndGameHandler) Handle(ctx context.Context, cmd EndGameCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("end game: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("end game: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "end game"); err != nil {
+		return nil, err
+	}
+	if cmd.Winner != "" {
+		err = game.DeclareWinner(cmd.Winner)
+	} else {
+		err = game.EndGame()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("end game: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("end game: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
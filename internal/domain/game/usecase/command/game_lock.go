@@ -0,0 +1,15 @@
+package command
+
+import (
+	gameEntity "telemafia/internal/domain/game/entity"
+	"telemafia/internal/shared/common"
+)
+
+// gameLocks serializes the load-modify-save cycles of every command that changes a game, since the
+// repositories save the whole game at once
+var gameLocks common.KeyedMutex[gameEntity.GameID]
+
+// lockGame blocks until no other command works on the game and returns the function that releases it
+func lockGame(id gameEntity.GameID) (unlock func()) {
+	return gameLocks.Lock(id)
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// GrantChallengeCommand gives the challenge of the current turn to a player who asked for it
+type GrantChallengeCommand struct {
+	Requester  sharedEntity.User
+	GameID     gameEntity.GameID
+	Challenger sharedEntity.UserID
+}
+
+// GrantChallengeHandler handles granting challenges
+type GrantChallengeHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewGrantChallengeHandler creates a new GrantChallengeHandler
+func NewGrantChallengeHandler(repo gamePort.GameRepository) *GrantChallengeHandler {
+	return &GrantChallengeHandler{gameRepo: repo}
+}
+
+// Handle grants the challenge and returns the updated game
+func (h *GrantChallengeHandler) Handle(ctx context.Context, cmd GrantChallengeCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("grant challenge: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("grant challenge: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "grant challenges"); err != nil {
+		return nil, err
+	}
+	if err := game.GrantChallenge(cmd.Challenger); err != nil {
+		return nil, fmt.Errorf("grant challenge: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("grant challenge: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// KickUnreadyPlayersCommand removes the players who did not confirm the ready-check from the room
+type KickUnreadyPlayersCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// KickUnreadyPlayersHandler handles dropping non-responders of a ready-check
+type KickUnreadyPlayersHandler struct {
+	gameRepo  gamePort.GameRepository
+	roomSeats gamePort.RoomSeatClient
+}
+
+// NewKickUnreadyPlayersHandler creates a new KickUnreadyPlayersHandler
+func NewKickUnreadyPlayersHandler(gameRepo gamePort.GameRepository, roomSeats gamePort.RoomSeatClient) *KickUnreadyPlayersHandler {
+	return &KickUnreadyPlayersHandler{gameRepo: gameRepo, roomSeats: roomSeats}
+}
+
+// Handle kicks the non-responders and returns the updated game with who left and who took their seats
+func (h *KickUnreadyPlayersHandler) Handle(ctx context.Context, cmd KickUnreadyPlayersCommand) (*gameEntity.Game, ReadyCheckDrop, error) {
+	if cmd.GameID == "" {
+		return nil, ReadyCheckDrop{}, errors.New("kick unready players: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, ReadyCheckDrop{}, fmt.Errorf("kick unready players: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "kick unready players"); err != nil {
+		return nil, ReadyCheckDrop{}, err
+	}
+	if game.ReadyCheck == nil || game.State != gameEntity.GameStateWaitingForPlayers {
+		return nil, ReadyCheckDrop{}, fmt.Errorf("kick unready players: %w", gameEntity.ErrNoReadyCheck)
+	}
+	drop, err := dropUnreadyPlayers(ctx, h.roomSeats, game)
+	if err != nil {
+		return nil, ReadyCheckDrop{}, fmt.Errorf("kick unready players: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, ReadyCheckDrop{}, fmt.Errorf("kick unready players: failed to update game %s: %w", game.ID, err)
+	}
+	return game, drop, nil
+}
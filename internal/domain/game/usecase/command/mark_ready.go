@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// MarkReadyCommand records that a player confirmed the ready-check
+type MarkReadyCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// MarkReadyHandler handles ready confirmations
+type MarkReadyHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewMarkReadyHandler creates a new MarkReadyHandler
+func NewMarkReadyHandler(repo gamePort.GameRepository) *MarkReadyHandler {
+	return &MarkReadyHandler{gameRepo: repo}
+}
+
+// Handle marks the requester as ready and returns the updated game
+func (h *MarkReadyHandler) Handle(ctx context.Context, cmd MarkReadyCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("mark ready: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("mark ready: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := game.MarkReady(cmd.Requester.ID); err != nil {
+		return nil, fmt.Errorf("mark ready: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("mark ready: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
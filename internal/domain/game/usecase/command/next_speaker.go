@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// NextSpeakerCommand ends the current speaking turn and gives the floor to the next player
+type NextSpeakerCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	Skip      bool // The current speaker is skipped rather than done
+}
+
+// NextSpeakerHandler handles moving the speaking turns on
+type NextSpeakerHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewNextSpeakerHandler creates a new NextSpeakerHandler
+func NewNextSpeakerHandler(repo gamePort.GameRepository) *NextSpeakerHandler {
+	return &NextSpeakerHandler{gameRepo: repo}
+}
+
+// Handle moves on to the next speaker and returns the updated game
+func (h *NextSpeakerHandler) Handle(ctx context.Context, cmd NextSpeakerCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("next speaker: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("next speaker: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "move speaking turns on"); err != nil {
+		return nil, err
+	}
+	if _, _, err := game.NextSpeaker(cmd.Skip); err != nil {
+		return nil, fmt.Errorf("next speaker: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("next speaker: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
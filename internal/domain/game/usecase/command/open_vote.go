@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// OpenVoteCommand opens the day vote for a limited time
+type OpenVoteCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	Duration  time.Duration
+}
+
+// OpenVoteHandler handles opening day votes
+type OpenVoteHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewOpenVoteHandler creates a new OpenVoteHandler
+func NewOpenVoteHandler(repo gamePort.GameRepository) *OpenVoteHandler {
+	return &OpenVoteHandler{gameRepo: repo}
+}
+
+// Handle opens the vote and returns the updated game
+func (h *OpenVoteHandler) Handle(ctx context.Context, cmd OpenVoteCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("open vote: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("open vote: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "open vote"); err != nil {
+		return nil, err
+	}
+	if err := game.OpenVote(time.Now(), cmd.Duration); err != nil {
+		return nil, fmt.Errorf("open vote: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("open vote: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// requireGameModerator checks that the requester is a global admin or the moderator of the game's room
+func requireGameModerator(game *gameEntity.Game, requester sharedEntity.User, action string) error {
+	if game.Room == nil {
+		return fmt.Errorf("%s: game has no associated room", action)
+	}
+	isRoomModerator := game.Room.Moderator != nil && game.Room.Moderator.ID == requester.ID
+	if !requester.Admin && !isRoomModerator {
+		return fmt.Errorf("%s: permission denied (requires admin or room moderator)", action)
+	}
+	return nil
+}
+
+// advanceGamePhase loads a game, validates the transition to next and persists it
+func advanceGamePhase(repo gamePort.GameRepository, requester sharedEntity.User, gameID gameEntity.GameID, next gameEntity.PhaseType, action string) (*gameEntity.Game, error) {
+	if gameID == "" {
+		return nil, errors.New(action + ": game ID cannot be empty")
+	}
+	defer lockGame(gameID)()
+
+	game, err := repo.GetGameByID(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: game '%s' not found: %w", action, gameID, err)
+	}
+	if err := requireGameModerator(game, requester, action); err != nil {
+		return nil, err
+	}
+	if err := game.AdvancePhase(next, time.Now()); err != nil {
+		return nil, fmt.Errorf("%s: %w", action, err)
+	}
+	if err := repo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("%s: failed to update game %s: %w", action, game.ID, err)
+	}
+	return game, nil
+}
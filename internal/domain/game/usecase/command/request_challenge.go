@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// RequestChallengeCommand asks the current speaker for a challenge on behalf of the requester
+type RequestChallengeCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// RequestChallengeHandler handles challenge requests from players
+type RequestChallengeHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewRequestChallengeHandler creates a new RequestChallengeHandler
+func NewRequestChallengeHandler(repo gamePort.GameRepository) *RequestChallengeHandler {
+	return &RequestChallengeHandler{gameRepo: repo}
+}
+
+// Handle records the request and returns the updated game
+func (h *RequestChallengeHandler) Handle(ctx context.Context, cmd RequestChallengeCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("request challenge: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("request challenge: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := game.RequestChallenge(cmd.Requester.ID); err != nil {
+		return nil, fmt.Errorf("request challenge: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("request challenge: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// ResolveNightCommand resolves tonight's actions of a game
+type ResolveNightCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// ResolveNightHandler handles night resolutions
+type ResolveNightHandler struct {
+	gameRepo gamePort.GameRepository
+	resolver *gameEntity.NightResolver
+}
+
+// NewResolveNightHandler creates a new ResolveNightHandler
+func NewResolveNightHandler(repo gamePort.GameRepository, resolver *gameEntity.NightResolver) *ResolveNightHandler {
+	return &ResolveNightHandler{gameRepo: repo, resolver: resolver}
+}
+
+// Handle applies tonight's actions and returns the updated game with the outcome of the night
+func (h *ResolveNightHandler) Handle(ctx context.Context, cmd ResolveNightCommand) (*gameEntity.Game, gameEntity.NightResolution, error) {
+	if cmd.GameID == "" {
+		return nil, gameEntity.NightResolution{}, errors.New("resolve night: game ID cannot be empty")
+	}
+	// Shares the lock of submissions so no action slips in while the night is resolved
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, gameEntity.NightResolution{}, fmt.Errorf("resolve night: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "resolve night"); err != nil {
+		return nil, gameEntity.NightResolution{}, err
+	}
+	resolution, err := game.ResolveNight(h.resolver)
+	if err != nil {
+		return nil, gameEntity.NightResolution{}, fmt.Errorf("resolve night: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, gameEntity.NightResolution{}, fmt.Errorf("resolve night: failed to update game %s: %w", game.ID, err)
+	}
+	return game, resolution, nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// RevivePlayerCommand brings a dead player back into a running game
+type RevivePlayerCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	PlayerID  sharedEntity.UserID
+}
+
+// RevivePlayerHandler handles player revivals
+type RevivePlayerHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewRevivePlayerHandler creates a new RevivePlayerHandler
+func NewRevivePlayerHandler(repo gamePort.GameRepository) *RevivePlayerHandler {
+	return &RevivePlayerHandler{gameRepo: repo}
+}
+
+// Handle clears the death of the player and returns the updated game
+func (h *RevivePlayerHandler) Handle(ctx context.Context, cmd RevivePlayerCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("revive player: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("revive player: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "revive player"); err != nil {
+		return nil, err
+	}
+	if err := game.Revive(cmd.PlayerID); err != nil {
+		return nil, fmt.Errorf("revive player %d: %w", cmd.PlayerID, err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("revive player: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
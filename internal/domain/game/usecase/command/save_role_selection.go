@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+)
+
+// SaveRoleSelectionCommand stores the current state of a "Choose Card" round
+type SaveRoleSelectionCommand struct {
+	Selection *gameEntity.RoleSelection
+}
+
+// SaveRoleSelectionHandler handles saving "Choose Card" rounds so they survive restarts
+type SaveRoleSelectionHandler struct {
+	selectionRepo gamePort.RoleSelectionWriter
+}
+
+// NewSaveRoleSelectionHandler creates a new SaveRoleSelectionHandler
+func NewSaveRoleSelectionHandler(repo gamePort.RoleSelectionWriter) *SaveRoleSelectionHandler {
+	return &SaveRoleSelectionHandler{selectionRepo: repo}
+}
+
+// Handle creates or replaces the saved round of the selection's game
+func (h *SaveRoleSelectionHandler) Handle(ctx context.Context, cmd SaveRoleSelectionCommand) error {
+	if cmd.Selection == nil || cmd.Selection.GameID == "" {
+		return errors.New("save role selection: game ID cannot be empty")
+	}
+	if err := h.selectionRepo.SaveRoleSelection(cmd.Selection); err != nil {
+		return fmt.Errorf("save role selection: game %s: %w", cmd.Selection.GameID, err)
+	}
+	return nil
+}
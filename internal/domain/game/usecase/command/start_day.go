@@ -0,0 +1,30 @@
+package command
+
+import (
+	"context"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartDayCommand moves a game from the night (or the start) into the day
+type StartDayCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// StartDayHandler handles day transitions
+type StartDayHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewStartDayHandler creates a new StartDayHandler
+func NewStartDayHandler(repo gamePort.GameRepository) *StartDayHandler {
+	return &StartDayHandler{gameRepo: repo}
+}
+
+// Handle moves the game into the day phase and returns the updated game
+func (h *StartDayHandler) Handle(ctx context.Context, cmd StartDayCommand) (*gameEntity.Game, error) {
+	return advanceGamePhase(h.gameRepo, cmd.Requester, cmd.GameID, gameEntity.PhaseDay, "start day")
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"context"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartDefenseCommand gives the players on trial their defense speeches
+type StartDefenseCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// StartDefenseHandler handles defense transitions
+type StartDefenseHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewStartDefenseHandler creates a new StartDefenseHandler
+func NewStartDefenseHandler(repo gamePort.GameRepository) *StartDefenseHandler {
+	return &StartDefenseHandler{gameRepo: repo}
+}
+
+// Handle moves the game into the defense phase and returns the updated game
+func (h *StartDefenseHandler) Handle(ctx context.Context, cmd StartDefenseCommand) (*gameEntity.Game, error) {
+	return advanceGamePhase(h.gameRepo, cmd.Requester, cmd.GameID, gameEntity.PhaseDefense, "start defense")
+}
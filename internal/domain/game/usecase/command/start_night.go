@@ -0,0 +1,30 @@
+package command
+
+import (
+	"context"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartNightCommand moves a game into the next night
+type StartNightCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// StartNightHandler handles night transitions
+type StartNightHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewStartNightHandler creates a new StartNightHandler
+func NewStartNightHandler(repo gamePort.GameRepository) *StartNightHandler {
+	return &StartNightHandler{gameRepo: repo}
+}
+
+// Handle moves the game into the night phase and returns the updated game
+func (h *StartNightHandler) Handle(ctx context.Context, cmd StartNightCommand) (*gameEntity.Game, error) {
+	return advanceGamePhase(h.gameRepo, cmd.Requester, cmd.GameID, gameEntity.PhaseNight, "start night")
+}
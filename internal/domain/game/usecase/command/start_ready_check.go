@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	roomPort "telemafia/internal/domain/room/port"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartReadyCheckCommand asks every player of the game's room to confirm they are ready
+type StartReadyCheckCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	Timeout   time.Duration // Non-responders are dropped from the room when it runs out; 0 for no timeout
+}
+
+// StartReadyCheckHandler handles starting ready-checks
+type StartReadyCheckHandler struct {
+	gameRepo gamePort.GameRepository
+	roomRepo roomPort.RoomReader
+	clock    common.Clock
+}
+
+// NewStartReadyCheckHandler creates a new StartReadyCheckHandler
+func NewStartReadyCheckHandler(gameRepo gamePort.GameRepository, roomRepo roomPort.RoomReader, clock common.Clock) *StartReadyCheckHandler {
+	return &StartReadyCheckHandler{gameRepo: gameRepo, roomRepo: roomRepo, clock: clock}
+}
+
+// Handle starts, or restarts, the ready-check and returns the updated game
+func (h *StartReadyCheckHandler) Handle(ctx context.Context, cmd StartReadyCheckCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("start ready-check: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("start ready-check: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "start ready-check"); err != nil {
+		return nil, err
+	}
+	players, err := h.roomRepo.GetPlayersInRoom(game.Room.ID)
+	if err != nil {
+		return nil, fmt.Errorf("start ready-check: failed to fetch players of room %s: %w", game.Room.ID, err)
+	}
+	var ids []sharedEntity.UserID
+	for _, p := range players {
+		if p != nil {
+			ids = append(ids, p.ID)
+		}
+	}
+	if err := game.StartReadyCheck(ids, h.clock.Now(), cmd.Timeout); err != nil {
+		return nil, fmt.Errorf("start ready-check: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("start ready-check: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
+
+// ReadyCheckDrop is who left the room because they did not confirm in time, and who took their seats
+type ReadyCheckDrop struct {
+	Kicked   []sharedEntity.UserID
+	Promoted []*sharedEntity.User // Waitlisted users seated in their place; they join the ready-check
+}
+
+// dropUnreadyPlayers removes the players who did not confirm from the game's room and asks the waitlisted
+// users seated in their place. The room is saved by the room module; saving the game is left to the caller.
+func dropUnreadyPlayers(ctx context.Context, seats gamePort.RoomSeatClient, game *gameEntity.Game) (ReadyCheckDrop, error) {
+	var drop ReadyCheckDrop
+	waiting := game.ReadyCheck.Waiting()
+	if len(waiting) == 0 {
+		return drop, nil
+	}
+	room, kicked, promoted, err := seats.DropPlayers(ctx, game.Room.ID, waiting)
+	if err != nil {
+		return ReadyCheckDrop{}, err
+	}
+	for _, userID := range waiting { // Players who already left are just forgotten
+		game.RemoveFromReadyCheck(userID)
+	}
+	for _, p := range promoted {
+		game.AddToReadyCheck(p.ID)
+	}
+	drop.Kicked, drop.Promoted = kicked, promoted
+	game.Room = room
+	return drop, nil
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartSpeakingTurnsCommand starts the talk order of the current day or defense
+type StartSpeakingTurnsCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// StartSpeakingTurnsHandler handles starting speaking turns
+type StartSpeakingTurnsHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewStartSpeakingTurnsHandler creates a new StartSpeakingTurnsHandler
+func NewStartSpeakingTurnsHandler(repo gamePort.GameRepository) *StartSpeakingTurnsHandler {
+	return &StartSpeakingTurnsHandler{gameRepo: repo}
+}
+
+// Handle starts, or restarts, the speaking turns and returns the updated game
+func (h *StartSpeakingTurnsHandler) Handle(ctx context.Context, cmd StartSpeakingTurnsCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("start speaking turns: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("start speaking turns: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "start speaking turns"); err != nil {
+		return nil, err
+	}
+	if err := game.StartSpeakingTurns(); err != nil {
+		return nil, fmt.Errorf("start speaking turns: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("start speaking turns: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartTimerCommand starts the countdown of the current phase
+type StartTimerCommand struct {
+	Requester   sharedEntity.User
+	GameID      gameEntity.GameID
+	Duration    time.Duration
+	AutoAdvance bool
+}
+
+// StartTimerHandler handles starting phase timers
+type StartTimerHandler struct {
+	gameRepo gamePort.GameRepository
+	clock    common.Clock
+}
+
+// NewStartTimerHandler creates a new StartTimerHandler
+func NewStartTimerHandler(repo gamePort.GameRepository, clock common.Clock) *StartTimerHandler {
+	return &StartTimerHandler{gameRepo: repo, clock: clock}
+}
+
+// Handle starts, or restarts, the timer and returns the updated game
+func (h *StartTimerHandler) Handle(ctx context.Context, cmd StartTimerCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("start timer: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("start timer: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "start timer"); err != nil {
+		return nil, err
+	}
+	if err := game.StartTimer(h.clock.Now(), cmd.Duration, cmd.AutoAdvance); err != nil {
+		return nil, fmt.Errorf("start timer: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("start timer: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
@@ -0,0 +1,30 @@
+package command
+
+import (
+	"context"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StartVotingCommand opens the day vote
+type StartVotingCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// StartVotingHandler handles voting transitions
+type StartVotingHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewStartVotingHandler creates a new StartVotingHandler
+func NewStartVotingHandler(repo gamePort.GameRepository) *StartVotingHandler {
+	return &StartVotingHandler{gameRepo: repo}
+}
+
+// Handle moves the game into the voting phase and returns the updated game
+func (h *StartVotingHandler) Handle(ctx context.Context, cmd StartVotingCommand) (*gameEntity.Game, error) {
+	return advanceGamePhase(h.gameRepo, cmd.Requester, cmd.GameID, gameEntity.PhaseVoting, "start voting")
+}
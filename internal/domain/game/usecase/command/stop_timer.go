@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// StopTimerCommand cancels the countdown of the current phase
+type StopTimerCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+}
+
+// StopTimerHandler handles stopping phase timers
+type StopTimerHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewStopTimerHandler creates a new StopTimerHandler
+func NewStopTimerHandler(repo gamePort.GameRepository) *StopTimerHandler {
+	return &StopTimerHandler{gameRepo: repo}
+}
+
+// Handle stops the timer and returns the updated game
+func (h *StopTimerHandler) Handle(ctx context.Context, cmd StopTimerCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("stop timer: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("stop timer: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := requireGameModerator(game, cmd.Requester, "stop timer"); err != nil {
+		return nil, err
+	}
+	if err := game.StopTimer(); err != nil {
+		return nil, fmt.Errorf("stop timer: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("stop timer: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
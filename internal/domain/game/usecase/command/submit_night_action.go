@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// SubmitNightActionCommand records the requester's use of an ability tonight
+type SubmitNightActionCommand struct {
+	Requester sharedEntity.User
+	GameID    gameEntity.GameID
+	Ability   string
+	Targets   []sharedEntity.UserID
+}
+
+// SubmitNightActionHandler handles night action submissions
+type SubmitNightActionHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewSubmitNightActionHandler creates a new SubmitNightActionHandler
+func NewSubmitNightActionHandler(repo gamePort.GameRepository) *SubmitNightActionHandler {
+	return &SubmitNightActionHandler{gameRepo: repo}
+}
+
+// Handle records or replaces the requester's action and returns the updated game
+func (h *SubmitNightActionHandler) Handle(ctx context.Context, cmd SubmitNightActionCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("submit night action: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("submit night action: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if err := game.SubmitNightAction(cmd.Requester.ID, cmd.Ability, cmd.Targets); err != nil {
+		return nil, fmt.Errorf("submit night action: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("submit night action: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
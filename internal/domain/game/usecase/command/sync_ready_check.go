@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	roomEntity "telemafia/internal/domain/room/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// SyncReadyCheckCommand tells the open ready-check of a room's game who took and who gave up a seat.
+// It is issued by the bot after joins, leaves, kicks and waitlist promotions, so it carries no requester.
+type SyncReadyCheckCommand struct {
+	RoomID   roomEntity.RoomID
+	Seated   []sharedEntity.UserID // Players who took a seat; they are asked to confirm too
+	Unseated []sharedEntity.UserID // Players who left their seat; they are no longer waited for
+}
+
+// SyncReadyCheckHandler keeps the ready-check of a game in line with the seats of its room
+type SyncReadyCheckHandler struct {
+	gameRepo gamePort.GameRepository
+}
+
+// NewSyncReadyCheckHandler creates a new SyncReadyCheckHandler
+func NewSyncReadyCheckHandler(repo gamePort.GameRepository) *SyncReadyCheckHandler {
+	return &SyncReadyCheckHandler{gameRepo: repo}
+}
+
+// Handle updates the ready-check and returns the updated game, or nil when the room has no game with an open check
+func (h *SyncReadyCheckHandler) Handle(ctx context.Context, cmd SyncReadyCheckCommand) (*gameEntity.Game, error) {
+	if cmd.RoomID == "" {
+		return nil, errors.New("sync ready-check: room ID cannot be empty")
+	}
+	if len(cmd.Seated) == 0 && len(cmd.Unseated) == 0 {
+		return nil, nil
+	}
+	// Most rooms have no game yet, which leaves nothing to sync
+	found, err := h.gameRepo.GetGameByRoomID(cmd.RoomID)
+	if err != nil || !hasOpenReadyCheck(found) {
+		return nil, nil
+	}
+	defer lockGame(found.ID)()
+
+	game, err := h.gameRepo.GetGameByID(found.ID)
+	if err != nil {
+		return nil, fmt.Errorf("sync ready-check: game '%s' not found: %w", found.ID, err)
+	}
+	if !hasOpenReadyCheck(game) {
+		return nil, nil
+	}
+	for _, userID := range cmd.Unseated {
+		game.RemoveFromReadyCheck(userID)
+	}
+	for _, userID := range cmd.Seated {
+		game.AddToReadyCheck(userID)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("sync ready-check: failed to update game %s: %w", game.ID, err)
+	}
+	return game, nil
+}
+
+// hasOpenReadyCheck reports whether the players of a game are still being asked to confirm
+func hasOpenReadyCheck(game *gameEntity.Game) bool {
+	return game != nil && game.ReadyCheck != nil && game.State == gameEntity.GameStateWaitingForPlayers
+}
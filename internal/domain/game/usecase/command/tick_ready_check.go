@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	"telemafia/internal/shared/common"
+)
+
+// TickReadyCheckCommand checks whether the ready-check of a game ran out of time
+type TickReadyCheckCommand struct {
+	GameID gameEntity.GameID
+}
+
+// TickReadyCheckHandler drives ready-check timeouts: it is called periodically and, once the deadline
+// passes, drops the players who did not confirm. Like phase timers it relies on the stored deadline only.
+type TickReadyCheckHandler struct {
+	gameRepo  gamePort.GameRepository
+	roomSeats gamePort.RoomSeatClient
+	clock     common.Clock
+}
+
+// NewTickReadyCheckHandler creates a new TickReadyCheckHandler
+func NewTickReadyCheckHandler(gameRepo gamePort.GameRepository, roomSeats gamePort.RoomSeatClient, clock common.Clock) *TickReadyCheckHandler {
+	return &TickReadyCheckHandler{gameRepo: gameRepo, roomSeats: roomSeats, clock: clock}
+}
+
+// Handle returns the game, whether the check timed out on this tick and who was dropped; the game is saved only when it did
+func (h *TickReadyCheckHandler) Handle(ctx context.Context, cmd TickReadyCheckCommand) (*gameEntity.Game, bool, ReadyCheckDrop, error) {
+	if cmd.GameID == "" {
+		return nil, false, ReadyCheckDrop{}, errors.New("tick ready-check: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, false, ReadyCheckDrop{}, fmt.Errorf("tick ready-check: game '%s' not found: %w", cmd.GameID, err)
+	}
+	if game.State != gameEntity.GameStateWaitingForPlayers || !game.ExpireReadyCheck(h.clock.Now()) {
+		return game, false, ReadyCheckDrop{}, nil
+	}
+	drop, err := dropUnreadyPlayers(ctx, h.roomSeats, game)
+	if err != nil {
+		return nil, false, ReadyCheckDrop{}, fmt.Errorf("tick ready-check: %w", err)
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, false, ReadyCheckDrop{}, fmt.Errorf("tick ready-check: failed to update game %s: %w", game.ID, err)
+	}
+	return game, true, drop, nil
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	"telemafia/internal/shared/common"
+)
+
+// TickTimerCommand moves the timer of a game forward to the current time
+type TickTimerCommand struct {
+	GameID gameEntity.GameID
+}
+
+// TickTimerHandler drives phase timers: it is called periodically and reports warnings,
+// expiries and automatic phase changes. It keeps no state of its own, so ticks after a
+// restart pick up from the stored deadlines.
+type TickTimerHandler struct {
+	gameRepo gamePort.GameRepository
+	clock    common.Clock
+}
+
+// NewTickTimerHandler creates a new TickTimerHandler
+func NewTickTimerHandler(repo gamePort.GameRepository, clock common.Clock) *TickTimerHandler {
+	return &TickTimerHandler{gameRepo: repo, clock: clock}
+}
+
+// Handle ticks the timer and returns the game with what happened; the game is saved only when something did
+func (h *TickTimerHandler) Handle(ctx context.Context, cmd TickTimerCommand) (*gameEntity.Game, gameEntity.TimerTick, error) {
+	if cmd.GameID == "" {
+		return nil, gameEntity.TimerTick{}, errors.New("tick timer: game ID cannot be empty")
+	}
+	defer lockGame(cmd.GameID)()
+
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, gameEntity.TimerTick{}, fmt.Errorf("tick timer: game '%s' not found: %w", cmd.GameID, err)
+	}
+	tick, err := game.TickTimer(h.clock.Now())
+	if err != nil {
+		return nil, gameEntity.TimerTick{}, fmt.Errorf("tick timer: %w", err)
+	}
+	if tick == (gameEntity.TimerTick{}) {
+		return game, tick, nil
+	}
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, gameEntity.TimerTick{}, fmt.Errorf("tick timer: failed to update game %s: %w", game.ID, err)
+	}
+	return game, tick, nil
+}
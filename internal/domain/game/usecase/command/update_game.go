@@ -2,15 +2,18 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gameEntity "telemafia/internal/domain/game/entity"
 	gamePort "telemafia/internal/domain/game/port"
 )
 
 // UpdateGameCommand represents the command to update a game entity.
-// It includes the full game object to allow updating any field.
+// Apply is called on the stored game while no other command works on it, so changes made
+// by other commands since the caller last read the game are kept.
 type UpdateGameCommand struct {
-	Game *gameEntity.Game
+	GameID gameEntity.GameID
+	Apply  func(game *gameEntity.Game)
 	// Requester sharedEntity.User // Optional: Add requester if permission needed
 }
 
@@ -26,17 +29,26 @@ func NewUpdateGameHandler(repo gamePort.GameRepository) *UpdateGameHandler {
 	}
 }
 
-// Handle processes the update game command.
-func (h *UpdateGameHandler) Handle(ctx context.Context, cmd UpdateGameCommand) error {
-	if cmd.Game == nil {
-		return fmt.Errorf("update game: game data cannot be nil")
+// Handle processes the update game command and returns the updated game.
+func (h *UpdateGameHandler) Handle(ctx context.Context, cmd UpdateGameCommand) (*gameEntity.Game, error) {
+	if cmd.GameID == "" {
+		return nil, errors.New("update game: game ID cannot be empty")
 	}
+	if cmd.Apply == nil {
+		return nil, fmt.Errorf("update game: no change given for game %s", cmd.GameID)
+	}
+	defer lockGame(cmd.GameID)()
 
 	// Optional: Add permission checks here if needed based on the Requester.
 	// For now, assume permission is checked upstream or not required for simple updates.
 
-	if err := h.gameRepo.UpdateGame(cmd.Game); err != nil {
-		return fmt.Errorf("update game: failed to update game %s: %w", cmd.Game.ID, err)
+	game, err := h.gameRepo.GetGameByID(cmd.GameID)
+	if err != nil {
+		return nil, fmt.Errorf("update game: game '%s' not found: %w", cmd.GameID, err)
+	}
+	cmd.Apply(game)
+	if err := h.gameRepo.UpdateGame(game); err != nil {
+		return nil, fmt.Errorf("update game: failed to update game %s: %w", game.ID, err)
 	}
-	return nil
+	return game, nil
 }
@@ -0,0 +1,30 @@
+package query
+
+import (
+	"context"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	roomEntity "telemafia/internal/domain/room/entity"
+)
+
+// GetGameByRoomIDQuery represents the query to get the current game of a room
+type GetGameByRoomIDQuery struct {
+	RoomID roomEntity.RoomID
+}
+
+// GetGameByRoomIDHandler handles queries for the game of a room
+type GetGameByRoomIDHandler struct {
+	gameRepo gamePort.GameReader
+}
+
+// NewGetGameByRoomIDHandler creates a new GetGameByRoomIDHandler
+func NewGetGameByRoomIDHandler(repo gamePort.GameReader) *GetGameByRoomIDHandler {
+	return &GetGameByRoomIDHandler{
+		gameRepo: repo,
+	}
+}
+
+// Handle processes the get game by room ID query; it fails when the room has no game
+func (h *GetGameByRoomIDHandler) Handle(ctx context.Context, query GetGameByRoomIDQuery) (*gameEntity.Game, error) {
+	return h.gameRepo.GetGameByRoomID(query.RoomID)
+}
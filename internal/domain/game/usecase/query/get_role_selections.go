@@ -0,0 +1,31 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+)
+
+// GetRoleSelectionsQuery asks for every saved "Choose Card" round
+type GetRoleSelectionsQuery struct{}
+
+// GetRoleSelectionsHandler handles loading saved "Choose Card" rounds, e.g. to resume them on startup
+type GetRoleSelectionsHandler struct {
+	selectionRepo gamePort.RoleSelectionReader
+}
+
+// NewGetRoleSelectionsHandler creates a new GetRoleSelectionsHandler
+func NewGetRoleSelectionsHandler(repo gamePort.RoleSelectionReader) *GetRoleSelectionsHandler {
+	return &GetRoleSelectionsHandler{selectionRepo: repo}
+}
+
+// Handle returns the saved rounds of all games
+func (h *GetRoleSelectionsHandler) Handle(ctx context.Context, query GetRoleSelectionsQuery) ([]*gameEntity.RoleSelection, error) {
+	selections, err := h.selectionRepo.GetAllRoleSelections()
+	if err != nil {
+		return nil, fmt.Errorf("get role selections: %w", err)
+	}
+	return selections, nil
+}
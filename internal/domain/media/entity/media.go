@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+var ErrMediaNotCached = errors.New("media not cached")
+
+// CachedMedia remembers the Telegram file a local media file was uploaded as.
+// File IDs only work with the bot that uploaded them, so the cache is kept per bot.
+type CachedMedia struct {
+	BotID    int64  // Telegram ID of the bot that uploaded the file
+	Path     string // Path of the file, relative to the media directory
+	Checksum string // Checksum of the uploaded content; a changed file is uploaded again
+	FileID   string // Telegram file_id to reuse instead of uploading
+}
+
+// Checksum identifies the content of a media file
+func Checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
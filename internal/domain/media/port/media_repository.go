@@ -0,0 +1,29 @@
+package port
+
+import (
+	mediaEntity "telemafia/internal/domain/media/entity"
+)
+
+// MediaCacheReader defines the interface for reading uploaded media
+type MediaCacheReader interface {
+	// GetCachedMedia gets the upload of a file by a bot, returning mediaEntity.ErrMediaNotCached if there is none
+	GetCachedMedia(botID int64, path string) (*mediaEntity.CachedMedia, error)
+}
+
+// MediaCacheWriter defines the interface for remembering uploaded media
+type MediaCacheWriter interface {
+	// SaveCachedMedia creates or replaces the upload of a file by a bot
+	SaveCachedMedia(media *mediaEntity.CachedMedia) error
+}
+
+// MediaCacheRepository defines the interface for the media cache
+type MediaCacheRepository interface {
+	MediaCacheReader
+	MediaCacheWriter
+}
+
+// MediaSource defines the interface for reading local media files
+type MediaSource interface {
+	// ReadMedia returns the content of a file, given relative to the media directory
+	ReadMedia(path string) ([]byte, error)
+}
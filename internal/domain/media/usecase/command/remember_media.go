@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mediaEntity "telemafia/internal/domain/media/entity"
+	mediaPort "telemafia/internal/domain/media/port"
+	"telemafia/internal/shared/common"
+)
+
+// RememberMediaCommand stores the Telegram file a bot uploaded a media file as
+type RememberMediaCommand struct {
+	BotID    int64
+	Path     string
+	Checksum string
+	FileID   string
+}
+
+// RememberMediaHandler handles caching uploaded media
+type RememberMediaHandler struct {
+	cache mediaPort.MediaCacheWriter
+}
+
+// NewRememberMediaHandler creates a new RememberMediaHandler
+func NewRememberMediaHandler(cache mediaPort.MediaCacheWriter) *RememberMediaHandler {
+	return &RememberMediaHandler{cache: cache}
+}
+
+// Handle saves the upload so later sends reuse the file ID
+func (h *RememberMediaHandler) Handle(ctx context.Context, cmd RememberMediaCommand) error {
+	if cmd.FileID == "" {
+		return errors.New("remember media: file ID cannot be empty")
+	}
+	path, err := common.CleanMediaPath(cmd.Path)
+	if err != nil {
+		return fmt.Errorf("remember media: %w", err)
+	}
+	media := &mediaEntity.CachedMedia{BotID: cmd.BotID, Path: path, Checksum: cmd.Checksum, FileID: cmd.FileID}
+	if err := h.cache.SaveCachedMedia(media); err != nil {
+		return fmt.Errorf("remember media '%s': %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mediaEntity "telemafia/internal/domain/media/entity"
+	mediaPort "telemafia/internal/domain/media/port"
+	"telemafia/internal/shared/common"
+)
+
+// ResolveMediaQuery asks how a bot should send a local media file
+type ResolveMediaQuery struct {
+	BotID int64
+	Path  string
+}
+
+// ResolvedMedia is either a cached Telegram file ID or the content to upload.
+// After an upload, the file ID is remembered with RememberMediaCommand using Path and Checksum.
+type ResolvedMedia struct {
+	Path     string // Cleaned path of the file
+	Checksum string
+	FileID   string // Set when the bot already uploaded this content
+	Data     []byte // Set when the content still has to be uploaded
+}
+
+// ResolveMediaHandler handles resolving media files
+type ResolveMediaHandler struct {
+	cache  mediaPort.MediaCacheReader
+	source mediaPort.MediaSource
+}
+
+// NewResolveMediaHandler creates a new ResolveMediaHandler
+func NewResolveMediaHandler(cache mediaPort.MediaCacheReader, source mediaPort.MediaSource) *ResolveMediaHandler {
+	return &ResolveMediaHandler{cache: cache, source: source}
+}
+
+// Handle reads the file and returns its cached upload when the content did not change since
+func (h *ResolveMediaHandler) Handle(ctx context.Context, query ResolveMediaQuery) (*ResolvedMedia, error) {
+	path, err := common.CleanMediaPath(query.Path)
+	if err != nil {
+		return nil, fmt.Errorf("resolve media '%s': %w", query.Path, err)
+	}
+	data, err := h.source.ReadMedia(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolve media '%s': %w", path, err)
+	}
+	resolved := &ResolvedMedia{Path: path, Checksum: mediaEntity.Checksum(data)}
+
+	cached, err := h.cache.GetCachedMedia(query.BotID, path)
+	switch {
+	case err == nil && cached.Checksum == resolved.Checksum && cached.FileID != "":
+		resolved.FileID = cached.FileID
+	case err == nil || errors.Is(err, mediaEntity.ErrMediaNotCached):
+		resolved.Data = data
+	default:
+		return nil, fmt.Errorf("resolve media '%s': %w", path, err)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var ErrInvalidCapacity = errors.New("room capacity cannot be negative")
+
+// HasPlayer reports whether a user holds a seat in the room
+func (r *Room) HasPlayer(userID sharedEntity.UserID) bool {
+	for _, p := range r.Players {
+		if p != nil && p.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFull reports whether every seat of a limited room is taken
+func (r *Room) IsFull() bool {
+	return r.MaxPlayers > 0 && len(r.Players) >= r.MaxPlayers
+}
+
+// WaitlistPosition returns the 1-based place of a user on the waitlist, 0 if they are not waiting
+func (r *Room) WaitlistPosition(userID sharedEntity.UserID) int {
+	for i, p := range r.Waitlist {
+		if p != nil && p.ID == userID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AddToWaitlist queues a user for the next free seat and returns their place; users already waiting keep theirs
+func (r *Room) AddToWaitlist(player *sharedEntity.User) int {
+	if position := r.WaitlistPosition(player.ID); position > 0 {
+		return position
+	}
+	r.Waitlist = append(r.Waitlist, player)
+	return len(r.Waitlist)
+}
+
+// LeaveWaitlist takes a user off the waitlist, reporting whether they were on it
+func (r *Room) LeaveWaitlist(userID sharedEntity.UserID) bool {
+	position := r.WaitlistPosition(userID)
+	if position == 0 {
+		return false
+	}
+	r.Waitlist = append(r.Waitlist[:position-1], r.Waitlist[position:]...)
+	return true
+}
+
+// PromoteWaitlisted seats waiting users, first come first served, while the room has free seats;
+// those who were watching stop doing so. It returns the users who got a seat.
+func (r *Room) PromoteWaitlisted() []*sharedEntity.User {
+	var promoted []*sharedEntity.User
+	for len(r.Waitlist) > 0 && !r.IsFull() {
+		player := r.Waitlist[0]
+		r.Waitlist = r.Waitlist[1:]
+		if player == nil || r.HasPlayer(player.ID) {
+			continue
+		}
+		r.RemoveSpectator(player.ID)
+		r.AddPlayer(player)
+		promoted = append(promoted, player)
+	}
+	if len(r.Waitlist) == 0 {
+		r.Waitlist = nil
+	}
+	return promoted
+}
+
+// SetCapacity limits the seats of the room (0 removes the limit) and seats waiting users if it grew.
+// Lowering it below the current player count keeps everyone seated; newcomers wait until enough players leave.
+func (r *Room) SetCapacity(maxPlayers int) ([]*sharedEntity.User, error) {
+	if maxPlayers < 0 {
+		return nil, ErrInvalidCapacity
+	}
+	r.MaxPlayers = maxPlayers
+	return r.PromoteWaitlisted(), nil
+}
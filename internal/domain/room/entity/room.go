@@ -18,7 +18,13 @@ type Room struct {
 	Players      []*sharedEntity.User // Use imported User type
 	Description  map[string]string
 	ScenarioName string
-	Moderator    *sharedEntity.User // Added Moderator field
+	Moderator    *sharedEntity.User   // Added Moderator field
+	MaxPlayers   int                  // Seats available to players, 0 for no limit
+	Waitlist     []*sharedEntity.User // Players waiting for a free seat, in arrival order
+	Spectators   []*sharedEntity.User // Users following the public announcements without playing
+	Visibility   Visibility           // Who can find and join the room; empty means public
+	PasswordHash string               // Salted hash of the code asked by password rooms
+	InviteCode   string               // Secret part of the invite link; empty when revoked
 }
 
 // Predefined error variables (using standard errors)
@@ -71,7 +77,8 @@ func (r *Room) SetDescription(descriptionName string, text string) {
 }
 
 // SetModerator updates the room's moderator, removes the new moderator from the player list if they exist,
-// and adds the previous moderator back to the player list.
+// and gives the previous moderator a seat back. When every seat is taken the previous moderator joins the
+// waitlist instead.
 func (r *Room) SetModerator(newModerator *sharedEntity.User) error {
 	if newModerator == nil {
 		return errors.New("new moderator cannot be nil")
@@ -82,36 +89,54 @@ func (r *Room) SetModerator(newModerator *sharedEntity.User) error {
 	// Set the new moderator
 	r.Moderator = newModerator
 
-	// Check if the new moderator is currently a player and remove them if so
-	pModFound := false
-	found := false
-	playerIndex := -1
-	for i, p := range r.Players {
-		if p.ID == newModerator.ID {
-			found = true
-			playerIndex = i
-			break
+	// The new moderator no longer waits for a seat, watches or plays
+	r.LeaveWaitlist(newModerator.ID)
+	r.RemoveSpectator(newModerator.ID)
+	r.RemovePlayer(newModerator.ID)
+
+	// Seat the previous moderator, if there was one, or queue them for the next free seat
+	if previousModerator != nil && !r.HasPlayer(previousModerator.ID) {
+		if r.IsFull() {
+			r.AddToWaitlist(previousModerator)
+		} else {
+			r.AddPlayer(previousModerator)
 		}
 	}
 
-	if found {
-		// Remove the new moderator from the players list using the found index
-		r.Players = append(r.Players[:playerIndex], r.Players[playerIndex+1:]...)
-	}
+	return nil
+}
 
-	// Add the previous moderator back to the players list, if there was one
-	if previousModerator != nil {
-		for _, p := range r.Players {
-			if p.ID == previousModerator.ID {
-				pModFound = true
-				break
-			}
+// Clone returns a copy of the room whose players, waitlist, spectators and description can be changed
+// without touching the original.
+func (r *Room) Clone() *Room {
+	clone := *r
+	clone.Players = cloneUsers(r.Players)
+	clone.Waitlist = cloneUsers(r.Waitlist)
+	clone.Spectators = cloneUsers(r.Spectators)
+	if r.Description != nil {
+		clone.Description = make(map[string]string, len(r.Description))
+		for name, text := range r.Description {
+			clone.Description[name] = text
 		}
 	}
-
-	if previousModerator != nil && !pModFound {
-		r.AddPlayer(previousModerator)
+	if r.Moderator != nil {
+		moderator := *r.Moderator
+		clone.Moderator = &moderator
 	}
+	return &clone
+}
 
-	return nil
+// cloneUsers copies a list of users together with the users it points to
+func cloneUsers(users []*sharedEntity.User) []*sharedEntity.User {
+	if users == nil {
+		return nil
+	}
+	clone := make([]*sharedEntity.User, len(users))
+	for i, user := range users {
+		if user != nil {
+			u := *user
+			clone[i] = &u
+		}
+	}
+	return clone
 }
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"errors"
+
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// ErrSeatOutOfRange is returned when a player would be moved past the first or the last seat
+var ErrSeatOutOfRange = errors.New("seat out of range")
+
+// Seats follow the order of Players: the first player sits in seat 1.
+
+// SeatOf returns the seat of a player, or 0 if they are not in the room
+func (r *Room) SeatOf(playerID sharedEntity.UserID) int {
+	for i, p := range r.Players {
+		if p != nil && p.ID == playerID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// MoveSeat moves a player by offset seats (negative is towards seat 1); the players in between shift over
+func (r *Room) MoveSeat(playerID sharedEntity.UserID, offset int) error {
+	seat := r.SeatOf(playerID)
+	if seat == 0 {
+		return ErrPlayerNotInRoom
+	}
+	from := seat - 1
+	to := from + offset
+	if to < 0 || to >= len(r.Players) {
+		return ErrSeatOutOfRange
+	}
+	player := r.Players[from]
+	if to < from {
+		copy(r.Players[to+1:from+1], r.Players[to:from])
+	} else {
+		copy(r.Players[from:to], r.Players[from+1:to+1])
+	}
+	r.Players[to] = player
+	return nil
+}
+
+// ShuffleSeats seats the players in the order produced by shuffle, e.g. common.Shuffle
+func (r *Room) ShuffleSeats(shuffle func(n int, swap func(i, j int))) {
+	shuffle(len(r.Players), func(i, j int) {
+		r.Players[i], r.Players[j] = r.Players[j], r.Players[i]
+	})
+}
+
+// SeatOrder returns the players from seat 1 onwards
+func (r *Room) SeatOrder() []*sharedEntity.User {
+	players := make([]*sharedEntity.User, 0, len(r.Players))
+	for _, p := range r.Players {
+		if p != nil {
+			players = append(players, p)
+		}
+	}
+	return players
+}
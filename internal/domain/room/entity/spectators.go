@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"errors"
+
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var ErrCannotWatch = errors.New("players and the moderator of a room cannot watch it")
+
+// IsSpectator reports whether a user watches the room
+func (r *Room) IsSpectator(userID sharedEntity.UserID) bool {
+	for _, s := range r.Spectators {
+		if s != nil && s.ID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// AddSpectator lets a user follow the room's public announcements without taking a seat.
+// Watching twice is harmless.
+func (r *Room) AddSpectator(user *sharedEntity.User) error {
+	if r.HasPlayer(user.ID) || (r.Moderator != nil && r.Moderator.ID == user.ID) {
+		return ErrCannotWatch
+	}
+	if !r.IsSpectator(user.ID) {
+		r.Spectators = append(r.Spectators, user)
+	}
+	return nil
+}
+
+// RemoveSpectator stops a user watching the room, reporting whether they were
+func (r *Room) RemoveSpectator(userID sharedEntity.UserID) bool {
+	for i, s := range r.Spectators {
+		if s != nil && s.ID == userID {
+			r.Spectators = append(r.Spectators[:i], r.Spectators[i+1:]...)
+			if len(r.Spectators) == 0 {
+				r.Spectators = nil
+			}
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+
+	"telemafia/internal/shared/common"
+)
+
+// Visibility decides who can find and join a room
+type Visibility string
+
+const (
+	VisibilityPublic   Visibility = "public"   // Listed, anyone can join
+	VisibilityUnlisted Visibility = "unlisted" // Hidden from the list, joined only through the invite link
+	VisibilityPassword Visibility = "password" // Listed, joined with the password or the invite link
+)
+
+var (
+	ErrInvalidVisibility = errors.New("invalid room visibility")
+	ErrPasswordMissing   = errors.New("password rooms need a password")
+	ErrPasswordRequired  = errors.New("this room needs a password")
+	ErrWrongPassword     = errors.New("wrong room password")
+	ErrInviteRequired    = errors.New("this room can only be joined through its invite link")
+	ErrInvalidInvite     = errors.New("the invite link is no longer valid")
+)
+
+// EffectiveVisibility returns the visibility of the room; rooms created before visibility existed are public
+func (r *Room) EffectiveVisibility() Visibility {
+	if r.Visibility == "" {
+		return VisibilityPublic
+	}
+	return r.Visibility
+}
+
+// IsListed reports whether the room appears in the room list
+func (r *Room) IsListed() bool {
+	return r.EffectiveVisibility() != VisibilityUnlisted
+}
+
+// SetVisibility changes who can join the room. Password rooms keep a salted hash of the password only.
+func (r *Room) SetVisibility(visibility Visibility, password string) error {
+	password = strings.TrimSpace(password)
+	hash := ""
+	switch visibility {
+	case VisibilityPublic, VisibilityUnlisted:
+	case VisibilityPassword:
+		if password == "" {
+			return ErrPasswordMissing
+		}
+		hash = common.HashPassword(password)
+	default:
+		return ErrInvalidVisibility
+	}
+	r.Visibility = visibility
+	r.PasswordHash = hash
+	return nil
+}
+
+// RegenerateInvite replaces the invite code, so links shared before stop working
+func (r *Room) RegenerateInvite(code string) {
+	r.InviteCode = code
+}
+
+// RevokeInvite disables the invite link until a new one is generated
+func (r *Room) RevokeInvite() {
+	r.InviteCode = ""
+}
+
+// Admit checks whether a newcomer may enter the room. A valid invite code opens any room,
+// password rooms also accept their password, and public rooms are open to everyone.
+func (r *Room) Admit(inviteCode, password string) error {
+	if inviteCode != "" && inviteCode == r.InviteCode {
+		return nil
+	}
+	switch r.EffectiveVisibility() {
+	case VisibilityUnlisted:
+		if inviteCode != "" {
+			return ErrInvalidInvite
+		}
+		return ErrInviteRequired
+	case VisibilityPassword:
+		password = strings.TrimSpace(password)
+		if password == "" {
+			return ErrPasswordRequired
+		}
+		if !common.CheckPassword(r.PasswordHash, password) {
+			return ErrWrongPassword
+		}
+	}
+	return nil
+}
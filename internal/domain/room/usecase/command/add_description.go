@@ -44,11 +44,17 @@ func (h *AddDescriptionHandler) Handle(ctx context.Context, cmd AddDescriptionCo
 		return errors.New("cannot add description to nil room")
 	}
 
-	// Modify the passed-in room object
-	cmd.Room.SetDescription(cmd.DescriptionName, cmd.Text)
+	defer lockRoom(cmd.Room.ID)()
+
+	// Reload the room so changes saved since the caller fetched it are kept
+	room, err := h.roomRepo.GetRoomByID(cmd.Room.ID)
+	if err != nil {
+		return fmt.Errorf("add description: could not find room %s: %w", cmd.Room.ID, err)
+	}
+	room.SetDescription(cmd.DescriptionName, cmd.Text)
 
 	// Persist the changes using UpdateRoom
-	if err := h.roomRepo.UpdateRoom(cmd.Room); err != nil {
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
 		return fmt.Errorf("failed to update room after adding description: %w", err)
 	}
 
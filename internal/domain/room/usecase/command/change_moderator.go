@@ -16,6 +16,14 @@ type ChangeModeratorCommand struct {
 	NewModerator *sharedEntity.User // User to become the new moderator
 }
 
+// ChangeModeratorResult tells who took or gave up a seat when the moderator changed
+type ChangeModeratorResult struct {
+	Previous *sharedEntity.User    // The previous moderator, nil if the room had none
+	Seated   []sharedEntity.UserID // The previous moderator, when they got a seat back
+	Unseated []sharedEntity.UserID // The new moderator, when they gave up their seat
+	Position int                   // Place of the previous moderator on the waitlist when every seat was taken
+}
+
 // ChangeModeratorHandler handles changing the room moderator
 type ChangeModeratorHandler struct {
 	roomRepo roomPort.RoomRepository // Need full repo to get/update
@@ -30,44 +38,61 @@ func NewChangeModeratorHandler(repo roomPort.RoomRepository) *ChangeModeratorHan
 }
 
 // Handle processes the change moderator command
-func (h *ChangeModeratorHandler) Handle(ctx context.Context, cmd ChangeModeratorCommand) error {
+func (h *ChangeModeratorHandler) Handle(ctx context.Context, cmd ChangeModeratorCommand) (*ChangeModeratorResult, error) {
 	// --- Basic Validation ---
 	if cmd.Requester == nil {
-		return fmt.Errorf("change moderator: requester cannot be nil")
+		return nil, fmt.Errorf("change moderator: requester cannot be nil")
 	}
 	if cmd.NewModerator == nil {
-		return fmt.Errorf("change moderator: new moderator cannot be nil")
+		return nil, fmt.Errorf("change moderator: new moderator cannot be nil")
 	}
 
+	defer lockRoom(cmd.RoomID)()
+
 	// Fetch the room
 	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
 	if err != nil {
-		return fmt.Errorf("change moderator: could not find room %s: %w", cmd.RoomID, err)
+		return nil, fmt.Errorf("change moderator: could not find room %s: %w", cmd.RoomID, err)
 	}
 
 	// --- Permission Check ---
 	// Allow if requester is global admin OR the current moderator of this specific room
 	isCurrentModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
 	if !cmd.Requester.Admin && !isCurrentModerator {
-		return fmt.Errorf("change moderator: permission denied (requires admin or current room moderator)")
+		return nil, fmt.Errorf("change moderator: permission denied (requires admin or current room moderator)")
 	}
 
 	// Ensure the new moderator is not the current moderator
 	if room.Moderator != nil && room.Moderator.ID == cmd.NewModerator.ID {
-		return fmt.Errorf("change moderator: user %s is already the moderator", cmd.NewModerator.GetProfileLink())
+		return nil, fmt.Errorf("change moderator: user %s is already the moderator", cmd.NewModerator.GetProfileLink())
 	}
 
+	previous := room.Moderator
+	newWasSeated := room.HasPlayer(cmd.NewModerator.ID)
+	previousWasSeated := previous != nil && room.HasPlayer(previous.ID)
+
 	// Use the entity method to set the new moderator
 	if err := room.SetModerator(cmd.NewModerator); err != nil {
-		return fmt.Errorf("change moderator: failed to set new moderator: %w", err)
+		return nil, fmt.Errorf("change moderator: failed to set new moderator: %w", err)
 	}
 
 	// Update the room in the repository
 	if err := h.roomRepo.UpdateRoom(room); err != nil {
-		return fmt.Errorf("change moderator: failed to save room updates: %w", err)
+		return nil, fmt.Errorf("change moderator: failed to save room updates: %w", err)
 	}
 
 	// TODO: Optionally publish a RoomModeratorChangedEvent
 
-	return nil
+	result := &ChangeModeratorResult{Previous: previous}
+	if newWasSeated {
+		result.Unseated = append(result.Unseated, cmd.NewModerator.ID)
+	}
+	if previous != nil && !previousWasSeated {
+		if room.HasPlayer(previous.ID) {
+			result.Seated = append(result.Seated, previous.ID)
+		} else {
+			result.Position = room.WaitlistPosition(previous.ID)
+		}
+	}
+	return result, nil
 }
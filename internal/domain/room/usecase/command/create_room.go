@@ -5,6 +5,7 @@ import (
 	"errors"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomPort "telemafia/internal/domain/room/port"
+	"telemafia/internal/shared/common"
 	sharedEntity "telemafia/internal/shared/entity"
 	sharedEvent "telemafia/internal/shared/event"
 	"time"
@@ -12,9 +13,10 @@ import (
 
 // CreateRoomCommand represents the command to create a new room
 type CreateRoomCommand struct {
-	ID      roomEntity.RoomID
-	Name    string
-	Creator *sharedEntity.User // Changed to pass the full User struct
+	ID         roomEntity.RoomID
+	Name       string
+	Creator    *sharedEntity.User // Changed to pass the full User struct
+	MaxPlayers int                // Optional seat limit, 0 for none
 }
 
 // CreateRoomHandler handles room creation
@@ -41,6 +43,10 @@ func (h *CreateRoomHandler) Handle(ctx context.Context, cmd CreateRoomCommand) (
 	if err != nil {
 		return nil, err
 	}
+	if _, err := room.SetCapacity(cmd.MaxPlayers); err != nil {
+		return nil, err
+	}
+	room.RegenerateInvite(common.RandomToken(inviteCodeBytes))
 
 	// Add Creator logic if needed - the entity constructor doesn't take creator anymore
 	// Now handled by NewRoom constructor
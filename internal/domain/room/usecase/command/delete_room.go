@@ -32,5 +32,6 @@ func (h *DeleteRoomHandler) Handle(ctx context.Context, cmd DeleteRoomCommand) e
 	if !cmd.Requester.Admin {
 		return errors.New("delete room: admin privilege required")
 	}
+	defer lockRoom(cmd.RoomID)()
 	return h.roomRepo.DeleteRoom(cmd.RoomID) // Propagates errors from repo
 }
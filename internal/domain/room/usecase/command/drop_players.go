@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// DropPlayersCommand takes players out of a room on behalf of another module, e.g. after a ready-check
+type DropPlayersCommand struct {
+	RoomID    roomEntity.RoomID
+	PlayerIDs []sharedEntity.UserID
+}
+
+// DropPlayersHandler handles removing several players at once
+type DropPlayersHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewDropPlayersHandler creates a new DropPlayersHandler
+func NewDropPlayersHandler(repo roomPort.RoomRepository) *DropPlayersHandler {
+	return &DropPlayersHandler{roomRepo: repo}
+}
+
+// Handle removes the players that are still seated, gives the freed seats to the waitlist and returns
+// the saved room with who was removed and who moved up. Players who already left are skipped.
+func (h *DropPlayersHandler) Handle(ctx context.Context, cmd DropPlayersCommand) (*roomEntity.Room, []sharedEntity.UserID, []*sharedEntity.User, error) {
+	if cmd.RoomID == "" {
+		return nil, nil, nil, errors.New("drop players: room ID cannot be empty")
+	}
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("drop players: could not find room %s: %w", cmd.RoomID, err)
+	}
+	var dropped []sharedEntity.UserID
+	for _, userID := range cmd.PlayerIDs {
+		if room.HasPlayer(userID) {
+			room.RemovePlayer(userID)
+			dropped = append(dropped, userID)
+		}
+	}
+	promoted := room.PromoteWaitlisted()
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, nil, nil, fmt.Errorf("drop players: failed to save room %s: %w", room.ID, err)
+	}
+	return room, dropped, promoted, nil
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomPort "telemafia/internal/domain/room/port"
 	sharedEntity "telemafia/internal/shared/entity"
@@ -11,8 +12,16 @@ import (
 
 // JoinRoomCommand represents the command to join a room
 type JoinRoomCommand struct {
-	Requester sharedEntity.User // Use imported User type
-	RoomID    roomEntity.RoomID // Use imported RoomID type
+	Requester  sharedEntity.User // Use imported User type
+	RoomID     roomEntity.RoomID // Use imported RoomID type
+	InviteCode string            // Code from the invite link, if the user followed one
+	Password   string            // Password typed for password rooms
+}
+
+// JoinRoomResult tells whether the requester got a seat or was put on the waitlist
+type JoinRoomResult struct {
+	Waitlisted bool
+	Position   int // 1-based place on the waitlist when Waitlisted
 }
 
 // JoinRoomHandler handles room joining
@@ -30,16 +39,42 @@ func NewJoinRoomHandler(repo roomPort.RoomRepository, publisher sharedEvent.Publ
 }
 
 // Handle processes the join room command
-func (h *JoinRoomHandler) Handle(ctx context.Context, cmd JoinRoomCommand) error {
+func (h *JoinRoomHandler) Handle(ctx context.Context, cmd JoinRoomCommand) (JoinRoomResult, error) {
+	defer lockRoom(cmd.RoomID)()
+
 	// Get the room first to ensure it exists
 	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
 	if err != nil {
-		return err // Propagates ErrRoomNotFound etc.
+		return JoinRoomResult{}, err // Propagates ErrRoomNotFound etc.
+	}
+
+	// Newcomers must be allowed in by the room's visibility
+	isMember := room.HasPlayer(cmd.Requester.ID) || room.WaitlistPosition(cmd.Requester.ID) > 0 || room.IsSpectator(cmd.Requester.ID)
+	if !isMember {
+		if err := admit(room, cmd.Requester, cmd.InviteCode, cmd.Password); err != nil {
+			return JoinRoomResult{}, err
+		}
+	}
+
+	// A full room puts newcomers on its waitlist
+	if room.IsFull() && !room.HasPlayer(cmd.Requester.ID) {
+		position := room.AddToWaitlist(&cmd.Requester)
+		if err := h.roomRepo.UpdateRoom(room); err != nil {
+			return JoinRoomResult{}, fmt.Errorf("join room: failed to save waitlist: %w", err)
+		}
+		return JoinRoomResult{Waitlisted: true, Position: position}, nil
+	}
+
+	// Taking a seat ends watching
+	if room.RemoveSpectator(cmd.Requester.ID) {
+		if err := h.roomRepo.UpdateRoom(room); err != nil {
+			return JoinRoomResult{}, fmt.Errorf("join room: failed to save spectators: %w", err)
+		}
 	}
 
 	// Add player to room using the repository method
 	if err := h.roomRepo.AddPlayerToRoom(cmd.RoomID, &cmd.Requester); err != nil {
-		return err // Propagates potential errors from repo impl (e.g., already exists)
+		return JoinRoomResult{}, err // Propagates potential errors from repo impl (e.g., already exists)
 	}
 
 	// Publish domain event
@@ -55,5 +90,5 @@ func (h *JoinRoomHandler) Handle(ctx context.Context, cmd JoinRoomCommand) error
 		// log.Printf("Failed to publish PlayerJoinedEvent: %v", err)
 	}
 
-	return nil
+	return JoinRoomResult{}, nil
 }
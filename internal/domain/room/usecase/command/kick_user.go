@@ -32,25 +32,41 @@ func NewKickUserHandler(repo roomPort.RoomRepository, publisher sharedEvent.Publ
 	}
 }
 
-// Handle processes the kick user command
-func (h *KickUserHandler) Handle(ctx context.Context, cmd KickUserCommand) error {
+// Handle processes the kick user command and returns the room with the waitlisted users who took the freed seat
+func (h *KickUserHandler) Handle(ctx context.Context, cmd KickUserCommand) (*roomEntity.Room, []*sharedEntity.User, error) {
+	defer lockRoom(cmd.RoomID)()
+
 	// Fetch the room first to check moderator status
 	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
 	if err != nil {
 		// Handle specific error like RoomNotFound if needed, otherwise return generic error
-		return fmt.Errorf("kick user: could not find room %s: %w", cmd.RoomID, err)
+		return nil, nil, fmt.Errorf("kick user: could not find room %s: %w", cmd.RoomID, err)
 	}
 
 	// --- Permission Check ---
 	// Allow if requester is global admin OR the moderator of this specific room
 	isRoomModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
 	if !cmd.Requester.Admin && !isRoomModerator {
-		return errors.New("kick user: permission denied (requires admin or room moderator)")
+		return nil, nil, errors.New("kick user: permission denied (requires admin or room moderator)")
+	}
+
+	// Kicking someone off the waitlist or out of the spectators frees no seat; a waiting user may also watch
+	leftWaitlist := room.LeaveWaitlist(cmd.PlayerID)
+	stoppedWatching := room.RemoveSpectator(cmd.PlayerID)
+	if leftWaitlist || stoppedWatching {
+		if err := h.roomRepo.UpdateRoom(room); err != nil {
+			return nil, nil, fmt.Errorf("kick user: failed to save room: %w", err)
+		}
+		return room, nil, nil
 	}
 
 	// Remove player from room
 	if err := h.roomRepo.RemovePlayerFromRoom(cmd.RoomID, cmd.PlayerID); err != nil {
-		return fmt.Errorf("kick user: failed to remove player: %w", err) // Propagates ErrPlayerNotInRoom etc.
+		return nil, nil, fmt.Errorf("kick user: failed to remove player: %w", err) // Propagates ErrPlayerNotInRoom etc.
+	}
+	room, promoted, err := promoteWaitlisted(h.roomRepo, cmd.RoomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("kick user: %w", err)
 	}
 
 	// Publish domain event
@@ -65,5 +81,5 @@ func (h *KickUserHandler) Handle(ctx context.Context, cmd KickUserCommand) error
 		// log.Printf("Failed to publish PlayerKickedEvent: %v", err)
 	}
 
-	return nil
+	return room, promoted, nil
 }
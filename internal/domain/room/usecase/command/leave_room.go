@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomPort "telemafia/internal/domain/room/port"
 	sharedEntity "telemafia/internal/shared/entity"
@@ -29,17 +30,33 @@ func NewLeaveRoomHandler(repo roomPort.RoomRepository, publisher sharedEvent.Pub
 	}
 }
 
-// Handle processes the leave room command
-func (h *LeaveRoomHandler) Handle(ctx context.Context, cmd LeaveRoomCommand) error {
+// Handle processes the leave room command and returns the room with the waitlisted users who took the freed seat
+func (h *LeaveRoomHandler) Handle(ctx context.Context, cmd LeaveRoomCommand) (*roomEntity.Room, []*sharedEntity.User, error) {
+	defer lockRoom(cmd.RoomID)()
+
 	// Check if the room exists (optional, RemovePlayerFromRoom might handle this)
-	_, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	// Leaving the waitlist or the spectators frees no seat
+	waiting := room.LeaveWaitlist(cmd.Requester.ID)
+	watching := room.RemoveSpectator(cmd.Requester.ID)
+	if waiting || watching {
+		if err := h.roomRepo.UpdateRoom(room); err != nil {
+			return nil, nil, fmt.Errorf("leave room: failed to save waitlist and spectators: %w", err)
+		}
+		return room, nil, nil
 	}
 
 	// Remove player from room
 	if err := h.roomRepo.RemovePlayerFromRoom(cmd.RoomID, cmd.Requester.ID); err != nil {
-		return err // Propagates ErrPlayerNotInRoom etc.
+		return nil, nil, err // Propagates ErrPlayerNotInRoom etc.
+	}
+	room, promoted, err := promoteWaitlisted(h.roomRepo, cmd.RoomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("leave room: %w", err)
 	}
 
 	// Publish domain event
@@ -54,5 +71,5 @@ func (h *LeaveRoomHandler) Handle(ctx context.Context, cmd LeaveRoomCommand) err
 		// log.Printf("Failed to publish PlayerLeftEvent: %v", err)
 	}
 
-	return nil
+	return room, promoted, nil
 }
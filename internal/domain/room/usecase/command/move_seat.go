@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// MoveSeatCommand moves a player to another seat of the room
+type MoveSeatCommand struct {
+	Requester sharedEntity.User
+	RoomID    roomEntity.RoomID
+	PlayerID  sharedEntity.UserID
+	Offset    int // Seats to move by; negative moves towards seat 1
+}
+
+// MoveSeatHandler handles reordering the seats of a room
+type MoveSeatHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewMoveSeatHandler creates a new MoveSeatHandler
+func NewMoveSeatHandler(repo roomPort.RoomRepository) *MoveSeatHandler {
+	return &MoveSeatHandler{roomRepo: repo}
+}
+
+// Handle moves the player and returns the updated room
+func (h *MoveSeatHandler) Handle(ctx context.Context, cmd MoveSeatCommand) (*roomEntity.Room, error) {
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, fmt.Errorf("move seat: could not find room %s: %w", cmd.RoomID, err)
+	}
+
+	// --- Permission Check ---
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
+	if !cmd.Requester.Admin && !isRoomModerator {
+		return nil, errors.New("move seat: permission denied (requires admin or room moderator)")
+	}
+
+	if err := room.MoveSeat(cmd.PlayerID, cmd.Offset); err != nil {
+		return nil, fmt.Errorf("move seat: %w", err)
+	}
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, fmt.Errorf("move seat: failed to save room updates: %w", err)
+	}
+	return room, nil
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// Random bytes in an invite code; the code is hex encoded in the link
+const inviteCodeBytes = 6
+
+// ResetInviteCommand invalidates the invite link of a room, issuing a new one unless Revoke is set
+type ResetInviteCommand struct {
+	Requester sharedEntity.User
+	RoomID    roomEntity.RoomID
+	Revoke    bool
+}
+
+// ResetInviteHandler handles regenerating and revoking invite links
+type ResetInviteHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewResetInviteHandler creates a new ResetInviteHandler
+func NewResetInviteHandler(repo roomPort.RoomRepository) *ResetInviteHandler {
+	return &ResetInviteHandler{roomRepo: repo}
+}
+
+// Handle replaces or revokes the invite code and returns the updated room
+func (h *ResetInviteHandler) Handle(ctx context.Context, cmd ResetInviteCommand) (*roomEntity.Room, error) {
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, fmt.Errorf("reset invite: could not find room %s: %w", cmd.RoomID, err)
+	}
+
+	// --- Permission Check ---
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
+	if !cmd.Requester.Admin && !isRoomModerator {
+		return nil, errors.New("reset invite: permission denied (requires admin or room moderator)")
+	}
+
+	if cmd.Revoke {
+		room.RevokeInvite()
+	} else {
+		room.RegenerateInvite(common.RandomToken(inviteCodeBytes))
+	}
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, fmt.Errorf("reset invite: failed to save room updates: %w", err)
+	}
+	return room, nil
+}
@@ -0,0 +1,14 @@
+package command
+
+import (
+	roomEntity "telemafia/internal/domain/room/entity"
+	"telemafia/internal/shared/common"
+)
+
+// roomLocks keeps seat, waitlist and spectator changes to one room from overwriting each other
+var roomLocks common.KeyedMutex[roomEntity.RoomID]
+
+// lockRoom blocks until no other command works on the room and returns the function that releases it
+func lockRoom(id roomEntity.RoomID) (unlock func()) {
+	return roomLocks.Lock(id)
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// SetRoomCapacityCommand limits the number of players in a room; 0 removes the limit
+type SetRoomCapacityCommand struct {
+	Requester  sharedEntity.User
+	RoomID     roomEntity.RoomID
+	MaxPlayers int
+}
+
+// SetRoomCapacityHandler handles changing the capacity of a room
+type SetRoomCapacityHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewSetRoomCapacityHandler creates a new SetRoomCapacityHandler
+func NewSetRoomCapacityHandler(repo roomPort.RoomRepository) *SetRoomCapacityHandler {
+	return &SetRoomCapacityHandler{roomRepo: repo}
+}
+
+// Handle sets the capacity and returns the updated room with the waitlisted users who got a seat
+func (h *SetRoomCapacityHandler) Handle(ctx context.Context, cmd SetRoomCapacityCommand) (*roomEntity.Room, []*sharedEntity.User, error) {
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("set room capacity: could not find room %s: %w", cmd.RoomID, err)
+	}
+
+	// --- Permission Check ---
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
+	if !cmd.Requester.Admin && !isRoomModerator {
+		return nil, nil, errors.New("set room capacity: permission denied (requires admin or room moderator)")
+	}
+
+	promoted, err := room.SetCapacity(cmd.MaxPlayers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("set room capacity: %w", err)
+	}
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, nil, fmt.Errorf("set room capacity: failed to save room updates: %w", err)
+	}
+	return room, promoted, nil
+}
+
+// promoteWaitlisted gives the seats freed in a room to its waitlist and saves the room if anyone moved up
+func promoteWaitlisted(repo roomPort.RoomRepository, roomID roomEntity.RoomID) (*roomEntity.Room, []*sharedEntity.User, error) {
+	room, err := repo.GetRoomByID(roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not reload room %s: %w", roomID, err)
+	}
+	promoted := room.PromoteWaitlisted()
+	if len(promoted) == 0 {
+		return room, nil, nil
+	}
+	if err := repo.UpdateRoom(room); err != nil {
+		return nil, nil, fmt.Errorf("failed to save promoted players: %w", err)
+	}
+	return room, promoted, nil
+}
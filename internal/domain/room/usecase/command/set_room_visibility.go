@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// SetRoomVisibilityCommand changes who can find and join a room
+type SetRoomVisibilityCommand struct {
+	Requester  sharedEntity.User
+	RoomID     roomEntity.RoomID
+	Visibility roomEntity.Visibility
+	Password   string // Required for password rooms, ignored otherwise
+}
+
+// SetRoomVisibilityHandler handles changing the visibility of a room
+type SetRoomVisibilityHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewSetRoomVisibilityHandler creates a new SetRoomVisibilityHandler
+func NewSetRoomVisibilityHandler(repo roomPort.RoomRepository) *SetRoomVisibilityHandler {
+	return &SetRoomVisibilityHandler{roomRepo: repo}
+}
+
+// Handle sets the visibility and returns the updated room. Players already in the room stay.
+func (h *SetRoomVisibilityHandler) Handle(ctx context.Context, cmd SetRoomVisibilityCommand) (*roomEntity.Room, error) {
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, fmt.Errorf("set room visibility: could not find room %s: %w", cmd.RoomID, err)
+	}
+
+	// --- Permission Check ---
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
+	if !cmd.Requester.Admin && !isRoomModerator {
+		return nil, errors.New("set room visibility: permission denied (requires admin or room moderator)")
+	}
+
+	if err := room.SetVisibility(cmd.Visibility, cmd.Password); err != nil {
+		return nil, fmt.Errorf("set room visibility: %w", err)
+	}
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, fmt.Errorf("set room visibility: failed to save room updates: %w", err)
+	}
+	return room, nil
+}
+
+// admit lets admins and the room's moderator in unconditionally and checks everyone else against the room's visibility
+func admit(room *roomEntity.Room, requester sharedEntity.User, inviteCode, password string) error {
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == requester.ID
+	if requester.Admin || isRoomModerator {
+		return nil
+	}
+	return room.Admit(inviteCode, password)
+}
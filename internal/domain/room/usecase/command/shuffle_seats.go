@@ -0,0 +1,49 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// ShuffleSeatsCommand seats the players of a room in a random order
+type ShuffleSeatsCommand struct {
+	Requester sharedEntity.User
+	RoomID    roomEntity.RoomID
+}
+
+// ShuffleSeatsHandler handles randomizing the seats of a room
+type ShuffleSeatsHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewShuffleSeatsHandler creates a new ShuffleSeatsHandler
+func NewShuffleSeatsHandler(repo roomPort.RoomRepository) *ShuffleSeatsHandler {
+	return &ShuffleSeatsHandler{roomRepo: repo}
+}
+
+// Handle shuffles the seats and returns the updated room
+func (h *ShuffleSeatsHandler) Handle(ctx context.Context, cmd ShuffleSeatsCommand) (*roomEntity.Room, error) {
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, fmt.Errorf("shuffle seats: could not find room %s: %w", cmd.RoomID, err)
+	}
+
+	// --- Permission Check ---
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == cmd.Requester.ID
+	if !cmd.Requester.Admin && !isRoomModerator {
+		return nil, errors.New("shuffle seats: permission denied (requires admin or room moderator)")
+	}
+
+	room.ShuffleSeats(common.Shuffle)
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, fmt.Errorf("shuffle seats: failed to save room updates: %w", err)
+	}
+	return room, nil
+}
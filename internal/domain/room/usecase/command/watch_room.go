@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// WatchRoomCommand adds the requester to the spectators of a room
+type WatchRoomCommand struct {
+	Requester  sharedEntity.User
+	RoomID     roomEntity.RoomID
+	InviteCode string // Code from the invite link, if the user followed one
+	Password   string // Password typed for password rooms
+}
+
+// WatchRoomHandler handles watching rooms
+type WatchRoomHandler struct {
+	roomRepo roomPort.RoomRepository
+}
+
+// NewWatchRoomHandler creates a new WatchRoomHandler
+func NewWatchRoomHandler(repo roomPort.RoomRepository) *WatchRoomHandler {
+	return &WatchRoomHandler{roomRepo: repo}
+}
+
+// Handle adds the spectator and returns the updated room. Spectators do not count as players,
+// so they never change the number of roles handed out.
+func (h *WatchRoomHandler) Handle(ctx context.Context, cmd WatchRoomCommand) (*roomEntity.Room, error) {
+	defer lockRoom(cmd.RoomID)()
+
+	room, err := h.roomRepo.GetRoomByID(cmd.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if !room.IsSpectator(cmd.Requester.ID) {
+		if err := admit(room, cmd.Requester, cmd.InviteCode, cmd.Password); err != nil {
+			return nil, err
+		}
+	}
+	if err := room.AddSpectator(&cmd.Requester); err != nil {
+		return nil, fmt.Errorf("watch room: %w", err)
+	}
+	if err := h.roomRepo.UpdateRoom(room); err != nil {
+		return nil, fmt.Errorf("watch room: failed to save room %s: %w", room.ID, err)
+	}
+	return room, nil
+}
@@ -0,0 +1,113 @@
+package entity
+
+import "fmt"
+
+// TargetFilter narrows the players an ability may target
+type TargetFilter string
+
+const (
+	// TargetAlive allows only alive players (the default when neither alive nor dead is given)
+	TargetAlive TargetFilter = "alive"
+	// TargetDead allows only dead players
+	TargetDead TargetFilter = "dead"
+	// TargetNonSelf excludes the role holder
+	TargetNonSelf TargetFilter = "non_self"
+	// TargetNonTeammate excludes players of the holder's side
+	TargetNonTeammate TargetFilter = "non_teammate"
+)
+
+// AbilityEffect tells the night resolver what an ability does
+type AbilityEffect string
+
+const (
+	// EffectNone leaves the ability to the moderator; it only shows up in the night summary
+	EffectNone AbilityEffect = ""
+	// EffectBlock stops the targets from using their abilities later in the night
+	EffectBlock AbilityEffect = "block"
+	// EffectSave protects the targets from kills applied later in the night
+	EffectSave AbilityEffect = "save"
+	// EffectKill kills the targets unless they were saved
+	EffectKill AbilityEffect = "kill"
+	// EffectInvestigate privately tells the actor whether the targets are innocent or guilty
+	EffectInvestigate AbilityEffect = "investigate"
+)
+
+// DefaultActionPriority is the order effects are applied in when a scenario does not declare one
+var DefaultActionPriority = []AbilityEffect{EffectBlock, EffectSave, EffectKill, EffectInvestigate}
+
+// InvestigationResult is what an investigation reveals about a player
+type InvestigationResult string
+
+const (
+	// InvestigationInnocent is the default result
+	InvestigationInnocent InvestigationResult = "innocent"
+	// InvestigationGuilty marks players the investigators are looking for
+	InvestigationGuilty InvestigationResult = "guilty"
+)
+
+// Ability is a night action a role may take, e.g. a doctor's save or a detective's investigation
+type Ability struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	TargetCount int            `json:"target_count,omitempty"` // Players chosen per use; defaults to 1
+	Targets     []TargetFilter `json:"targets,omitempty"`      // Every filter must hold; defaults to alive
+	Uses        int            `json:"uses,omitempty"`         // Uses per game; 0 means every night
+	Effect      AbilityEffect  `json:"effect,omitempty"`       // block, save, kill or investigate; empty for moderator-resolved abilities
+}
+
+// Count returns the number of targets chosen per use
+func (a Ability) Count() int {
+	if a.TargetCount <= 0 {
+		return 1
+	}
+	return a.TargetCount
+}
+
+// Has reports whether the ability declares a target filter
+func (a Ability) Has(filter TargetFilter) bool {
+	for _, f := range a.Targets {
+		if f == filter {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the ability definition
+func (a Ability) Validate() error {
+	if a.Name == "" {
+		return fmt.Errorf("ability name cannot be empty")
+	}
+	if a.TargetCount < 0 {
+		return fmt.Errorf("ability '%s': target_count cannot be negative", a.Name)
+	}
+	if a.Uses < 0 {
+		return fmt.Errorf("ability '%s': uses cannot be negative", a.Name)
+	}
+	for _, f := range a.Targets {
+		switch f {
+		case TargetAlive, TargetDead, TargetNonSelf, TargetNonTeammate:
+		default:
+			return fmt.Errorf("ability '%s': invalid target filter '%s' (expected alive, dead, non_self or non_teammate)", a.Name, f)
+		}
+	}
+	switch a.Effect {
+	case EffectNone, EffectBlock, EffectSave, EffectKill, EffectInvestigate:
+	default:
+		return fmt.Errorf("ability '%s': invalid effect '%s' (expected block, save, kill or investigate)", a.Name, a.Effect)
+	}
+	if a.Has(TargetAlive) && a.Has(TargetDead) {
+		return fmt.Errorf("ability '%s': targets cannot be both alive and dead", a.Name)
+	}
+	return nil
+}
+
+// validateInvestigation checks an investigated_as value
+func validateInvestigation(result InvestigationResult) error {
+	switch result {
+	case "", InvestigationInnocent, InvestigationGuilty:
+		return nil
+	default:
+		return fmt.Errorf("invalid investigated_as '%s' (expected innocent or guilty)", result)
+	}
+}
@@ -1,33 +1,148 @@
 package entity
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"sort"
 	"telemafia/internal/shared/common"
 )
 
+// Predefined scenario errors
+var (
+	ErrScenarioNotFound  = errors.New("scenario not found")
+	ErrScenarioConflict  = errors.New("scenario already exists")
+	ErrInvalidScenarioID = errors.New("invalid scenario id")
+)
+
+// MaxScenarioIDLength keeps scenario IDs short enough for Telegram's 64 bytes of callback data, where
+// the longest payload carries a room ID next to the scenario ID
+const MaxScenarioIDLength = 30
+
+// scenarioIDPattern restricts IDs to readable slugs such as "godfather" or "classic-12"
+var scenarioIDPattern = regexp.MustCompile(fmt.Sprintf(`^[a-z0-9][a-z0-9_-]{0,%d}$`, MaxScenarioIDLength-1))
+
+// ScenarioConflictError reports that a new scenario collides with a stored one.
+// Field is "id" when the slugs match and "name" when only the names match.
+type ScenarioConflictError struct {
+	Existing *Scenario
+	Field    string
+}
+
+func (e *ScenarioConflictError) Error() string {
+	return fmt.Sprintf("a scenario with the same %s already exists: '%s' (ID: %s)", e.Field, e.Existing.Name, e.Existing.ID)
+}
+
+// Unwrap lets callers match the error with errors.Is(err, ErrScenarioConflict)
+func (e *ScenarioConflictError) Unwrap() error {
+	return ErrScenarioConflict
+}
+
 // Role represents a single assignable role with its name and side affiliation.
 // This is used *after* extracting roles from the Scenario structure for assignment.
 type Role struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	AddedAt     int    `json:"added_at,omitempty"`
-	ImageID     string `json:"image_id,omitempty"`
-	Side        string `json:"side,omitempty"` // e.g., "Mafia", "Civilian", "Neutral"
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	AddedAt     int       `json:"added_at,omitempty"`
+	Tips        []string  `json:"tips,omitempty"`      // Advice shown with the description
+	ImageID     string    `json:"image_id,omitempty"`  // Telegram file_id; only valid for the bot that uploaded it
+	Image       string    `json:"image,omitempty"`     // Image file relative to the media directory, uploaded once per bot
+	Side        string    `json:"side,omitempty"`      // e.g., "Mafia", "Civilian", "Neutral"
+	Abilities   []Ability `json:"abilities,omitempty"` // Night actions of the role
+	// InvestigatedAs overrides the side's investigation result, e.g. a godfather who looks innocent
+	InvestigatedAs InvestigationResult `json:"investigated_as,omitempty"`
+}
+
+// Ability returns the ability of the role with the given name
+func (r Role) Ability(name string) (Ability, bool) {
+	for _, a := range r.Abilities {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return Ability{}, false
+}
+
+// validate checks the image path and every ability of the role, and that ability names are unique
+func (r Role) validate() error {
+	if r.Image != "" {
+		if _, err := common.CleanMediaPath(r.Image); err != nil {
+			return fmt.Errorf("role '%s': image '%s': %w", r.Name, r.Image, err)
+		}
+	}
+	if err := validateInvestigation(r.InvestigatedAs); err != nil {
+		return fmt.Errorf("role '%s': %w", r.Name, err)
+	}
+	seen := make(map[string]bool, len(r.Abilities))
+	for _, a := range r.Abilities {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("role '%s': %w", r.Name, err)
+		}
+		if seen[a.Name] {
+			return fmt.Errorf("role '%s': duplicate ability '%s'", r.Name, a.Name)
+		}
+		seen[a.Name] = true
+	}
+	return nil
 }
 
 // Side represents a group of roles within a scenario.
 type Side struct {
 	Name           string   `json:"name"`
+	Description    string   `json:"description,omitempty"` // The side's goal and how it plays, shown with its roles
 	PopulationRate *float32 `json:"population_rate,omitempty"`
 	DefaultRole    *Role    `json:"default_role,omitempty"`
 	Roles          []Role   `json:"roles,omitempty"` // List of role names belonging to this side
+	// InvestigatedAs is what investigations reveal about the side's roles; innocent by default
+	InvestigatedAs InvestigationResult `json:"investigated_as,omitempty"`
+	// WinConditions are the goals of the side; any one of them wins the game
+	WinConditions []WinCondition `json:"win_conditions,omitempty"`
+}
+
+// DeathReveal controls what the room learns about a player who dies
+type DeathReveal string
+
+const (
+	// DeathRevealNone keeps the role of dead players secret (the default)
+	DeathRevealNone DeathReveal = "none"
+	// DeathRevealSide reveals only the side of dead players
+	DeathRevealSide DeathReveal = "side"
+	// DeathRevealRole reveals the full role of dead players
+	DeathRevealRole DeathReveal = "role"
+)
+
+// Reveals reports whether anything is revealed when a player dies
+func (d DeathReveal) Reveals() bool {
+	return d == DeathRevealSide || d == DeathRevealRole
 }
 
+// VoteTieRule controls what happens when the first day vote ends in a tie
+type VoteTieRule string
+
+const (
+	// VoteTieRevote opens a new vote among the tied players
+	VoteTieRevote VoteTieRule = "revote"
+	// VoteTieDefense puts the tied players on trial before they are voted on again (the default)
+	VoteTieDefense VoteTieRule = "defense"
+	// VoteTieNoLynch ends the day without a lynch
+	VoteTieNoLynch VoteTieRule = "no_lynch"
+)
+
 // Scenario represents a game scenario containing sides and their roles.
 type Scenario struct {
-	ID    string `json:"-"` // Internal ID, not usually part of the input JSON
-	Name  string `json:"name"`
-	Sides []Side `json:"sides"`
+	ID          string      `json:"id,omitempty"` // Optional slug in the input JSON; generated when missing
+	Name        string      `json:"name"`
+	DeathReveal DeathReveal `json:"death_reveal,omitempty"` // none (default), side or role
+	VoteTie     VoteTieRule `json:"vote_tie,omitempty"`     // revote, defense (default) or no_lynch
+	// MinPlayers and MaxPlayers bound the supported player counts; 0 computes the bound from the roles
+	MinPlayers int `json:"min_players,omitempty"`
+	MaxPlayers int `json:"max_players,omitempty"`
+	// ActionPriority is the order night effects are resolved in; effects left out follow in the default order
+	ActionPriority []AbilityEffect `json:"action_priority,omitempty"`
+	Sides          []Side          `json:"sides"`
+	// SeedChecksum is the Checksum of the bundled file the scenario was last seeded from; it is empty for
+	// scenarios uploaded or created in the bot and is not part of the scenario's JSON
+	SeedChecksum string `json:"-"`
 }
 
 func (s *Scenario) FlatRoles(playerNum int) []Role {
@@ -78,3 +193,126 @@ func (s *Scenario) GetShuffledRoles(playerNum int) []Role {
 
 	return flatRoles
 }
+
+// Validate checks the structural rules every stored scenario must satisfy.
+func (s *Scenario) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("scenario name cannot be empty")
+	}
+	if len(s.Sides) == 0 {
+		return fmt.Errorf("scenario must have at least one side defined")
+	}
+	if s.ID != "" {
+		if err := ValidateScenarioID(s.ID); err != nil {
+			return err
+		}
+	}
+	if s.MinPlayers < 0 || s.MaxPlayers < 0 {
+		return fmt.Errorf("min_players and max_players cannot be negative")
+	}
+	if s.MaxPlayers > 0 && s.MaxPlayers < s.MinPlayers {
+		return fmt.Errorf("max_players %d is less than min_players %d", s.MaxPlayers, s.MinPlayers)
+	}
+	switch s.DeathReveal {
+	case "", DeathRevealNone, DeathRevealSide, DeathRevealRole:
+	default:
+		return fmt.Errorf("invalid death_reveal '%s' (expected none, side or role)", s.DeathReveal)
+	}
+	switch s.VoteTie {
+	case "", VoteTieRevote, VoteTieDefense, VoteTieNoLynch:
+	default:
+		return fmt.Errorf("invalid vote_tie '%s' (expected revote, defense or no_lynch)", s.VoteTie)
+	}
+
+	seenEffects := make(map[AbilityEffect]bool, len(s.ActionPriority))
+	for _, effect := range s.ActionPriority {
+		switch effect {
+		case EffectBlock, EffectSave, EffectKill, EffectInvestigate:
+		default:
+			return fmt.Errorf("invalid action_priority entry '%s' (expected block, save, kill or investigate)", effect)
+		}
+		if seenEffects[effect] {
+			return fmt.Errorf("action_priority lists '%s' twice", effect)
+		}
+		seenEffects[effect] = true
+	}
+
+	for sideIdx, side := range s.Sides {
+		if side.Name == "" {
+			return fmt.Errorf("side name cannot be empty (side index %d)", sideIdx)
+		}
+		if err := validateInvestigation(side.InvestigatedAs); err != nil {
+			return fmt.Errorf("side '%s': %w", side.Name, err)
+		}
+		for _, condition := range side.WinConditions {
+			if err := condition.validate(s, side.Name); err != nil {
+				return err
+			}
+		}
+		if len(side.Roles) == 0 && side.DefaultRole == nil {
+			return fmt.Errorf("side '%s' must have at least one role", side.Name)
+		}
+
+		for roleIdx, role := range side.Roles {
+			if role.Name == "" {
+				return fmt.Errorf("role name cannot be empty (side '%s', role index %d)", side.Name, roleIdx)
+			}
+			if err := role.validate(); err != nil {
+				return err
+			}
+		}
+		if side.DefaultRole != nil {
+			if err := side.DefaultRole.validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// EffectOrder returns the order night effects are resolved in
+func (s *Scenario) EffectOrder() []AbilityEffect {
+	order := append([]AbilityEffect(nil), s.ActionPriority...)
+	for _, effect := range DefaultActionPriority {
+		listed := false
+		for _, e := range order {
+			listed = listed || e == effect
+		}
+		if !listed {
+			order = append(order, effect)
+		}
+	}
+	return order
+}
+
+// InvestigationOf returns what an investigation reveals about a role:
+// the role's own investigated_as, else its side's, else innocent
+func (s *Scenario) InvestigationOf(role Role) InvestigationResult {
+	if role.InvestigatedAs != "" {
+		return role.InvestigatedAs
+	}
+	for _, side := range s.Sides {
+		if side.Name == role.Side && side.InvestigatedAs != "" {
+			return side.InvestigatedAs
+		}
+	}
+	return InvestigationInnocent
+}
+
+// FindSide returns the side with the given name
+func (s *Scenario) FindSide(name string) (Side, bool) {
+	for _, side := range s.Sides {
+		if side.Name == name {
+			return side, true
+		}
+	}
+	return Side{}, false
+}
+
+// ValidateScenarioID checks that id is a slug: lowercase letters, digits, '-' or '_', at most MaxScenarioIDLength characters.
+func ValidateScenarioID(id string) error {
+	if !scenarioIDPattern.MatchString(id) {
+		return fmt.Errorf("%w '%s': use lowercase letters, digits, '-' or '_' (max %d characters)", ErrInvalidScenarioID, id, MaxScenarioIDLength)
+	}
+	return nil
+}
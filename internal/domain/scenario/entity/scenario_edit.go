@@ -0,0 +1,227 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Scenario editing errors
+var (
+	ErrSideNotFound          = errors.New("side not found")
+	ErrDuplicateSide         = errors.New("a side with this name already exists")
+	ErrRoleNotFound          = errors.New("role not found")
+	ErrEmptyName             = errors.New("name cannot be empty")
+	ErrInvalidPopulationRate = errors.New("population rate must be between 0.01 and 0.99")
+	ErrInvalidAddedAt        = errors.New("added_at cannot be negative")
+)
+
+// Population rates outside this range make GetRoles fill the side up to the player count instead
+const (
+	MinPopulationRate = 0.01
+	MaxPopulationRate = 0.99
+)
+
+// Clone returns a copy of the scenario whose sides, roles and win conditions can be edited without
+// touching the original, which games may still hold
+func (s *Scenario) Clone() *Scenario {
+	clone := *s
+	clone.ActionPriority = append([]AbilityEffect(nil), s.ActionPriority...)
+	clone.Sides = make([]Side, len(s.Sides))
+	for i, side := range s.Sides {
+		if side.PopulationRate != nil {
+			rate := *side.PopulationRate
+			side.PopulationRate = &rate
+		}
+		if side.DefaultRole != nil {
+			role := *side.DefaultRole
+			side.DefaultRole = &role
+		}
+		side.Roles = append([]Role(nil), side.Roles...)
+		if side.WinConditions != nil {
+			conditions := make([]WinCondition, len(side.WinConditions))
+			for j, condition := range side.WinConditions {
+				condition.Sides = append([]string(nil), condition.Sides...)
+				conditions[j] = condition
+			}
+			side.WinConditions = conditions
+		}
+		clone.Sides[i] = side
+	}
+	return &clone
+}
+
+// side returns the side with the given name for editing
+func (s *Scenario) side(name string) (*Side, error) {
+	for i := range s.Sides {
+		if s.Sides[i].Name == name {
+			return &s.Sides[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: '%s'", ErrSideNotFound, name)
+}
+
+// AddSide appends a new side whose players get the given default role
+func (s *Scenario) AddSide(name, defaultRole string) error {
+	name, defaultRole = strings.TrimSpace(name), strings.TrimSpace(defaultRole)
+	if name == "" || defaultRole == "" {
+		return ErrEmptyName
+	}
+	if s.HasSide(name) {
+		return fmt.Errorf("%w: '%s'", ErrDuplicateSide, name)
+	}
+	s.Sides = append(s.Sides, Side{Name: name, DefaultRole: &Role{Name: defaultRole}})
+	return nil
+}
+
+// RenameSide renames a side and the win conditions of other sides that refer to it
+func (s *Scenario) RenameSide(name, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return ErrEmptyName
+	}
+	side, err := s.side(name)
+	if err != nil {
+		return err
+	}
+	if newName != name && s.HasSide(newName) {
+		return fmt.Errorf("%w: '%s'", ErrDuplicateSide, newName)
+	}
+	side.Name = newName
+	for i := range s.Sides {
+		for j := range s.Sides[i].WinConditions {
+			targets := s.Sides[i].WinConditions[j].Sides
+			for k := range targets {
+				if targets[k] == name {
+					targets[k] = newName
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// RemoveSide removes a side with all its roles. Win conditions of other sides no longer list it, and
+// conditions that only listed it are dropped.
+func (s *Scenario) RemoveSide(name string) error {
+	for i := range s.Sides {
+		if s.Sides[i].Name == name {
+			s.Sides = append(s.Sides[:i], s.Sides[i+1:]...)
+			s.forgetSide(name)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: '%s'", ErrSideNotFound, name)
+}
+
+// forgetSide removes a side from the win conditions that list it
+func (s *Scenario) forgetSide(name string) {
+	for i := range s.Sides {
+		side := &s.Sides[i]
+		var conditions []WinCondition
+		for _, condition := range side.WinConditions {
+			if len(condition.Sides) == 0 {
+				conditions = append(conditions, condition)
+				continue
+			}
+			var targets []string
+			for _, target := range condition.Sides {
+				if target != name {
+					targets = append(targets, target)
+				}
+			}
+			if len(targets) > 0 {
+				condition.Sides = targets
+				conditions = append(conditions, condition)
+			}
+		}
+		side.WinConditions = conditions
+	}
+}
+
+// AddRole adds a role to a side, handed out once the game has at least addedAt players
+func (s *Scenario) AddRole(sideName, roleName string, addedAt int) error {
+	roleName = strings.TrimSpace(roleName)
+	if roleName == "" {
+		return ErrEmptyName
+	}
+	if addedAt < 0 {
+		return ErrInvalidAddedAt
+	}
+	side, err := s.side(sideName)
+	if err != nil {
+		return err
+	}
+	side.Roles = append(side.Roles, Role{Name: roleName, AddedAt: addedAt})
+	return nil
+}
+
+// RemoveRole removes the role at the given position of a side
+func (s *Scenario) RemoveRole(sideName string, index int) error {
+	side, err := s.side(sideName)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(side.Roles) {
+		return fmt.Errorf("%w: side '%s' has no role #%d", ErrRoleNotFound, sideName, index+1)
+	}
+	side.Roles = append(side.Roles[:index], side.Roles[index+1:]...)
+	return nil
+}
+
+// SetRoleAddedAt sets the player count from which the role at the given position of a side is handed out
+func (s *Scenario) SetRoleAddedAt(sideName string, index, addedAt int) error {
+	if addedAt < 0 {
+		return ErrInvalidAddedAt
+	}
+	side, err := s.side(sideName)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(side.Roles) {
+		return fmt.Errorf("%w: side '%s' has no role #%d", ErrRoleNotFound, sideName, index+1)
+	}
+	side.Roles[index].AddedAt = addedAt
+	return nil
+}
+
+// SetPopulationRate sets the share of players a side gets; nil lets its default role fill the remaining seats
+func (s *Scenario) SetPopulationRate(sideName string, rate *float32) error {
+	if rate != nil && (*rate < MinPopulationRate || *rate > MaxPopulationRate) {
+		return ErrInvalidPopulationRate
+	}
+	side, err := s.side(sideName)
+	if err != nil {
+		return err
+	}
+	if rate != nil {
+		r := *rate
+		rate = &r
+	}
+	side.PopulationRate = rate
+	return nil
+}
+
+// SetDefaultRole sets the role that fills a side's remaining seats. A role of the side with the same name
+// is copied with its description and abilities; an empty name removes the default role.
+func (s *Scenario) SetDefaultRole(sideName, roleName string) error {
+	side, err := s.side(sideName)
+	if err != nil {
+		return err
+	}
+	roleName = strings.TrimSpace(roleName)
+	if roleName == "" {
+		side.DefaultRole = nil
+		return nil
+	}
+	role := Role{Name: roleName}
+	for _, r := range side.Roles {
+		if r.Name == roleName {
+			role = r
+			role.AddedAt = 0
+			break
+		}
+	}
+	side.DefaultRole = &role
+	return nil
+}
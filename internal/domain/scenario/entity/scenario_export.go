@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// ExportJSON encodes the scenario as an indented JSON document that /add_scenario_json and file uploads accept
+// unchanged. The side GetRoles stamps on roles is left out, as the side they are listed under already says it.
+func (s *Scenario) ExportJSON() ([]byte, error) {
+	clone := s.Clone()
+	for i := range clone.Sides {
+		side := &clone.Sides[i]
+		if side.DefaultRole != nil {
+			side.DefaultRole.Side = ""
+		}
+		for j := range side.Roles {
+			side.Roles[j].Side = ""
+		}
+	}
+	return json.MarshalIndent(clone, "", "  ")
+}
+
+// ExportFileName is the name of the file the scenario is exported to
+func (s *Scenario) ExportFileName() string {
+	return s.ID + ".json"
+}
+
+// Checksum fingerprints the exported content of the scenario, so a stored copy can be compared with the
+// file it was seeded from
+func (s *Scenario) Checksum() (string, error) {
+	data, err := s.ExportJSON()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// EditedSinceSeed reports whether the scenario no longer holds what the seeder last stored, because it was
+// edited or replaced in the bot or never came from a bundled file
+func (s *Scenario) EditedSinceSeed() bool {
+	if s.SeedChecksum == "" {
+		return true
+	}
+	sum, err := s.Checksum()
+	return err != nil || sum != s.SeedChecksum
+}
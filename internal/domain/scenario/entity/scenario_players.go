@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Player count errors, returned wrapped with the supported range by CheckPlayerCount
+var (
+	ErrTooFewPlayers     = errors.New("too few players for the scenario")
+	ErrTooManyPlayers    = errors.New("too many players for the scenario")
+	ErrRoleCountMismatch = errors.New("scenario hands out a different number of roles than players")
+)
+
+// playerBoundsSearchLimit is the largest player count computed bounds are searched up to. A scenario
+// that still fits at this count is treated as having no upper bound.
+const playerBoundsSearchLimit = 50
+
+// PlayerBounds returns the player counts the scenario supports. Bounds set in the scenario win; missing ones
+// are computed as the first run of playable counts, see playable.
+// max is 0 when there is no upper bound, and min is 0 when no count up to the search limit fits.
+func (s *Scenario) PlayerBounds() (min, max int) {
+	min, max = s.MinPlayers, s.MaxPlayers
+	if min > 0 && max > 0 {
+		return min, max
+	}
+
+	start := min
+	if start < 1 {
+		start = 1
+	}
+	first, last := 0, 0
+	for n := start; n <= playerBoundsSearchLimit; n++ {
+		if s.playable(n) {
+			if first == 0 {
+				first = n
+			}
+			last = n
+		} else if first != 0 {
+			break
+		}
+	}
+	if min == 0 {
+		min = first
+	}
+	if max == 0 && last < playerBoundsSearchLimit {
+		max = last
+	}
+	return min, max
+}
+
+// CheckPlayerCount reports why a game of n players cannot use the scenario, or nil if it can
+func (s *Scenario) CheckPlayerCount(n int) error {
+	min, max := s.PlayerBounds()
+	if min > 0 && n < min {
+		return fmt.Errorf("%w: %d players, at least %d needed", ErrTooFewPlayers, n, min)
+	}
+	if max > 0 && n > max {
+		return fmt.Errorf("%w: %d players, at most %d supported", ErrTooManyPlayers, n, max)
+	}
+	if roles := len(s.GetRoles(n)); roles != n {
+		return fmt.Errorf("%w: %d roles for %d players", ErrRoleCountMismatch, roles, n)
+	}
+	return nil
+}
+
+// playable reports whether GetRoles hands out exactly one role per player for n players, and the roles
+// put players on at least two sides so there is someone to play against
+func (s *Scenario) playable(n int) bool {
+	roles := s.GetRoles(n)
+	if len(roles) != n {
+		return false
+	}
+	if len(s.Sides) < 2 {
+		return true
+	}
+	for _, role := range roles[1:] {
+		if role.Side != roles[0].Side {
+			return true
+		}
+	}
+	return false
+}
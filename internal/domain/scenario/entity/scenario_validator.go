@@ -0,0 +1,279 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Player counts the validator checks unless configured otherwise
+const (
+	DefaultValidationMinPlayers = 6
+	DefaultValidationMaxPlayers = 20
+)
+
+// IssueSeverity tells whether a validation issue breaks games or only deserves a look
+type IssueSeverity string
+
+const (
+	// SeverityError marks a problem that breaks games, e.g. fewer roles than players
+	SeverityError IssueSeverity = "error"
+	// SeverityWarning marks something that is probably unintended but still playable
+	SeverityWarning IssueSeverity = "warning"
+)
+
+// IssueCode names the kind of a validation issue; the fields of ValidationIssue it uses are listed with each code
+type IssueCode string
+
+const (
+	IssueInvalidScenario        IssueCode = "invalid_scenario"          // Detail: the structural error of Validate
+	IssueBoundsOutsideRange     IssueCode = "bounds_outside_range"      // The declared player counts miss the report's MinPlayers-MaxPlayers
+	IssueRateOutOfRange         IssueCode = "rate_out_of_range"         // Side, Rate; ignored outside MinPopulationRate-MaxPopulationRate
+	IssueRateWithoutDefaultRole IssueCode = "rate_without_default_role" // Side
+	IssueRatesExceedPlayers     IssueCode = "rates_exceed_players"      // Rate: the total of all sides
+	IssueDuplicateRole          IssueCode = "duplicate_role"            // Side, Role
+	IssueSharedRole             IssueCode = "shared_role"               // Role, Side and OtherSide
+	IssueTooFewRoles            IssueCode = "too_few_roles"             // Players
+	IssueTooManyRoles           IssueCode = "too_many_roles"            // Players
+	IssueSideWithoutPlayers     IssueCode = "side_without_players"      // Side, Players
+	IssueParityAtStart          IssueCode = "parity_at_start"           // Side, Players
+)
+
+// ValidationIssue is one finding of the validator. The text is left to whoever shows the report.
+type ValidationIssue struct {
+	Severity  IssueSeverity
+	Code      IssueCode
+	Side      string
+	OtherSide string
+	Role      string
+	Rate      float32
+	Detail    string
+	Players   []int // Player counts the issue occurs at; empty when it concerns the scenario as a whole
+}
+
+// sameFinding reports whether two issues describe the same problem, whatever player counts they occur at
+func (i ValidationIssue) sameFinding(other ValidationIssue) bool {
+	return i.Severity == other.Severity && i.Code == other.Code && i.Side == other.Side && i.OtherSide == other.OtherSide &&
+		i.Role == other.Role && i.Rate == other.Rate && i.Detail == other.Detail
+}
+
+// PlayerRanges renders the player counts of the issue as ranges, e.g. "6-8, 12"
+func (i ValidationIssue) PlayerRanges() string {
+	var parts []string
+	for start := 0; start < len(i.Players); {
+		end := start
+		for end+1 < len(i.Players) && i.Players[end+1] == i.Players[end]+1 {
+			end++
+		}
+		if start == end {
+			parts = append(parts, fmt.Sprintf("%d", i.Players[start]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", i.Players[start], i.Players[end]))
+		}
+		start = end + 1
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PlayerCountSummary is what GetRoles hands out for one player count
+type PlayerCountSummary struct {
+	Players    int
+	Roles      int            // Number of roles handed out; differs from Players on a mismatch
+	SideCounts map[string]int // Players per side
+	RoleCounts map[string]int // Copies per role name
+}
+
+// ValidationReport is the result of checking a scenario over a range of player counts
+type ValidationReport struct {
+	MinPlayers int
+	MaxPlayers int
+	Counts     []PlayerCountSummary // One entry per player count, empty when the scenario is structurally invalid
+	Issues     []ValidationIssue
+}
+
+// Errors returns the issues that break games
+func (r *ValidationReport) Errors() []ValidationIssue {
+	return r.withSeverity(SeverityError)
+}
+
+// Warnings returns the issues that are only suspicious
+func (r *ValidationReport) Warnings() []ValidationIssue {
+	return r.withSeverity(SeverityWarning)
+}
+
+// HasErrors reports whether any issue breaks games
+func (r *ValidationReport) HasErrors() bool {
+	return len(r.Errors()) > 0
+}
+
+func (r *ValidationReport) withSeverity(severity IssueSeverity) []ValidationIssue {
+	var issues []ValidationIssue
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// add records an issue, merging it with an earlier identical one so each problem is listed once
+func (r *ValidationReport) add(players int, issue ValidationIssue) {
+	for i := range r.Issues {
+		if r.Issues[i].sameFinding(issue) {
+			if players > 0 {
+				r.Issues[i].Players = append(r.Issues[i].Players, players)
+			}
+			return
+		}
+	}
+	if players > 0 {
+		issue.Players = []int{players}
+	}
+	r.Issues = append(r.Issues, issue)
+}
+
+// ScenarioValidator checks a scenario beyond the structural rules of Validate: population rates, duplicate
+// role names, and for every player count in its range the roles GetRoles hands out and the balance of the sides
+type ScenarioValidator struct {
+	MinPlayers int
+	MaxPlayers int
+}
+
+// NewScenarioValidator creates a validator for games of minPlayers to maxPlayers players
+func NewScenarioValidator(minPlayers, maxPlayers int) ScenarioValidator {
+	return ScenarioValidator{MinPlayers: minPlayers, MaxPlayers: maxPlayers}
+}
+
+// DefaultScenarioValidator creates a validator for the usual table sizes
+func DefaultScenarioValidator() ScenarioValidator {
+	return NewScenarioValidator(DefaultValidationMinPlayers, DefaultValidationMaxPlayers)
+}
+
+// Validate checks the scenario and returns the report; it never modifies the scenario
+func (v ScenarioValidator) Validate(s *Scenario) *ValidationReport {
+	minPlayers, maxPlayers := v.MinPlayers, v.MaxPlayers
+	if minPlayers < 1 {
+		minPlayers = 1
+	}
+	if maxPlayers < minPlayers {
+		maxPlayers = minPlayers
+	}
+	report := &ValidationReport{MinPlayers: minPlayers, MaxPlayers: maxPlayers}
+
+	if err := s.Validate(); err != nil {
+		report.add(0, ValidationIssue{Severity: SeverityError, Code: IssueInvalidScenario, Detail: err.Error()})
+		return report
+	}
+	s = s.Clone() // GetRoles sets the side of default roles in place
+
+	v.checkPopulationRates(s, report)
+	v.checkRoleNames(s, report)
+
+	// Counts outside the bounds the scenario declares are never played
+	if s.MinPlayers > report.MinPlayers {
+		report.MinPlayers = s.MinPlayers
+	}
+	if s.MaxPlayers > 0 && s.MaxPlayers < report.MaxPlayers {
+		report.MaxPlayers = s.MaxPlayers
+	}
+	if report.MinPlayers > report.MaxPlayers {
+		report.MinPlayers, report.MaxPlayers = minPlayers, maxPlayers
+		report.add(0, ValidationIssue{Severity: SeverityWarning, Code: IssueBoundsOutsideRange})
+		return report
+	}
+	for n := report.MinPlayers; n <= report.MaxPlayers; n++ {
+		report.Counts = append(report.Counts, v.checkPlayerCount(s, n, report))
+	}
+	return report
+}
+
+// checkPopulationRates reports rates that add up to more than every player, or that GetRoles ignores
+func (v ScenarioValidator) checkPopulationRates(s *Scenario, report *ValidationReport) {
+	var total float32
+	for _, side := range s.Sides {
+		if side.PopulationRate == nil {
+			continue
+		}
+		rate := *side.PopulationRate
+		if rate < MinPopulationRate || rate > MaxPopulationRate {
+			report.add(0, ValidationIssue{Severity: SeverityWarning, Code: IssueRateOutOfRange, Side: side.Name, Rate: rate})
+			continue
+		}
+		if side.DefaultRole == nil {
+			report.add(0, ValidationIssue{Severity: SeverityWarning, Code: IssueRateWithoutDefaultRole, Side: side.Name})
+		}
+		total += rate
+	}
+	if total > 1 {
+		report.add(0, ValidationIssue{Severity: SeverityError, Code: IssueRatesExceedPlayers, Rate: total})
+	}
+}
+
+// checkRoleNames reports role names used by more than one side, which makes roles ambiguous in games,
+// and names repeated within a side. A default role may share its name with a role of its own side.
+func (v ScenarioValidator) checkRoleNames(s *Scenario, report *ValidationReport) {
+	owners := make(map[string]string)
+	for _, side := range s.Sides {
+		seen := make(map[string]bool)
+		names := make([]string, 0, len(side.Roles)+1)
+		for _, role := range side.Roles {
+			if seen[role.Name] {
+				report.add(0, ValidationIssue{Severity: SeverityWarning, Code: IssueDuplicateRole, Side: side.Name, Role: role.Name})
+				continue
+			}
+			seen[role.Name] = true
+			names = append(names, role.Name)
+		}
+		if side.DefaultRole != nil && !seen[side.DefaultRole.Name] {
+			names = append(names, side.DefaultRole.Name)
+		}
+		for _, name := range names {
+			if owner, ok := owners[name]; ok {
+				report.add(0, ValidationIssue{Severity: SeverityError, Code: IssueSharedRole, Role: name, Side: owner, OtherSide: side.Name})
+				continue
+			}
+			owners[name] = side.Name
+		}
+	}
+}
+
+// checkPlayerCount summarizes the roles handed out for n players and reports mismatches and unbalanced sides
+func (v ScenarioValidator) checkPlayerCount(s *Scenario, n int, report *ValidationReport) PlayerCountSummary {
+	roles := s.GetRoles(n)
+	summary := PlayerCountSummary{Players: n, Roles: len(roles), SideCounts: make(map[string]int), RoleCounts: make(map[string]int)}
+	for _, role := range roles {
+		summary.SideCounts[role.Side]++
+		summary.RoleCounts[role.Name]++
+	}
+
+	switch {
+	case len(roles) < n:
+		report.add(n, ValidationIssue{Severity: SeverityError, Code: IssueTooFewRoles})
+	case len(roles) > n:
+		report.add(n, ValidationIssue{Severity: SeverityError, Code: IssueTooManyRoles})
+	}
+
+	for _, side := range s.Sides {
+		count := summary.SideCounts[side.Name]
+		if count == 0 {
+			report.add(n, ValidationIssue{Severity: SeverityWarning, Code: IssueSideWithoutPlayers, Side: side.Name})
+			continue
+		}
+		for _, condition := range side.WinConditions {
+			if condition.Type == WinParity && count*2 >= len(roles) {
+				report.add(n, ValidationIssue{Severity: SeverityError, Code: IssueParityAtStart, Side: side.Name})
+			}
+		}
+	}
+	return summary
+}
+
+// SortedNames returns the keys of a count map in name order, for stable output
+func SortedNames(counts map[string]int) []string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
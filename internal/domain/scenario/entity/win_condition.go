@@ -0,0 +1,59 @@
+package entity
+
+import "fmt"
+
+// WinConditionType is the kind of goal a side plays for
+type WinConditionType string
+
+const (
+	// WinEliminate is met once every player of the listed sides is dead
+	WinEliminate WinConditionType = "eliminate"
+	// WinParity is met once the side's alive players are at least as many as everyone else alive
+	WinParity WinConditionType = "parity"
+	// WinSurvive makes the side win alongside the winners if any of its players is still alive
+	WinSurvive WinConditionType = "survive"
+	// WinPersonal is a goal only the moderator can judge, e.g. a neutral's task; the moderator declares it
+	WinPersonal WinConditionType = "personal"
+)
+
+// WinCondition is one way a side can win; a side wins as soon as any of its conditions is met
+type WinCondition struct {
+	Type        WinConditionType `json:"type"`
+	Sides       []string         `json:"sides,omitempty"`       // Sides to eliminate, for eliminate
+	Description string           `json:"description,omitempty"` // Shown to the moderator, mostly for personal goals
+}
+
+// validate checks the condition of a side against the sides of the scenario
+func (w WinCondition) validate(s *Scenario, side string) error {
+	switch w.Type {
+	case WinEliminate:
+		if len(w.Sides) == 0 {
+			return fmt.Errorf("side '%s': eliminate win condition must list the sides to eliminate", side)
+		}
+		for _, target := range w.Sides {
+			if target == side {
+				return fmt.Errorf("side '%s': cannot win by eliminating itself", side)
+			}
+			if !s.HasSide(target) {
+				return fmt.Errorf("side '%s': win condition references unknown side '%s'", side, target)
+			}
+		}
+	case WinParity, WinSurvive, WinPersonal:
+		if len(w.Sides) > 0 {
+			return fmt.Errorf("side '%s': only eliminate win conditions list sides", side)
+		}
+	default:
+		return fmt.Errorf("side '%s': invalid win condition '%s' (expected eliminate, parity, survive or personal)", side, w.Type)
+	}
+	return nil
+}
+
+// HasSide reports whether the scenario has a side with the given name
+func (s *Scenario) HasSide(name string) bool {
+	for _, side := range s.Sides {
+		if side.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,7 @@ type ScenarioReader interface {
 // ScenarioWriter defines the interface for writing scenario data
 type ScenarioWriter interface {
 	CreateScenario(scenario *scenarioEntity.Scenario) error
+	UpdateScenario(scenario *scenarioEntity.Scenario) error // Replaces a stored scenario; ErrScenarioNotFound if missing
 	DeleteScenario(id string) error
 }
 
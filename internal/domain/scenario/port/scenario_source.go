@@ -0,0 +1,13 @@
+package port
+
+// ScenarioFile is a raw scenario document together with the name it was found under
+type ScenarioFile struct {
+	Name string // File name, e.g. "godfather.json"
+	Data []byte
+}
+
+// ScenarioSource defines the interface for reading bundled scenario documents
+type ScenarioSource interface {
+	// ListScenarioFiles returns every scenario document available from the source
+	ListScenarioFiles() ([]ScenarioFile, error)
+}
@@ -3,7 +3,9 @@ package command
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	scenarioEntity "telemafia/internal/domain/scenario/entity"
@@ -11,19 +13,32 @@ import (
 	sharedEntity "telemafia/internal/shared/entity"
 )
 
+// ScenarioConflictPolicy decides what happens when an uploaded scenario has the same ID or name as a stored one.
+type ScenarioConflictPolicy int
+
+const (
+	// ConflictFail rejects the upload with a *scenarioEntity.ScenarioConflictError (default)
+	ConflictFail ScenarioConflictPolicy = iota
+	// ConflictReplace overwrites the existing scenario, keeping its ID
+	ConflictReplace
+	// ConflictCreateNew stores the upload as a separate scenario under a free ID
+	ConflictCreateNew
+)
+
 // AddScenarioJSONCommand represents the command to add a scenario from JSON data.
 type AddScenarioJSONCommand struct {
-	Requester sharedEntity.User
-	JSONData  string
+	Requester  sharedEntity.User
+	JSONData   string
+	OnConflict ScenarioConflictPolicy
 }
 
 // AddScenarioJSONHandler handles the AddScenarioJSONCommand.
 type AddScenarioJSONHandler struct {
-	scenarioRepo scenarioPort.ScenarioWriter
+	scenarioRepo scenarioPort.ScenarioRepository
 }
 
 // NewAddScenarioJSONHandler creates a new AddScenarioJSONHandler.
-func NewAddScenarioJSONHandler(repo scenarioPort.ScenarioWriter) *AddScenarioJSONHandler {
+func NewAddScenarioJSONHandler(repo scenarioPort.ScenarioRepository) *AddScenarioJSONHandler {
 	return &AddScenarioJSONHandler{scenarioRepo: repo}
 }
 
@@ -34,43 +49,131 @@ func (h *AddScenarioJSONHandler) Handle(ctx context.Context, cmd AddScenarioJSON
 		return nil, fmt.Errorf("permission denied: user is not an admin")
 	}
 
-	// 2. Unmarshal JSON directly into the domain entity
-	var scenario scenarioEntity.Scenario
-	if err := json.Unmarshal([]byte(cmd.JSONData), &scenario); err != nil {
-		return nil, fmt.Errorf("invalid JSON format: %w", err)
+	// 2. Unmarshal and validate JSON directly into the domain entity
+	scenario, err := parseScenarioJSON([]byte(cmd.JSONData))
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. Validate Input
-	if scenario.Name == "" {
-		return nil, fmt.Errorf("scenario name cannot be empty")
-	}
-	if len(scenario.Sides) == 0 {
-		return nil, fmt.Errorf("scenario must have at least one side defined")
+	// 3. Look for a stored scenario with the same slug or name
+	conflict, err := h.findConflict(scenario)
+	if err != nil {
+		return nil, err
 	}
 
-	for sideIdx, side := range scenario.Sides {
-		if side.Name == "" {
-			return nil, fmt.Errorf("side name cannot be empty (side index %d)", sideIdx)
-		}
-		if len(side.Roles) == 0 && side.DefaultRole == nil {
-			return nil, fmt.Errorf("side '%s' must have at least one role", side.Name)
-		}
-
-		for roleIdx, role := range side.Roles {
-			if role.Name == "" {
-				return nil, fmt.Errorf("role name cannot be empty (side '%s', role index %d)", side.Name, roleIdx)
+	if conflict != nil {
+		switch cmd.OnConflict {
+		case ConflictReplace:
+			return h.replace(conflict.Existing.ID, scenario)
+		case ConflictCreateNew:
+			if conflict.Field == "id" {
+				if scenario.ID, err = h.freeID(scenario.ID); err != nil {
+					return nil, err
+				}
 			}
+		default:
+			return nil, conflict
 		}
 	}
 
-	// 4. Assign Internal ID (Input JSON doesn't contain it)
-	scenario.ID = fmt.Sprintf("scen_%d", time.Now().UnixNano())
+	// 4. Derive a readable ID from the name when the JSON doesn't carry one
+	if scenario.ID == "" {
+		if scenario.ID, err = h.idFromName(scenario.Name); err != nil {
+			return nil, err
+		}
+	}
 
 	// 5. Persist using Repository (No transformation needed now)
-	if err := h.scenarioRepo.CreateScenario(&scenario); err != nil {
+	if err := h.scenarioRepo.CreateScenario(scenario); err != nil {
 		return nil, fmt.Errorf("failed to create scenario in repository: %w", err)
 	}
 
 	// 6. Return created entity
+	return scenario, nil
+}
+
+// replace stores the upload under the ID of the scenario it collides with. The conflict was found before
+// the lock was taken, so the scenario is looked up again: a scenario deleted meanwhile is not brought back.
+func (h *AddScenarioJSONHandler) replace(id string, scenario *scenarioEntity.Scenario) (*scenarioEntity.Scenario, error) {
+	defer lockScenario(id)()
+
+	if _, err := h.scenarioRepo.GetScenarioByID(id); err != nil {
+		return nil, fmt.Errorf("failed to replace scenario '%s': %w", id, err)
+	}
+	scenario.ID = id
+	if err := h.scenarioRepo.UpdateScenario(scenario); err != nil {
+		return nil, fmt.Errorf("failed to replace scenario in repository: %w", err)
+	}
+	return scenario, nil
+}
+
+// findConflict returns the stored scenario the upload collides with, matching the slug first and then the name.
+func (h *AddScenarioJSONHandler) findConflict(scenario *scenarioEntity.Scenario) (*scenarioEntity.ScenarioConflictError, error) {
+	if scenario.ID != "" {
+		existing, err := h.scenarioRepo.GetScenarioByID(scenario.ID)
+		if err == nil && existing != nil {
+			return &scenarioEntity.ScenarioConflictError{Existing: existing, Field: "id"}, nil
+		}
+		if err != nil && !errors.Is(err, scenarioEntity.ErrScenarioNotFound) {
+			return nil, fmt.Errorf("failed to look up scenario '%s': %w", scenario.ID, err)
+		}
+	}
+
+	all, err := h.scenarioRepo.GetAllScenarios()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scenarios: %w", err)
+	}
+	for _, existing := range all {
+		if strings.EqualFold(strings.TrimSpace(existing.Name), strings.TrimSpace(scenario.Name)) {
+			return &scenarioEntity.ScenarioConflictError{Existing: existing, Field: "name"}, nil
+		}
+	}
+	return nil, nil
+}
+
+// idFromName turns a scenario name into a free slug, e.g. "Night Fall" -> "night-fall" or "night-fall-2".
+// Names without usable characters fall back to a generated ID.
+func (h *AddScenarioJSONHandler) idFromName(name string) (string, error) {
+	slug := scenarioSlug(name)
+	if scenarioEntity.ValidateScenarioID(slug) != nil {
+		return fmt.Sprintf("scen_%d", time.Now().UnixNano()), nil
+	}
+	if _, err := h.scenarioRepo.GetScenarioByID(slug); errors.Is(err, scenarioEntity.ErrScenarioNotFound) {
+		return slug, nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to look up scenario '%s': %w", slug, err)
+	}
+	return h.freeID(slug)
+}
+
+// freeID returns the first of base-2, base-3, ... that is not taken.
+func (h *AddScenarioJSONHandler) freeID(base string) (string, error) {
+	for i := 2; i < 1000; i++ {
+		suffix := fmt.Sprintf("-%d", i)
+		prefix := base
+		if len(prefix)+len(suffix) > scenarioEntity.MaxScenarioIDLength {
+			prefix = strings.TrimRight(prefix[:scenarioEntity.MaxScenarioIDLength-len(suffix)], "-_")
+		}
+		candidate := prefix + suffix
+		if _, err := h.scenarioRepo.GetScenarioByID(candidate); errors.Is(err, scenarioEntity.ErrScenarioNotFound) {
+			return candidate, nil
+		} else if err != nil {
+			return "", fmt.Errorf("failed to look up scenario '%s': %w", candidate, err)
+		}
+	}
+	return "", fmt.Errorf("could not find a free ID for '%s'", base)
+}
+
+// parseScenarioJSON decodes a scenario document and applies the entity validation rules.
+// It is shared by every use case that accepts scenario JSON so they reject the same input.
+func parseScenarioJSON(data []byte) (*scenarioEntity.Scenario, error) {
+	var scenario scenarioEntity.Scenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("invalid JSON format: %w", err)
+	}
+	scenario.ID = strings.TrimSpace(scenario.ID)
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
 	return &scenario, nil
 }
@@ -31,5 +31,7 @@ func (h *DeleteScenarioHandler) Handle(ctx context.Context, cmd DeleteScenarioCo
 	if !cmd.Requester.Admin {
 		return errors.New("delete scenario: admin privilege required")
 	}
+	// Wait for a running edit of the scenario
+	defer lockScenario(cmd.ID)()
 	return h.scenarioRepo.DeleteScenario(cmd.ID) // Propagates errors from repo
 }
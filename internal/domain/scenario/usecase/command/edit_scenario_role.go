@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// AddRoleCommand adds a role to a side of a scenario
+type AddRoleCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+	RoleName   string
+	AddedAt    int // Minimum player count for the role to be handed out
+}
+
+// AddRoleHandler handles adding roles
+type AddRoleHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewAddRoleHandler creates a new AddRoleHandler
+func NewAddRoleHandler(repo scenarioPort.ScenarioRepository) *AddRoleHandler {
+	return &AddRoleHandler{scenarioRepo: repo}
+}
+
+// Handle adds the role and returns the updated scenario
+func (h *AddRoleHandler) Handle(ctx context.Context, cmd AddRoleCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		return s.AddRole(cmd.Side, cmd.RoleName, cmd.AddedAt)
+	})
+}
+
+// RemoveRoleCommand removes a role from a side of a scenario
+type RemoveRoleCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+	RoleIndex  int    // Position of the role in the side
+	RoleName   string // Name the caller saw at RoleIndex; the edit is refused when another role is there now
+}
+
+// RemoveRoleHandler handles removing roles
+type RemoveRoleHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewRemoveRoleHandler creates a new RemoveRoleHandler
+func NewRemoveRoleHandler(repo scenarioPort.ScenarioRepository) *RemoveRoleHandler {
+	return &RemoveRoleHandler{scenarioRepo: repo}
+}
+
+// Handle removes the role and returns the updated scenario
+func (h *RemoveRoleHandler) Handle(ctx context.Context, cmd RemoveRoleCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		if err := checkRoleName(s, cmd.Side, cmd.RoleIndex, cmd.RoleName); err != nil {
+			return err
+		}
+		return s.RemoveRole(cmd.Side, cmd.RoleIndex)
+	})
+}
+
+// SetRoleAddedAtCommand sets the player count from which a role is handed out
+type SetRoleAddedAtCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+	RoleIndex  int    // Position of the role in the side
+	RoleName   string // Name the caller saw at RoleIndex; the edit is refused when another role is there now
+	AddedAt    int
+}
+
+// SetRoleAddedAtHandler handles added_at changes
+type SetRoleAddedAtHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewSetRoleAddedAtHandler creates a new SetRoleAddedAtHandler
+func NewSetRoleAddedAtHandler(repo scenarioPort.ScenarioRepository) *SetRoleAddedAtHandler {
+	return &SetRoleAddedAtHandler{scenarioRepo: repo}
+}
+
+// Handle sets added_at of the role and returns the updated scenario
+func (h *SetRoleAddedAtHandler) Handle(ctx context.Context, cmd SetRoleAddedAtCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		if err := checkRoleName(s, cmd.Side, cmd.RoleIndex, cmd.RoleName); err != nil {
+			return err
+		}
+		return s.SetRoleAddedAt(cmd.Side, cmd.RoleIndex, cmd.AddedAt)
+	})
+}
+
+// checkRoleName makes sure the role at index of a side still has the expected name, so an edit made from
+// an outdated role list does not hit the role that moved into its place. An empty name skips the check.
+func checkRoleName(s *scenarioEntity.Scenario, sideName string, index int, name string) error {
+	if name == "" {
+		return nil
+	}
+	for _, side := range s.Sides {
+		if side.Name != sideName {
+			continue
+		}
+		if index < 0 || index >= len(side.Roles) || side.Roles[index].Name != name {
+			return fmt.Errorf("%w: role '%s' is no longer #%d of side '%s'", scenarioEntity.ErrRoleNotFound, name, index+1, sideName)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: '%s'", scenarioEntity.ErrSideNotFound, sideName)
+}
@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// editScenario applies an edit to a copy of a stored scenario, validates the result and stores it.
+// Only admins may edit scenarios; edits of the same scenario run one at a time.
+func editScenario(repo scenarioPort.ScenarioRepository, requester sharedEntity.User, id string, edit func(*scenarioEntity.Scenario) error) (*scenarioEntity.Scenario, error) {
+	if !requester.Admin {
+		return nil, errors.New("edit scenario: admin privilege required")
+	}
+	defer lockScenario(id)()
+
+	stored, err := repo.GetScenarioByID(id)
+	if err != nil {
+		return nil, err
+	}
+	scenario := stored.Clone()
+	if err := edit(scenario); err != nil {
+		return nil, err
+	}
+	if err := scenario.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario after edit: %w", err)
+	}
+	if err := repo.UpdateScenario(scenario); err != nil {
+		return nil, fmt.Errorf("failed to update scenario: %w", err)
+	}
+	return scenario, nil
+}
+
+// AddSideCommand adds a side with its default role to a scenario
+type AddSideCommand struct {
+	Requester   sharedEntity.User
+	ScenarioID  string
+	Name        string
+	DefaultRole string
+}
+
+// AddSideHandler handles adding sides
+type AddSideHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewAddSideHandler creates a new AddSideHandler
+func NewAddSideHandler(repo scenarioPort.ScenarioRepository) *AddSideHandler {
+	return &AddSideHandler{scenarioRepo: repo}
+}
+
+// Handle adds the side and returns the updated scenario
+func (h *AddSideHandler) Handle(ctx context.Context, cmd AddSideCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		return s.AddSide(cmd.Name, cmd.DefaultRole)
+	})
+}
+
+// RenameSideCommand renames a side of a scenario
+type RenameSideCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+	NewName    string
+}
+
+// RenameSideHandler handles renaming sides
+type RenameSideHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewRenameSideHandler creates a new RenameSideHandler
+func NewRenameSideHandler(repo scenarioPort.ScenarioRepository) *RenameSideHandler {
+	return &RenameSideHandler{scenarioRepo: repo}
+}
+
+// Handle renames the side and returns the updated scenario
+func (h *RenameSideHandler) Handle(ctx context.Context, cmd RenameSideCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		return s.RenameSide(cmd.Side, cmd.NewName)
+	})
+}
+
+// RemoveSideCommand removes a side and its roles from a scenario
+type RemoveSideCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+}
+
+// RemoveSideHandler handles removing sides
+type RemoveSideHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewRemoveSideHandler creates a new RemoveSideHandler
+func NewRemoveSideHandler(repo scenarioPort.ScenarioRepository) *RemoveSideHandler {
+	return &RemoveSideHandler{scenarioRepo: repo}
+}
+
+// Handle removes the side and returns the updated scenario
+func (h *RemoveSideHandler) Handle(ctx context.Context, cmd RemoveSideCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		return s.RemoveSide(cmd.Side)
+	})
+}
+
+// SetPopulationRateCommand sets the share of players a side gets
+type SetPopulationRateCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+	Rate       *float32 // nil lets the default role fill the remaining seats
+}
+
+// SetPopulationRateHandler handles population rate changes
+type SetPopulationRateHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewSetPopulationRateHandler creates a new SetPopulationRateHandler
+func NewSetPopulationRateHandler(repo scenarioPort.ScenarioRepository) *SetPopulationRateHandler {
+	return &SetPopulationRateHandler{scenarioRepo: repo}
+}
+
+// Handle sets the population rate and returns the updated scenario
+func (h *SetPopulationRateHandler) Handle(ctx context.Context, cmd SetPopulationRateCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		return s.SetPopulationRate(cmd.Side, cmd.Rate)
+	})
+}
+
+// SetDefaultRoleCommand sets the role that fills a side's remaining seats
+type SetDefaultRoleCommand struct {
+	Requester  sharedEntity.User
+	ScenarioID string
+	Side       string
+	RoleName   string // Empty removes the default role
+}
+
+// SetDefaultRoleHandler handles default role changes
+type SetDefaultRoleHandler struct {
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewSetDefaultRoleHandler creates a new SetDefaultRoleHandler
+func NewSetDefaultRoleHandler(repo scenarioPort.ScenarioRepository) *SetDefaultRoleHandler {
+	return &SetDefaultRoleHandler{scenarioRepo: repo}
+}
+
+// Handle sets the default role and returns the updated scenario
+func (h *SetDefaultRoleHandler) Handle(ctx context.Context, cmd SetDefaultRoleCommand) (*scenarioEntity.Scenario, error) {
+	return editScenario(h.scenarioRepo, cmd.Requester, cmd.ScenarioID, func(s *scenarioEntity.Scenario) error {
+		return s.SetDefaultRole(cmd.Side, cmd.RoleName)
+	})
+}
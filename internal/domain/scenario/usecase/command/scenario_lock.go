@@ -0,0 +1,11 @@
+package command
+
+import "telemafia/internal/shared/common"
+
+// scenarioLocks runs the edits, replacements and deletions of one stored scenario one at a time
+var scenarioLocks common.KeyedMutex[string]
+
+// lockScenario blocks until no other command rewrites the scenario and returns the function that releases it
+func lockScenario(id string) (unlock func()) {
+	return scenarioLocks.Lock(id)
+}
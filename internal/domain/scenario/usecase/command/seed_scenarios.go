@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+)
+
+// SeedScenariosCommand represents the command to load the bundled scenario files.
+// It is issued by the application itself at startup, so it carries no requester.
+type SeedScenariosCommand struct {
+	// Overwrite replaces stored scenarios with the file contents. By default missing scenarios are created
+	// and stored ones are only updated while they still hold what was last seeded, so edits made in the bot
+	// and replaced uploads survive a restart.
+	Overwrite bool
+}
+
+// SeedScenarioResult reports the outcome of seeding a single file
+type SeedScenarioResult struct {
+	File       string
+	ScenarioID string
+	Name       string
+	Replaced   bool  // true if an existing scenario was overwritten
+	Unchanged  bool  // true if the stored scenario already matched the file
+	Skipped    bool  // true if the stored scenario was edited in the bot and was kept
+	Err        error // nil on success
+}
+
+// SeedScenariosHandler loads scenarios from a ScenarioSource and upserts them by a stable ID
+type SeedScenariosHandler struct {
+	source       scenarioPort.ScenarioSource
+	scenarioRepo scenarioPort.ScenarioRepository
+}
+
+// NewSeedScenariosHandler creates a new SeedScenariosHandler
+func NewSeedScenariosHandler(source scenarioPort.ScenarioSource, repo scenarioPort.ScenarioRepository) *SeedScenariosHandler {
+	return &SeedScenariosHandler{source: source, scenarioRepo: repo}
+}
+
+// Handle seeds every file of the source. A bad file does not stop the others;
+// its error is reported in the returned results. The error return is for source failures only.
+func (h *SeedScenariosHandler) Handle(ctx context.Context, cmd SeedScenariosCommand) ([]SeedScenarioResult, error) {
+	files, err := h.source.ListScenarioFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SeedScenarioResult, 0, len(files))
+	for _, file := range files {
+		results = append(results, h.seedFile(file, cmd.Overwrite))
+	}
+	return results, nil
+}
+
+func (h *SeedScenariosHandler) seedFile(file scenarioPort.ScenarioFile, overwrite bool) SeedScenarioResult {
+	result := SeedScenarioResult{File: file.Name}
+
+	scenario, err := parseScenarioJSON(file.Data)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	// An explicit "id" in the file wins over the one derived from the file name
+	if scenario.ID == "" {
+		scenario.ID = ScenarioIDFromFileName(file.Name)
+		if err := scenarioEntity.ValidateScenarioID(scenario.ID); err != nil {
+			result.Err = fmt.Errorf("cannot derive a scenario ID from file name '%s': %w", file.Name, err)
+			return result
+		}
+	}
+	result.ScenarioID = scenario.ID
+	result.Name = scenario.Name
+	if scenario.SeedChecksum, err = scenario.Checksum(); err != nil {
+		result.Err = fmt.Errorf("failed to fingerprint scenario: %w", err)
+		return result
+	}
+
+	defer lockScenario(scenario.ID)()
+	stored, err := h.scenarioRepo.GetScenarioByID(scenario.ID)
+	switch {
+	case errors.Is(err, scenarioEntity.ErrScenarioNotFound):
+		if err := h.scenarioRepo.CreateScenario(scenario); err != nil {
+			result.Err = fmt.Errorf("failed to create scenario in repository: %w", err)
+		}
+	case err != nil:
+		result.Err = fmt.Errorf("failed to look up scenario in repository: %w", err)
+	case !overwrite && stored.EditedSinceSeed():
+		// The stored copy was edited in the bot or replaced by an upload
+		result.Skipped = true
+	case !overwrite && stored.SeedChecksum == scenario.SeedChecksum:
+		result.Unchanged = true
+	default:
+		if err := h.scenarioRepo.UpdateScenario(scenario); err != nil {
+			result.Err = fmt.Errorf("failed to update scenario in repository: %w", err)
+		} else {
+			result.Replaced = true
+		}
+	}
+	return result
+}
+
+// ScenarioIDFromFileName derives a stable scenario slug from a file name,
+// e.g. "GodFather.json" -> "godfather", "Night Fall.json" -> "night-fall", cut to MaxScenarioIDLength characters.
+func ScenarioIDFromFileName(fileName string) string {
+	return scenarioSlug(strings.TrimSuffix(path.Base(fileName), path.Ext(fileName)))
+}
+
+// scenarioSlug keeps the letters, digits, '-' and '_' of s in lower case, turning spaces and dots into '-',
+// and cuts the result to MaxScenarioIDLength characters
+func scenarioSlug(s string) string {
+	base := strings.ToLower(strings.TrimSpace(s))
+
+	var b strings.Builder
+	for _, r := range base {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ' || r == '.':
+			b.WriteRune('-')
+		}
+	}
+	slug := strings.Trim(b.String(), "-_")
+	if len(slug) > scenarioEntity.MaxScenarioIDLength {
+		slug = strings.TrimRight(slug[:scenarioEntity.MaxScenarioIDLength], "-_")
+	}
+	return slug
+}
@@ -0,0 +1,91 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// ExportedScenario is a scenario encoded as a JSON document
+type ExportedScenario struct {
+	FileName string
+	Name     string
+	Data     []byte
+}
+
+// ExportScenarioQuery represents the query to export a scenario by ID
+type ExportScenarioQuery struct {
+	Requester sharedEntity.User
+	ID        string
+}
+
+// ExportScenarioHandler handles exporting a single scenario
+type ExportScenarioHandler struct {
+	scenarioRepo scenarioPort.ScenarioReader
+}
+
+// NewExportScenarioHandler creates a new ExportScenarioHandler
+func NewExportScenarioHandler(repo scenarioPort.ScenarioReader) *ExportScenarioHandler {
+	return &ExportScenarioHandler{scenarioRepo: repo}
+}
+
+// Handle encodes the scenario as JSON
+func (h *ExportScenarioHandler) Handle(ctx context.Context, query ExportScenarioQuery) (*ExportedScenario, error) {
+	if !query.Requester.Admin {
+		return nil, errors.New("export scenario: admin privilege required")
+	}
+	scenario, err := h.scenarioRepo.GetScenarioByID(query.ID)
+	if err != nil {
+		return nil, err
+	}
+	return exportScenario(scenario)
+}
+
+// ExportAllScenariosQuery represents the query to export every scenario
+type ExportAllScenariosQuery struct {
+	Requester sharedEntity.User
+}
+
+// ExportAllScenariosHandler handles exporting every scenario
+type ExportAllScenariosHandler struct {
+	scenarioRepo scenarioPort.ScenarioReader
+}
+
+// NewExportAllScenariosHandler creates a new ExportAllScenariosHandler
+func NewExportAllScenariosHandler(repo scenarioPort.ScenarioReader) *ExportAllScenariosHandler {
+	return &ExportAllScenariosHandler{scenarioRepo: repo}
+}
+
+// Handle encodes every scenario as JSON, in file name order
+func (h *ExportAllScenariosHandler) Handle(ctx context.Context, query ExportAllScenariosQuery) ([]ExportedScenario, error) {
+	if !query.Requester.Admin {
+		return nil, errors.New("export scenarios: admin privilege required")
+	}
+	scenarios, err := h.scenarioRepo.GetAllScenarios()
+	if err != nil {
+		return nil, err
+	}
+	exported := make([]ExportedScenario, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		file, err := exportScenario(scenario)
+		if err != nil {
+			return nil, err
+		}
+		exported = append(exported, *file)
+	}
+	sort.Slice(exported, func(i, j int) bool { return exported[i].FileName < exported[j].FileName })
+	return exported, nil
+}
+
+func exportScenario(scenario *scenarioEntity.Scenario) (*ExportedScenario, error) {
+	data, err := scenario.ExportJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode scenario %s: %w", scenario.ID, err)
+	}
+	return &ExportedScenario{FileName: scenario.ExportFileName(), Name: scenario.Name, Data: data}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"telemafia/internal/shared/entity"
 	"telemafia/internal/shared/tgutil"
+	"time"
 
 	// gameUsecase "telemafia/internal/game/usecase"
 	gameEntity "telemafia/internal/domain/game/entity"
@@ -14,8 +15,11 @@ import (
 	gameQuery "telemafia/internal/domain/game/usecase/query"
 
 	// roomPort "telemafia/internal/room/port" // Import room port for RoomWriter interface
+	roomEntity "telemafia/internal/domain/room/entity"
 	roomPort "telemafia/internal/domain/room/port"
 	// roomUsecase "telemafia/internal/room/usecase"
+	mediaCommand "telemafia/internal/domain/media/usecase/command"
+	mediaQuery "telemafia/internal/domain/media/usecase/query"
 	roomCommand "telemafia/internal/domain/room/usecase/command"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
 
@@ -53,6 +57,54 @@ type BotHandler struct {
 	playerRoleChoiceRefreshers map[gameEntity.GameID]*tgutil.RefreshingMessageBook
 	adminRefreshMutex          sync.RWMutex // Mutex for admin refreshers map
 	adminAssignmentTrackers    map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+	// Persist the rounds above so they survive restarts
+	saveRoleSelectionHandler   *gameCommand.SaveRoleSelectionHandler
+	deleteRoleSelectionHandler *gameCommand.DeleteRoleSelectionHandler
+	getRoleSelectionsHandler   *gameQuery.GetRoleSelectionsHandler
+
+	// Moderator phase panels, keyed by game
+	phasePanelsMutex sync.RWMutex
+	phasePanels      map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+
+	// Live tallies of open day votes, keyed by game
+	voteBooksMutex sync.RWMutex
+	voteBooks      map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+
+	// Countdown messages of phase timers, per game
+	timerBooksMutex sync.RWMutex
+	timerBooks      map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+
+	// Ready buttons of the players and rosters of the moderators, per game
+	readyBooksMutex sync.RWMutex
+	readyBooks      map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+
+	// Talk order messages of speaking turns, per game
+	turnsBooksMutex sync.RWMutex
+	turnsBooks      map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+
+	// Moderator night summaries, keyed by game
+	nightSummariesMutex sync.RWMutex
+	nightSummaries      map[gameEntity.GameID]*tgutil.RefreshingMessageBook
+
+	// Targets picked so far for abilities that need several, keyed by "gameID|userID|ability"
+	nightDraftsMutex  sync.Mutex
+	nightActionDrafts map[string][]entity.UserID
+
+	// Night action prompts sent to the players, keyed by game; their buttons are removed once the night is over
+	nightPromptsMutex sync.Mutex
+	nightPrompts      map[gameEntity.GameID][]*tgutil.RefreshingMessage
+
+	// Scenario uploads waiting for a replace-or-create decision, keyed by uploader ID
+	pendingUploadsMutex    sync.Mutex
+	pendingScenarioUploads map[int64]string
+
+	// Text messages the bot asked for about a room (passwords), keyed by user ID
+	pendingRoomInputsMutex sync.Mutex
+	pendingRoomInputs      map[int64]room.PendingRoomInput
+
+	// Names the scenario editor asked admins to type, by user ID
+	pendingScenarioInputsMutex sync.Mutex
+	pendingScenarioInputs      map[int64]scenario.PendingScenarioInput
 
 	// // Refresh state (moved from repository) - REMOVED
 	// refreshMutex            sync.RWMutex
@@ -60,28 +112,71 @@ type BotHandler struct {
 	// activeRefreshMessages   map[int64]*telebot.Message // Map ChatID to the message being refreshed
 
 	// Use Case Handlers
-	roomRepo                roomPort.RoomWriter                     // Use roomPort
-	createRoomHandler       *roomCommand.CreateRoomHandler          // Use roomCommand
-	joinRoomHandler         *roomCommand.JoinRoomHandler            // Use roomCommand
-	leaveRoomHandler        *roomCommand.LeaveRoomHandler           // Use roomCommand
-	kickUserHandler         *roomCommand.KickUserHandler            // Use roomCommand
-	deleteRoomHandler       *roomCommand.DeleteRoomHandler          // Use roomCommand
-	getRoomsHandler         *roomQuery.GetRoomsHandler              // Use roomQuery
-	getPlayerRoomsHandler   *roomQuery.GetPlayerRoomsHandler        // Use roomQuery
-	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler      // Use roomQuery
-	getRoomHandler          *roomQuery.GetRoomHandler               // Use roomQuery
-	addDescriptionHandler   *roomCommand.AddDescriptionHandler      // Add handler field
-	changeModeratorHandler  *roomCommand.ChangeModeratorHandler     // Add ChangeModeratorHandler field
-	createScenarioHandler   *scenarioCommand.CreateScenarioHandler  // Use scenarioCommand
-	deleteScenarioHandler   *scenarioCommand.DeleteScenarioHandler  // Use scenarioCommand
-	getScenarioByIDHandler  *scenarioQuery.GetScenarioByIDHandler   // Use scenarioQuery
-	getAllScenariosHandler  *scenarioQuery.GetAllScenariosHandler   // Use scenarioQuery
-	addScenarioJSONHandler  *scenarioCommand.AddScenarioJSONHandler // NEW: Inject AddScenarioJSONHandler
-	assignRolesHandler      *gameCommand.AssignRolesHandler         // Use gameCommand
-	createGameHandler       *gameCommand.CreateGameHandler          // Use gameCommand
-	updateGameHandler       *gameCommand.UpdateGameHandler          // ADDED: Update Game Handler
-	getGamesHandler         *gameQuery.GetGamesHandler              // Use gameQuery
-	getGameByIDHandler      *gameQuery.GetGameByIDHandler           // Use gameQuery
+	roomRepo                  roomPort.RoomWriter                     // Use roomPort
+	createRoomHandler         *roomCommand.CreateRoomHandler          // Use roomCommand
+	joinRoomHandler           *roomCommand.JoinRoomHandler            // Use roomCommand
+	leaveRoomHandler          *roomCommand.LeaveRoomHandler           // Use roomCommand
+	kickUserHandler           *roomCommand.KickUserHandler            // Use roomCommand
+	deleteRoomHandler         *roomCommand.DeleteRoomHandler          // Use roomCommand
+	getRoomsHandler           *roomQuery.GetRoomsHandler              // Use roomQuery
+	getPlayerRoomsHandler     *roomQuery.GetPlayerRoomsHandler        // Use roomQuery
+	getPlayersInRoomHandler   *roomQuery.GetPlayersInRoomHandler      // Use roomQuery
+	getRoomHandler            *roomQuery.GetRoomHandler               // Use roomQuery
+	addDescriptionHandler     *roomCommand.AddDescriptionHandler      // Add handler field
+	changeModeratorHandler    *roomCommand.ChangeModeratorHandler     // Add ChangeModeratorHandler field
+	createScenarioHandler     *scenarioCommand.CreateScenarioHandler  // Use scenarioCommand
+	deleteScenarioHandler     *scenarioCommand.DeleteScenarioHandler  // Use scenarioCommand
+	getScenarioByIDHandler    *scenarioQuery.GetScenarioByIDHandler   // Use scenarioQuery
+	getAllScenariosHandler    *scenarioQuery.GetAllScenariosHandler   // Use scenarioQuery
+	addScenarioJSONHandler    *scenarioCommand.AddScenarioJSONHandler // NEW: Inject AddScenarioJSONHandler
+	assignRolesHandler        *gameCommand.AssignRolesHandler         // Use gameCommand
+	createGameHandler         *gameCommand.CreateGameHandler          // Use gameCommand
+	updateGameHandler         *gameCommand.UpdateGameHandler          // ADDED: Update Game Handler
+	getGamesHandler           *gameQuery.GetGamesHandler              // Use gameQuery
+	getGameByIDHandler        *gameQuery.GetGameByIDHandler           // Use gameQuery
+	startNightHandler         *gameCommand.StartNightHandler
+	startDayHandler           *gameCommand.StartDayHandler
+	startVotingHandler        *gameCommand.StartVotingHandler
+	startDefenseHandler       *gameCommand.StartDefenseHandler
+	endGameHandler            *gameCommand.EndGameHandler
+	eliminatePlayerHandler    *gameCommand.EliminatePlayerHandler
+	revivePlayerHandler       *gameCommand.RevivePlayerHandler
+	getGameByRoomIDHandler    *gameQuery.GetGameByRoomIDHandler
+	openVoteHandler           *gameCommand.OpenVoteHandler
+	castVoteHandler           *gameCommand.CastVoteHandler
+	closeVoteHandler          *gameCommand.CloseVoteHandler
+	submitNightActionHandler  *gameCommand.SubmitNightActionHandler
+	resolveNightHandler       *gameCommand.ResolveNightHandler
+	startTimerHandler         *gameCommand.StartTimerHandler
+	stopTimerHandler          *gameCommand.StopTimerHandler
+	tickTimerHandler          *gameCommand.TickTimerHandler
+	startTurnsHandler         *gameCommand.StartSpeakingTurnsHandler
+	nextSpeakerHandler        *gameCommand.NextSpeakerHandler
+	requestChallengeHandler   *gameCommand.RequestChallengeHandler
+	grantChallengeHandler     *gameCommand.GrantChallengeHandler
+	moveSeatHandler           *roomCommand.MoveSeatHandler
+	shuffleSeatsHandler       *roomCommand.ShuffleSeatsHandler
+	setRoomCapacityHandler    *roomCommand.SetRoomCapacityHandler
+	startReadyCheckHandler    *gameCommand.StartReadyCheckHandler
+	markReadyHandler          *gameCommand.MarkReadyHandler
+	kickUnreadyHandler        *gameCommand.KickUnreadyPlayersHandler
+	tickReadyCheckHandler     *gameCommand.TickReadyCheckHandler
+	syncReadyCheckHandler     *gameCommand.SyncReadyCheckHandler
+	watchRoomHandler          *roomCommand.WatchRoomHandler
+	setRoomVisibilityHandler  *roomCommand.SetRoomVisibilityHandler
+	resetInviteHandler        *roomCommand.ResetInviteHandler
+	resolveMediaHandler       *mediaQuery.ResolveMediaHandler
+	rememberMediaHandler      *mediaCommand.RememberMediaHandler
+	addSideHandler            *scenarioCommand.AddSideHandler
+	renameSideHandler         *scenarioCommand.RenameSideHandler
+	removeSideHandler         *scenarioCommand.RemoveSideHandler
+	setPopulationRateHandler  *scenarioCommand.SetPopulationRateHandler
+	setDefaultRoleHandler     *scenarioCommand.SetDefaultRoleHandler
+	addRoleHandler            *scenarioCommand.AddRoleHandler
+	removeRoleHandler         *scenarioCommand.RemoveRoleHandler
+	setRoleAddedAtHandler     *scenarioCommand.SetRoleAddedAtHandler
+	exportScenarioHandler     *scenarioQuery.ExportScenarioHandler
+	exportAllScenariosHandler *scenarioQuery.ExportAllScenariosHandler
 }
 
 // --- Methods implementing BotHandlerInterface --- (NEW)
@@ -102,6 +197,38 @@ func (h *BotHandler) GetScenarioByIDHandler() *scenarioQuery.GetScenarioByIDHand
 	return h.getScenarioByIDHandler
 }
 
+func (h *BotHandler) AddSideHandler() *scenarioCommand.AddSideHandler {
+	return h.addSideHandler
+}
+
+func (h *BotHandler) RenameSideHandler() *scenarioCommand.RenameSideHandler {
+	return h.renameSideHandler
+}
+
+func (h *BotHandler) RemoveSideHandler() *scenarioCommand.RemoveSideHandler {
+	return h.removeSideHandler
+}
+
+func (h *BotHandler) SetPopulationRateHandler() *scenarioCommand.SetPopulationRateHandler {
+	return h.setPopulationRateHandler
+}
+
+func (h *BotHandler) SetDefaultRoleHandler() *scenarioCommand.SetDefaultRoleHandler {
+	return h.setDefaultRoleHandler
+}
+
+func (h *BotHandler) AddRoleHandler() *scenarioCommand.AddRoleHandler {
+	return h.addRoleHandler
+}
+
+func (h *BotHandler) RemoveRoleHandler() *scenarioCommand.RemoveRoleHandler {
+	return h.removeRoleHandler
+}
+
+func (h *BotHandler) SetRoleAddedAtHandler() *scenarioCommand.SetRoleAddedAtHandler {
+	return h.setRoleAddedAtHandler
+}
+
 func (h *BotHandler) AssignRolesHandler() *gameCommand.AssignRolesHandler {
 	return h.assignRolesHandler
 }
@@ -114,6 +241,107 @@ func (h *BotHandler) UpdateGameHandler() *gameCommand.UpdateGameHandler {
 	return h.updateGameHandler
 }
 
+func (h *BotHandler) StartNightHandler() *gameCommand.StartNightHandler {
+	return h.startNightHandler
+}
+
+func (h *BotHandler) StartDayHandler() *gameCommand.StartDayHandler {
+	return h.startDayHandler
+}
+
+func (h *BotHandler) StartVotingHandler() *gameCommand.StartVotingHandler {
+	return h.startVotingHandler
+}
+
+func (h *BotHandler) StartDefenseHandler() *gameCommand.StartDefenseHandler {
+	return h.startDefenseHandler
+}
+
+func (h *BotHandler) EndGameHandler() *gameCommand.EndGameHandler {
+	return h.endGameHandler
+}
+
+func (h *BotHandler) EliminatePlayerHandler() *gameCommand.EliminatePlayerHandler {
+	return h.eliminatePlayerHandler
+}
+
+func (h *BotHandler) RevivePlayerHandler() *gameCommand.RevivePlayerHandler {
+	return h.revivePlayerHandler
+}
+
+func (h *BotHandler) GetGameByRoomIDHandler() *gameQuery.GetGameByRoomIDHandler {
+	return h.getGameByRoomIDHandler
+}
+
+func (h *BotHandler) OpenVoteHandler() *gameCommand.OpenVoteHandler {
+	return h.openVoteHandler
+}
+
+func (h *BotHandler) CastVoteHandler() *gameCommand.CastVoteHandler {
+	return h.castVoteHandler
+}
+
+func (h *BotHandler) CloseVoteHandler() *gameCommand.CloseVoteHandler {
+	return h.closeVoteHandler
+}
+
+func (h *BotHandler) SubmitNightActionHandler() *gameCommand.SubmitNightActionHandler {
+	return h.submitNightActionHandler
+}
+
+func (h *BotHandler) ResolveNightHandler() *gameCommand.ResolveNightHandler {
+	return h.resolveNightHandler
+}
+
+func (h *BotHandler) StartTimerHandler() *gameCommand.StartTimerHandler {
+	return h.startTimerHandler
+}
+
+func (h *BotHandler) StopTimerHandler() *gameCommand.StopTimerHandler {
+	return h.stopTimerHandler
+}
+
+func (h *BotHandler) StartSpeakingTurnsHandler() *gameCommand.StartSpeakingTurnsHandler {
+	return h.startTurnsHandler
+}
+
+func (h *BotHandler) NextSpeakerHandler() *gameCommand.NextSpeakerHandler {
+	return h.nextSpeakerHandler
+}
+
+func (h *BotHandler) RequestChallengeHandler() *gameCommand.RequestChallengeHandler {
+	return h.requestChallengeHandler
+}
+
+func (h *BotHandler) GrantChallengeHandler() *gameCommand.GrantChallengeHandler {
+	return h.grantChallengeHandler
+}
+
+func (h *BotHandler) StartReadyCheckHandler() *gameCommand.StartReadyCheckHandler {
+	return h.startReadyCheckHandler
+}
+
+func (h *BotHandler) MarkReadyHandler() *gameCommand.MarkReadyHandler {
+	return h.markReadyHandler
+}
+
+func (h *BotHandler) KickUnreadyPlayersHandler() *gameCommand.KickUnreadyPlayersHandler {
+	return h.kickUnreadyHandler
+}
+
+func (h *BotHandler) SyncReadyCheckHandler() *gameCommand.SyncReadyCheckHandler {
+	return h.syncReadyCheckHandler
+}
+
+// SeatsChanged lets the open ready-check of the room's game follow players who took or gave up seats
+func (h *BotHandler) SeatsChanged(roomID roomEntity.RoomID, seated, unseated []entity.UserID) {
+	game.FollowReadyCheckSeats(h, roomID, seated, unseated, h.msgs)
+}
+
+func (h *BotHandler) RaiseRoomDetailRefresh() {
+	h.roomDetailRefreshMessage.RaiseRefreshNeeded()
+}
+
 // --- End Interface Methods ---
 
 // --- Refresh Book Management for Game Role Selection ---
@@ -126,6 +354,9 @@ func NewBotHandler(
 	adminUsernames []string,
 	msgs *messages.Messages, // Add messages parameter
 	roomRepo roomPort.RoomWriter, // Use roomPort
+	saveRoleSelectionHandler *gameCommand.SaveRoleSelectionHandler,
+	deleteRoleSelectionHandler *gameCommand.DeleteRoleSelectionHandler,
+	getRoleSelectionsHandler *gameQuery.GetRoleSelectionsHandler,
 	createRoomHandler *roomCommand.CreateRoomHandler, // Use roomCommand
 	joinRoomHandler *roomCommand.JoinRoomHandler, // Use roomCommand
 	leaveRoomHandler *roomCommand.LeaveRoomHandler, // Use roomCommand
@@ -147,6 +378,49 @@ func NewBotHandler(
 	updateGameHandler *gameCommand.UpdateGameHandler, // ADDED Parameter
 	getGamesHandler *gameQuery.GetGamesHandler, // Use gameQuery
 	getGameByIDHandler *gameQuery.GetGameByIDHandler, // Use gameQuery
+	startNightHandler *gameCommand.StartNightHandler,
+	startDayHandler *gameCommand.StartDayHandler,
+	startVotingHandler *gameCommand.StartVotingHandler,
+	startDefenseHandler *gameCommand.StartDefenseHandler,
+	endGameHandler *gameCommand.EndGameHandler,
+	eliminatePlayerHandler *gameCommand.EliminatePlayerHandler,
+	revivePlayerHandler *gameCommand.RevivePlayerHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	openVoteHandler *gameCommand.OpenVoteHandler,
+	castVoteHandler *gameCommand.CastVoteHandler,
+	closeVoteHandler *gameCommand.CloseVoteHandler,
+	submitNightActionHandler *gameCommand.SubmitNightActionHandler,
+	resolveNightHandler *gameCommand.ResolveNightHandler,
+	startTimerHandler *gameCommand.StartTimerHandler,
+	stopTimerHandler *gameCommand.StopTimerHandler,
+	tickTimerHandler *gameCommand.TickTimerHandler,
+	startTurnsHandler *gameCommand.StartSpeakingTurnsHandler,
+	nextSpeakerHandler *gameCommand.NextSpeakerHandler,
+	requestChallengeHandler *gameCommand.RequestChallengeHandler,
+	grantChallengeHandler *gameCommand.GrantChallengeHandler,
+	moveSeatHandler *roomCommand.MoveSeatHandler,
+	shuffleSeatsHandler *roomCommand.ShuffleSeatsHandler,
+	setRoomCapacityHandler *roomCommand.SetRoomCapacityHandler,
+	startReadyCheckHandler *gameCommand.StartReadyCheckHandler,
+	markReadyHandler *gameCommand.MarkReadyHandler,
+	kickUnreadyHandler *gameCommand.KickUnreadyPlayersHandler,
+	tickReadyCheckHandler *gameCommand.TickReadyCheckHandler,
+	syncReadyCheckHandler *gameCommand.SyncReadyCheckHandler,
+	watchRoomHandler *roomCommand.WatchRoomHandler,
+	setRoomVisibilityHandler *roomCommand.SetRoomVisibilityHandler,
+	resetInviteHandler *roomCommand.ResetInviteHandler,
+	resolveMediaHandler *mediaQuery.ResolveMediaHandler,
+	rememberMediaHandler *mediaCommand.RememberMediaHandler,
+	addSideHandler *scenarioCommand.AddSideHandler,
+	renameSideHandler *scenarioCommand.RenameSideHandler,
+	removeSideHandler *scenarioCommand.RemoveSideHandler,
+	setPopulationRateHandler *scenarioCommand.SetPopulationRateHandler,
+	setDefaultRoleHandler *scenarioCommand.SetDefaultRoleHandler,
+	addRoleHandler *scenarioCommand.AddRoleHandler,
+	removeRoleHandler *scenarioCommand.RemoveRoleHandler,
+	setRoleAddedAtHandler *scenarioCommand.SetRoleAddedAtHandler,
+	exportScenarioHandler *scenarioQuery.ExportScenarioHandler,
+	exportAllScenariosHandler *scenarioQuery.ExportAllScenariosHandler,
 ) *BotHandler {
 	// Set admin users for util package (now moved)
 	tgutil.SetAdminUsers(adminUsernames)
@@ -171,6 +445,7 @@ func NewBotHandler(
 			return room.RoomDetailMessage(
 				getRoomsHandler,
 				getPlayersInRoomHandler,
+				getGameByRoomIDHandler,
 				msgs,
 				entity.UserID(user),
 				data,
@@ -179,6 +454,20 @@ func NewBotHandler(
 		interactiveSelections:      make(map[gameEntity.GameID]*tgutil.InteractiveSelectionState), // Use tgutil type
 		playerRoleChoiceRefreshers: make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
 		adminAssignmentTrackers:    make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		phasePanels:                make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		voteBooks:                  make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		timerBooks:                 make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		readyBooks:                 make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		turnsBooks:                 make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		nightSummaries:             make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook),
+		nightActionDrafts:          make(map[string][]entity.UserID),
+		nightPrompts:               make(map[gameEntity.GameID][]*tgutil.RefreshingMessage),
+		pendingScenarioUploads:     make(map[int64]string),
+		pendingRoomInputs:          make(map[int64]room.PendingRoomInput),
+		pendingScenarioInputs:      make(map[int64]scenario.PendingScenarioInput),
+		saveRoleSelectionHandler:   saveRoleSelectionHandler,
+		deleteRoleSelectionHandler: deleteRoleSelectionHandler,
+		getRoleSelectionsHandler:   getRoleSelectionsHandler,
 		roomRepo:                   roomRepo,
 		createRoomHandler:          createRoomHandler,
 		joinRoomHandler:            joinRoomHandler,
@@ -201,6 +490,49 @@ func NewBotHandler(
 		updateGameHandler:          updateGameHandler, // ADDED Assignment
 		getGamesHandler:            getGamesHandler,
 		getGameByIDHandler:         getGameByIDHandler,
+		startNightHandler:          startNightHandler,
+		startDayHandler:            startDayHandler,
+		startVotingHandler:         startVotingHandler,
+		startDefenseHandler:        startDefenseHandler,
+		endGameHandler:             endGameHandler,
+		eliminatePlayerHandler:     eliminatePlayerHandler,
+		revivePlayerHandler:        revivePlayerHandler,
+		getGameByRoomIDHandler:     getGameByRoomIDHandler,
+		openVoteHandler:            openVoteHandler,
+		castVoteHandler:            castVoteHandler,
+		closeVoteHandler:           closeVoteHandler,
+		submitNightActionHandler:   submitNightActionHandler,
+		resolveNightHandler:        resolveNightHandler,
+		startTimerHandler:          startTimerHandler,
+		stopTimerHandler:           stopTimerHandler,
+		tickTimerHandler:           tickTimerHandler,
+		startTurnsHandler:          startTurnsHandler,
+		nextSpeakerHandler:         nextSpeakerHandler,
+		requestChallengeHandler:    requestChallengeHandler,
+		grantChallengeHandler:      grantChallengeHandler,
+		moveSeatHandler:            moveSeatHandler,
+		shuffleSeatsHandler:        shuffleSeatsHandler,
+		setRoomCapacityHandler:     setRoomCapacityHandler,
+		startReadyCheckHandler:     startReadyCheckHandler,
+		markReadyHandler:           markReadyHandler,
+		kickUnreadyHandler:         kickUnreadyHandler,
+		tickReadyCheckHandler:      tickReadyCheckHandler,
+		syncReadyCheckHandler:      syncReadyCheckHandler,
+		watchRoomHandler:           watchRoomHandler,
+		setRoomVisibilityHandler:   setRoomVisibilityHandler,
+		resetInviteHandler:         resetInviteHandler,
+		resolveMediaHandler:        resolveMediaHandler,
+		rememberMediaHandler:       rememberMediaHandler,
+		addSideHandler:             addSideHandler,
+		renameSideHandler:          renameSideHandler,
+		removeSideHandler:          removeSideHandler,
+		setPopulationRateHandler:   setPopulationRateHandler,
+		setDefaultRoleHandler:      setDefaultRoleHandler,
+		addRoleHandler:             addRoleHandler,
+		removeRoleHandler:          removeRoleHandler,
+		setRoleAddedAtHandler:      setRoleAddedAtHandler,
+		exportScenarioHandler:      exportScenarioHandler,
+		exportAllScenariosHandler:  exportAllScenariosHandler,
 	}
 	return h
 }
@@ -211,6 +543,10 @@ func (h *BotHandler) Start() {
 	// go h.StartRefreshTimer() // Assuming this is handled elsewhere or removed
 	// Start the bot's main loop (blocking)
 	log.Println("Starting bot polling...")
+	h.RestoreInteractiveSelections()
+	h.RestoreOpenVotes()
+	h.RestoreTimers()
+	h.RestoreReadyChecks()
 	go h.StartRefreshTimer()
 	h.bot.Start()
 }
@@ -236,16 +572,22 @@ func (h *BotHandler) RegisterHandlers() {
 	h.bot.Handle("/create_scenario", h.handleCreateScenario)
 	h.bot.Handle("/delete_scenario", h.handleDeleteScenario)
 	h.bot.Handle("/add_scenario_json", h.handleAddScenarioJSON) // NEW: Register command
-	// TODO: Add /list_scenarios handler
+	h.bot.Handle("/roles", h.handleRoles)
+	h.bot.Handle("/edit_scenario", h.handleEditScenario)
+	h.bot.Handle("/export_scenario", h.handleExportScenario)
+	h.bot.Handle("/export_scenarios", h.handleExportScenarios)
+	h.bot.Handle("/list_scenarios", h.handleListScenarios)
 
 	// Game Handlers
 	h.bot.Handle("/create_game", h.handleCreateGame) // Renamed from /assign_scenario
 	h.bot.Handle("/assign_roles", h.handleAssignRoles)
 	h.bot.Handle("/games", h.handleGamesList)
+	h.bot.Handle("/panel", h.handlePhasePanel)
 
 	// Register handler for callback queries
 	h.bot.Handle(telebot.OnCallback, h.handleCallback)
 	h.bot.Handle(telebot.OnDocument, h.handleDocument)
+	h.bot.Handle(telebot.OnText, h.handleText)
 
 	log.Println("Registered command and callback handlers.")
 }
@@ -268,11 +610,11 @@ func (h *BotHandler) handleCreateRoom(c telebot.Context) error {
 
 func (h *BotHandler) handleJoinRoom(c telebot.Context) error {
 	roomIDStr := strings.TrimSpace(c.Message().Payload)
-	return room.HandleJoinRoom(h.joinRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, c, roomIDStr, h.msgs)
+	return room.HandleJoinRoom(h.joinRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, h, c, roomIDStr, h.msgs)
 }
 
 func (h *BotHandler) handleLeaveRoom(c telebot.Context) error {
-	return room.HandleLeaveRoom(h.leaveRoomHandler, h.roomListRefreshMessage, c, h.msgs)
+	return room.HandleLeaveRoom(h.leaveRoomHandler, h.roomListRefreshMessage, h, c, h.msgs)
 }
 
 func (h *BotHandler) handleListRooms(c telebot.Context) error {
@@ -284,7 +626,7 @@ func (h *BotHandler) handleMyRooms(c telebot.Context) error {
 }
 
 func (h *BotHandler) handleKickUser(c telebot.Context) error {
-	return room.HandleKickUser(h.kickUserHandler, h.roomListRefreshMessage, c, h.msgs)
+	return room.HandleKickUser(h.kickUserHandler, h.roomListRefreshMessage, h, c, h.msgs)
 }
 
 func (h *BotHandler) handleDeleteRoom(c telebot.Context) error {
@@ -301,9 +643,29 @@ func (h *BotHandler) handleDeleteScenario(c telebot.Context) error {
 	return scenario.HandleDeleteScenario(h.deleteScenarioHandler, c, h.msgs)
 }
 
+func (h *BotHandler) handleEditScenario(c telebot.Context) error {
+	return scenario.HandleEditScenario(h, h.getAllScenariosHandler, c, h.msgs)
+}
+
+func (h *BotHandler) handleRoles(c telebot.Context) error {
+	return scenario.HandleRoles(h.getScenarioByIDHandler, h.getAllScenariosHandler, c, h.msgs)
+}
+
+func (h *BotHandler) handleListScenarios(c telebot.Context) error {
+	return scenario.HandleListScenarios(h.getAllScenariosHandler, c, h.msgs)
+}
+
+func (h *BotHandler) handleExportScenario(c telebot.Context) error {
+	return scenario.HandleExportScenario(h.exportScenarioHandler, h.getAllScenariosHandler, c, h.msgs)
+}
+
+func (h *BotHandler) handleExportScenarios(c telebot.Context) error {
+	return scenario.HandleExportScenarios(h.exportAllScenariosHandler, c, h.msgs)
+}
+
 // NEW: Dispatcher method for Add Scenario JSON
 func (h *BotHandler) handleAddScenarioJSON(c telebot.Context) error {
-	return scenario.HandleAddScenarioJSON(h.addScenarioJSONHandler, c, h.msgs)
+	return scenario.HandleAddScenarioJSON(h.addScenarioJSONHandler, h, c, h.msgs)
 }
 
 // --- Game ---
@@ -312,13 +674,17 @@ func (h *BotHandler) handleCreateGame(c telebot.Context) error { // Renamed from
 }
 
 func (h *BotHandler) handleAssignRoles(c telebot.Context) error {
-	return game.HandleAssignRoles(h.assignRolesHandler, h.bot, c, h.msgs)
+	return game.HandleAssignRoles(h.assignRolesHandler, h.bot, h, c, h.msgs)
 }
 
 func (h *BotHandler) handleGamesList(c telebot.Context) error {
 	return game.HandleGamesList(h.getGamesHandler, c, h.msgs)
 }
 
+func (h *BotHandler) handlePhasePanel(c telebot.Context) error {
+	return game.HandlePhasePanelCommand(h, h.getGamesHandler, c, h.msgs)
+}
+
 // --- Callbacks ---
 // Removed handleCallback dispatcher method - implementation is in callbacks.go
 // func (h *BotHandler) handleCallback(c telebot.Context) error {
@@ -333,7 +699,23 @@ func (h *BotHandler) HandleStart(c telebot.Context) error {
 }
 
 func (h *BotHandler) handleDocument(c telebot.Context) error {
-	return HandleDocument(h.addScenarioJSONHandler, c, h.msgs)
+	return HandleDocument(h.addScenarioJSONHandler, h, c, h.msgs)
+}
+
+// handleText answers a room prompt (such as a password) or a scenario editor prompt in private chat;
+// other text is ignored
+func (h *BotHandler) handleText(c telebot.Context) error {
+	if c.Chat() == nil || c.Chat().Type != telebot.ChatPrivate || c.Sender() == nil {
+		return nil
+	}
+	input, exists := h.TakePendingRoomInput(c.Sender().ID)
+	if !exists {
+		if scenarioInput, ok := h.TakePendingScenarioInput(c.Sender().ID); ok {
+			return scenario.HandleScenarioInputReply(h, c, scenarioInput, h.msgs)
+		}
+		return nil
+	}
+	return room.HandleRoomInputReply(h.joinRoomHandler, h.watchRoomHandler, h.setRoomVisibilityHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, h, c, input, h.msgs)
 }
 
 // Helper methods to manage interactive state safely (NEW)
@@ -354,6 +736,9 @@ func (h *BotHandler) DeleteInteractiveSelectionState(gameID gameEntity.GameID) {
 	h.interactiveSelectionsMutex.Lock()
 	defer h.interactiveSelectionsMutex.Unlock()
 	delete(h.interactiveSelections, gameID)
+	if err := h.deleteRoleSelectionHandler.Handle(context.Background(), gameCommand.DeleteRoleSelectionCommand{GameID: gameID}); err != nil {
+		log.Printf("Failed to delete saved role selection for game %s: %v", gameID, err)
+	}
 }
 
 // GetPlayerRoleRefresher Helper methods to manage player role choice refreshers safely (NEW)
@@ -447,3 +832,306 @@ func (h *BotHandler) DeleteAdminAssignmentTracker(gameID gameEntity.GameID) {
 	delete(h.adminAssignmentTrackers, gameID)
 	log.Printf("Deleted Admin Assignment Tracker book for game %s", gameID)
 }
+
+// GetOrCreatePhasePanel returns the refresh book of the moderator panels of a game
+func (h *BotHandler) GetOrCreatePhasePanel(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook {
+	h.phasePanelsMutex.Lock()
+	defer h.phasePanelsMutex.Unlock()
+	book, exists := h.phasePanels[gameID]
+	if !exists {
+		book = tgutil.NewRefreshState(func(user int64, data string) (string, []interface{}, error) {
+			gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(data)})
+			if err != nil {
+				return "", nil, err
+			}
+			var players []*entity.User
+			if gameData.Room != nil {
+				players, err = h.getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: gameData.Room.ID})
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return game.PreparePhasePanelMessage(gameData, players, h.msgs)
+		})
+		h.phasePanels[gameID] = book
+		log.Printf("Created new Phase Panel book for game %s", gameID)
+	}
+	return book
+}
+
+// GetOrCreateVoteBook returns the refresh book of the ballots and tallies of a game's vote
+func (h *BotHandler) GetOrCreateVoteBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook {
+	h.voteBooksMutex.Lock()
+	defer h.voteBooksMutex.Unlock()
+	book, exists := h.voteBooks[gameID]
+	if !exists {
+		book = tgutil.NewRefreshState(func(user int64, data string) (string, []interface{}, error) {
+			gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(data)})
+			if err != nil {
+				return "", nil, err
+			}
+			var players []*entity.User
+			if gameData.Room != nil {
+				players, err = h.getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: gameData.Room.ID})
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return game.PrepareVoteMessage(gameData, players, user, time.Now(), h.msgs)
+		})
+		h.voteBooks[gameID] = book
+		log.Printf("Created new Vote book for game %s", gameID)
+	}
+	return book
+}
+
+// DeleteVoteBook forgets the ballots of a game once its vote is over
+func (h *BotHandler) DeleteVoteBook(gameID gameEntity.GameID) {
+	h.voteBooksMutex.Lock()
+	defer h.voteBooksMutex.Unlock()
+	delete(h.voteBooks, gameID)
+	log.Printf("Deleted Vote book for game %s", gameID)
+}
+
+// RestoreOpenVotes tracks the votes left open by a previous run, so they still close when their time is up.
+// Their ballot messages are picked up again as soon as a player votes.
+func (h *BotHandler) RestoreOpenVotes() {
+	games, err := h.getGamesHandler.Handle(context.Background(), gameQuery.GetGamesQuery{})
+	if err != nil {
+		log.Printf("Failed to load games to restore open votes: %v", err)
+		return
+	}
+	for _, g := range games {
+		if g.Vote.IsOpen() {
+			h.GetOrCreateVoteBook(g.ID)
+			log.Printf("Restored open vote of game %s", g.ID)
+		}
+	}
+}
+
+// GetOrCreateTimerBook returns the refresh book of the countdown messages of a game's phase timer
+func (h *BotHandler) GetOrCreateTimerBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook {
+	h.timerBooksMutex.Lock()
+	defer h.timerBooksMutex.Unlock()
+	book, exists := h.timerBooks[gameID]
+	if !exists {
+		book = tgutil.NewRefreshState(func(user int64, data string) (string, []interface{}, error) {
+			gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(data)})
+			if err != nil {
+				return "", nil, err
+			}
+			return game.PrepareTimerMessage(gameData, time.Now(), h.msgs)
+		})
+		h.timerBooks[gameID] = book
+		log.Printf("Created new Timer book for game %s", gameID)
+	}
+	return book
+}
+
+// DeleteTimerBook forgets the countdown messages of a game once its timer is over
+func (h *BotHandler) DeleteTimerBook(gameID gameEntity.GameID) {
+	h.timerBooksMutex.Lock()
+	defer h.timerBooksMutex.Unlock()
+	delete(h.timerBooks, gameID)
+	log.Printf("Deleted Timer book for game %s", gameID)
+}
+
+// RestoreTimers tracks the timers left running by a previous run. Their deadlines are stored,
+// so warnings and auto-advance carry on; the old countdown messages are not redrawn.
+func (h *BotHandler) RestoreTimers() {
+	games, err := h.getGamesHandler.Handle(context.Background(), gameQuery.GetGamesQuery{})
+	if err != nil {
+		log.Printf("Failed to load games to restore timers: %v", err)
+		return
+	}
+	for _, g := range games {
+		if g.Timer.IsRunning() {
+			h.GetOrCreateTimerBook(g.ID)
+			log.Printf("Restored timer of game %s", g.ID)
+		}
+	}
+}
+
+// GetOrCreateReadyCheckBook returns the refresh book of the ready buttons and moderator rosters of a game's ready-check
+func (h *BotHandler) GetOrCreateReadyCheckBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook {
+	h.readyBooksMutex.Lock()
+	defer h.readyBooksMutex.Unlock()
+	book, exists := h.readyBooks[gameID]
+	if !exists {
+		book = tgutil.NewRefreshState(func(user int64, data string) (string, []interface{}, error) {
+			gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(data)})
+			if err != nil {
+				return "", nil, err
+			}
+			var players []*entity.User
+			if gameData.Room != nil {
+				players, err = h.getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: gameData.Room.ID})
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return game.PrepareReadyCheckMessage(gameData, players, entity.UserID(user), time.Now(), h.msgs)
+		})
+		h.readyBooks[gameID] = book
+		log.Printf("Created new Ready-check book for game %s", gameID)
+	}
+	return book
+}
+
+// DeleteReadyCheckBook forgets the ready-check messages of a game
+func (h *BotHandler) DeleteReadyCheckBook(gameID gameEntity.GameID) {
+	h.readyBooksMutex.Lock()
+	defer h.readyBooksMutex.Unlock()
+	delete(h.readyBooks, gameID)
+	log.Printf("Deleted Ready-check book for game %s", gameID)
+}
+
+// RestoreReadyChecks tracks the timed ready-checks left running by a previous run, so non-responders
+// are still dropped at the deadline. Their messages are picked up again when the check is restarted.
+func (h *BotHandler) RestoreReadyChecks() {
+	games, err := h.getGamesHandler.Handle(context.Background(), gameQuery.GetGamesQuery{})
+	if err != nil {
+		log.Printf("Failed to load games to restore ready-checks: %v", err)
+		return
+	}
+	for _, g := range games {
+		if g.State == gameEntity.GameStateWaitingForPlayers && g.ReadyCheck.IsTimed() {
+			h.GetOrCreateReadyCheckBook(g.ID)
+			log.Printf("Restored ready-check of game %s", g.ID)
+		}
+	}
+}
+
+// GetOrCreateTurnsBook returns the refresh book of the talk order messages sent to the players of a game
+func (h *BotHandler) GetOrCreateTurnsBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook {
+	h.turnsBooksMutex.Lock()
+	defer h.turnsBooksMutex.Unlock()
+	book, exists := h.turnsBooks[gameID]
+	if !exists {
+		book = tgutil.NewRefreshState(func(user int64, data string) (string, []interface{}, error) {
+			gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(data)})
+			if err != nil {
+				return "", nil, err
+			}
+			var players []*entity.User
+			if gameData.Room != nil {
+				players, err = h.getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: gameData.Room.ID})
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return game.PrepareTurnsMessage(gameData, players, entity.UserID(user), h.msgs)
+		})
+		h.turnsBooks[gameID] = book
+		log.Printf("Created new Turns book for game %s", gameID)
+	}
+	return book
+}
+
+// GetOrCreateNightSummary returns the refresh book of the night summaries sent to the moderators of a game
+func (h *BotHandler) GetOrCreateNightSummary(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook {
+	h.nightSummariesMutex.Lock()
+	defer h.nightSummariesMutex.Unlock()
+	book, exists := h.nightSummaries[gameID]
+	if !exists {
+		book = tgutil.NewRefreshState(func(user int64, data string) (string, []interface{}, error) {
+			gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(data)})
+			if err != nil {
+				return "", nil, err
+			}
+			var players []*entity.User
+			if gameData.Room != nil {
+				players, err = h.getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: gameData.Room.ID})
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return game.PrepareNightSummaryMessage(gameData, players, h.msgs)
+		})
+		h.nightSummaries[gameID] = book
+		log.Printf("Created new Night Summary book for game %s", gameID)
+	}
+	return book
+}
+
+// NightActionDraft returns the targets a player picked so far for an ability
+func (h *BotHandler) NightActionDraft(key string) []entity.UserID {
+	h.nightDraftsMutex.Lock()
+	defer h.nightDraftsMutex.Unlock()
+	return h.nightActionDrafts[key]
+}
+
+// SetNightActionDraft stores the picked targets of an ability; an empty draft is forgotten
+func (h *BotHandler) SetNightActionDraft(key string, targets []entity.UserID) {
+	h.nightDraftsMutex.Lock()
+	defer h.nightDraftsMutex.Unlock()
+	if len(targets) == 0 {
+		delete(h.nightActionDrafts, key)
+		return
+	}
+	h.nightActionDrafts[key] = targets
+}
+
+// AddNightPrompt remembers a night action prompt sent to a player
+func (h *BotHandler) AddNightPrompt(gameID gameEntity.GameID, msg *tgutil.RefreshingMessage) {
+	h.nightPromptsMutex.Lock()
+	defer h.nightPromptsMutex.Unlock()
+	h.nightPrompts[gameID] = append(h.nightPrompts[gameID], msg)
+}
+
+// TakeNightPrompts returns and forgets the night action prompts sent for a game
+func (h *BotHandler) TakeNightPrompts(gameID gameEntity.GameID) []*tgutil.RefreshingMessage {
+	h.nightPromptsMutex.Lock()
+	defer h.nightPromptsMutex.Unlock()
+	prompts := h.nightPrompts[gameID]
+	delete(h.nightPrompts, gameID)
+	return prompts
+}
+
+// SetPendingScenarioUpload parks a colliding scenario upload until the admin decides
+func (h *BotHandler) SetPendingScenarioUpload(userID int64, jsonData string) {
+	h.pendingUploadsMutex.Lock()
+	defer h.pendingUploadsMutex.Unlock()
+	h.pendingScenarioUploads[userID] = jsonData
+}
+
+// TakePendingScenarioUpload returns and forgets the parked upload of a user
+func (h *BotHandler) TakePendingScenarioUpload(userID int64) (string, bool) {
+	h.pendingUploadsMutex.Lock()
+	defer h.pendingUploadsMutex.Unlock()
+	jsonData, exists := h.pendingScenarioUploads[userID]
+	delete(h.pendingScenarioUploads, userID)
+	return jsonData, exists
+}
+
+// SetPendingRoomInput waits for a user's next private text message about a room
+func (h *BotHandler) SetPendingRoomInput(userID int64, input room.PendingRoomInput) {
+	h.pendingRoomInputsMutex.Lock()
+	defer h.pendingRoomInputsMutex.Unlock()
+	h.pendingRoomInputs[userID] = input
+}
+
+// TakePendingRoomInput returns and forgets what the bot is waiting for from a user
+func (h *BotHandler) TakePendingRoomInput(userID int64) (room.PendingRoomInput, bool) {
+	h.pendingRoomInputsMutex.Lock()
+	defer h.pendingRoomInputsMutex.Unlock()
+	input, exists := h.pendingRoomInputs[userID]
+	delete(h.pendingRoomInputs, userID)
+	return input, exists
+}
+
+// SetPendingScenarioInput waits for an admin's next private text message for the scenario editor
+func (h *BotHandler) SetPendingScenarioInput(userID int64, input scenario.PendingScenarioInput) {
+	h.pendingScenarioInputsMutex.Lock()
+	defer h.pendingScenarioInputsMutex.Unlock()
+	h.pendingScenarioInputs[userID] = input
+}
+
+// TakePendingScenarioInput returns and forgets what the scenario editor is waiting for from an admin
+func (h *BotHandler) TakePendingScenarioInput(userID int64) (scenario.PendingScenarioInput, bool) {
+	h.pendingScenarioInputsMutex.Lock()
+	defer h.pendingScenarioInputsMutex.Unlock()
+	input, exists := h.pendingScenarioInputs[userID]
+	delete(h.pendingScenarioInputs, userID)
+	return input, exists
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	game "telemafia/internal/presentation/telegram/handler/game"
 	room "telemafia/internal/presentation/telegram/handler/room"
+	scenario "telemafia/internal/presentation/telegram/handler/scenario"
 
 	// Import messages
 	tgutil "telemafia/internal/shared/tgutil"
@@ -31,7 +32,7 @@ func (h *BotHandler) handleCallback(c telebot.Context) error {
 	switch unique {
 	// Game Creation Callbacks
 	case tgutil.UniqueCreateGameSelectRoom:
-		return game.HandleSelectRoomForCreateGame(h.getAllScenariosHandler, c, data, h.msgs)
+		return game.HandleSelectRoomForCreateGame(h.getAllScenariosHandler, h.getPlayersInRoomHandler, c, data, h.msgs)
 	case tgutil.UniqueCreateGameSelectScenario:
 		roomID, scenarioID := tgutil.SplitCallbackData(data)
 		if roomID == "" || scenarioID == "" {
@@ -40,33 +41,161 @@ func (h *BotHandler) handleCallback(c telebot.Context) error {
 		}
 		return game.HandleSelectScenarioForCreateGame(h.createGameHandler, h.getPlayersInRoomHandler, h.getScenarioByIDHandler, c, roomID, scenarioID, h.msgs)
 	case tgutil.UniqueStartGame:
-		return game.HandleStartCreatedGame(h.assignRolesHandler, h.bot, c, data, h.msgs)
+		return game.HandleStartCreatedGame(h.assignRolesHandler, h.bot, h, c, data, h.msgs)
 	case tgutil.UniqueChooseCardStart:
 		return game.HandleChooseCardStart(h, c, data, h.msgs)
 	case tgutil.UniquePlayerSelectsCard:
 		return game.HandlePlayerSelectsCard(h, c, data, h.msgs)
 	case tgutil.UniqueCancelGame:
 		return game.HandleCancelCreateGame(h, c, h.msgs, data)
+	case tgutil.UniqueReadyCheckSelect:
+		return game.HandleReadyCheckSelect(c, data, h.msgs)
+	case tgutil.UniqueStartReadyCheck:
+		return game.HandleStartReadyCheck(h, c, data, h.msgs)
+	case tgutil.UniqueMarkReady:
+		return game.HandleMarkReady(h, c, data, h.msgs)
+	case tgutil.UniqueKickUnready:
+		return game.HandleKickUnready(h, c, data, h.msgs)
+
+	// Game Phase Panel Callbacks
+	case tgutil.UniquePhasePanelOpen:
+		return game.HandleOpenPhasePanel(h, c, data, h.msgs)
+	case tgutil.UniquePhasePanelBack:
+		return game.HandlePhasePanelBack(h, c, data, h.msgs)
+	case tgutil.UniquePhaseStartNight, tgutil.UniquePhaseStartDay, tgutil.UniquePhaseStartVoting, tgutil.UniquePhaseStartDefense, tgutil.UniqueEndGameConfirm:
+		return game.HandlePhaseTransition(h, c, unique, data, h.msgs)
+	case tgutil.UniqueEndGame:
+		return game.HandleEndGameRequest(h, c, data, h.msgs)
+	case tgutil.UniqueEndGameWinner:
+		return game.HandleEndGameWinner(h, c, data, h.msgs)
+
+	// Player Life Status Callbacks
+	case tgutil.UniqueEliminateSelect:
+		return game.HandleEliminateSelect(h, c, data, h.msgs)
+	case tgutil.UniqueEliminateCause:
+		return game.HandleEliminateCause(h, c, data, h.msgs)
+	case tgutil.UniqueEliminateConfirm:
+		return game.HandleEliminateConfirm(h, c, data, h.msgs)
+	case tgutil.UniqueReviveSelect:
+		return game.HandleReviveSelect(h, c, data, h.msgs)
+	case tgutil.UniqueReviveConfirm:
+		return game.HandleReviveConfirm(h, c, data, h.msgs)
+
+	// Day Vote Callbacks
+	case tgutil.UniqueOpenVoteSelect:
+		return game.HandleOpenVoteSelect(c, data, h.msgs)
+	case tgutil.UniqueOpenVote:
+		return game.HandleOpenVote(h, c, data, h.msgs)
+	case tgutil.UniqueCastVote:
+		return game.HandleCastVote(h, c, data, h.msgs)
+	case tgutil.UniqueCloseVote:
+		return game.HandleCloseVote(h, c, data, h.msgs)
+
+	// Night Action Callbacks
+	case tgutil.UniqueNightActionPick:
+		return game.HandleNightActionPick(h, c, data, h.msgs)
+	case tgutil.UniqueNightSummary:
+		return game.HandleShowNightSummary(h, c, data, h.msgs)
+	case tgutil.UniqueTimerSelect:
+		return game.HandleTimerSelect(c, data, h.msgs)
+	case tgutil.UniqueStartTimer:
+		return game.HandleStartTimer(h, c, data, h.msgs)
+	case tgutil.UniqueStopTimer:
+		return game.HandleStopTimer(h, c, data, h.msgs)
+	case tgutil.UniqueStartTurns:
+		return game.HandleStartTurns(h, c, data, h.msgs)
+	case tgutil.UniqueNextSpeaker:
+		return game.HandleNextSpeaker(h, c, data, h.msgs)
+	case tgutil.UniqueRequestChallenge:
+		return game.HandleRequestChallenge(h, c, data, h.msgs)
+	case tgutil.UniqueGrantChallenge:
+		return game.HandleGrantChallenge(h, c, data, h.msgs)
+	case tgutil.UniqueResolveNight:
+		return game.HandleResolveNight(h, c, data, h.msgs)
+	case tgutil.UniqueMyRole:
+		return game.HandleMyRole(h, c, data, h.msgs)
+
+	// Scenario Upload Conflict Callbacks
+	case tgutil.UniqueScenarioUploadReplace, tgutil.UniqueScenarioUploadCreateNew, tgutil.UniqueScenarioUploadCancel:
+		return scenario.HandleScenarioUploadDecision(h.addScenarioJSONHandler, h, c, unique, h.msgs)
+
+	// Scenario List Callbacks
+	case tgutil.UniqueScenarioList:
+		return scenario.HandleScenarioListCallback(h.getAllScenariosHandler, c, h.msgs)
+	case tgutil.UniqueScenarioDetail:
+		return scenario.HandleScenarioDetailCallback(h.getScenarioByIDHandler, c, data, h.msgs)
+	case tgutil.UniqueScenarioPreview:
+		return scenario.HandleScenarioPreviewCallback(h.getScenarioByIDHandler, c, data, h.msgs)
+	case tgutil.UniqueScenarioExport:
+		return scenario.HandleScenarioExportCallback(h.exportScenarioHandler, c, data, h.msgs)
+
+	// Scenario Editor Callbacks
+	case tgutil.UniqueScenarioEditOpen:
+		return scenario.HandleEditScenarioOpenCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioEditSide:
+		return scenario.HandleEditScenarioSideCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioEditAsk:
+		return scenario.HandleEditScenarioAskCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioRemoveSide:
+		return scenario.HandleEditScenarioRemoveSideCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioRemoveRole:
+		return scenario.HandleEditScenarioRemoveRoleCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioAddedAtSelect:
+		return scenario.HandleEditScenarioAddedAtSelectCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioSetAddedAt:
+		return scenario.HandleEditScenarioSetAddedAtCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioRateSelect:
+		return scenario.HandleEditScenarioRateSelectCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioSetRate:
+		return scenario.HandleEditScenarioSetRateCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioDefaultSelect:
+		return scenario.HandleEditScenarioDefaultSelectCallback(h, c, data, h.msgs)
+	case tgutil.UniqueScenarioSetDefaultRole:
+		return scenario.HandleEditScenarioSetDefaultRoleCallback(h, c, data, h.msgs)
 
 	// Existing Room Callbacks (assuming tgutil still defines these constants)
 	case tgutil.UniqueJoinRoom:
-		return room.HandleJoinRoomCallback(h.joinRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, c, data, h.msgs)
+		return room.HandleJoinRoomCallback(h.joinRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, h, c, data, h.msgs)
+	case tgutil.UniqueWatchRoom:
+		return room.HandleWatchRoomCallback(h.watchRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, c, data, h.msgs)
 	case tgutil.UniqueDeleteRoomSelectRoom:
 		return room.HandleDeleteRoomSelectCallback(h.getRoomHandler, c, data, h.msgs)
 	case tgutil.UniqueDeleteRoomConfirm:
 		return room.HandleDeleteRoomConfirmCallback(h.deleteRoomHandler, h.roomListRefreshMessage, c, data, h.msgs)
 	case tgutil.UniqueLeaveRoomSelectRoom:
-		return room.HandleLeaveRoomSelectCallback(h.leaveRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, c, data, h.msgs)
+		return room.HandleLeaveRoomSelectCallback(h.leaveRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, c, data, h.msgs)
 	case tgutil.UniqueLeaveRoomConfirm:
-		return room.HandleLeaveRoomConfirmCallback(h.leaveRoomHandler, h.roomListRefreshMessage, c, data, h.msgs)
+		return room.HandleLeaveRoomConfirmCallback(h.leaveRoomHandler, h.roomListRefreshMessage, h, c, data, h.msgs)
 	case tgutil.UniqueGetInviteLink:
-		return room.HandleGetInviteLinkCallback(h.bot, c, data, h.msgs)
+		return room.HandleGetInviteLinkCallback(h.bot, h.getRoomHandler, c, data, h.msgs)
+	case tgutil.UniqueResetInvite:
+		return room.HandleResetInviteCallback(h.resetInviteHandler, h.bot, c, data, h.msgs)
 
 	// Kick User Flow Callbacks
 	case tgutil.UniqueKickUserSelect:
-		return room.HandleKickUserSelectCallback(h.getPlayersInRoomHandler, c, data, h.msgs)
+		return room.HandleKickUserSelectCallback(h.getRoomHandler, c, data, h.msgs)
 	case tgutil.UniqueKickUserConfirm:
-		return room.HandleKickUserConfirmCallback(h.kickUserHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, c, data, h.msgs)
+		return room.HandleKickUserConfirmCallback(h.kickUserHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, c, data, h.msgs)
+
+	// Seat Order Callbacks
+	case tgutil.UniqueSeatsSelect:
+		return room.HandleSeatsSelectCallback(h.getRoomHandler, c, data, h.msgs)
+	case tgutil.UniqueMoveSeat:
+		return room.HandleMoveSeatCallback(h.moveSeatHandler, h.roomDetailRefreshMessage, c, data, h.msgs)
+	case tgutil.UniqueShuffleSeats:
+		return room.HandleShuffleSeatsCallback(h.shuffleSeatsHandler, h.roomDetailRefreshMessage, c, data, h.msgs)
+	case tgutil.UniqueSeatsBack:
+		return room.HandleSeatsBackCallback(h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, c, data, h.msgs)
+
+	// Room Capacity Callbacks
+	case tgutil.UniqueCapacitySelect:
+		return room.HandleCapacitySelectCallback(h.getRoomHandler, c, data, h.msgs)
+	case tgutil.UniqueSetCapacity:
+		return room.HandleSetCapacityCallback(h.setRoomCapacityHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, c, data, h.msgs)
+	case tgutil.UniqueVisibilitySelect:
+		return room.HandleVisibilitySelectCallback(h.getRoomHandler, c, data, h.msgs)
+	case tgutil.UniqueSetVisibility:
+		return room.HandleSetVisibilityCallback(h.setRoomVisibilityHandler, h.getRoomHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, c, data, h.msgs)
 
 	// Change Moderator Flow Callbacks
 	case tgutil.UniqueChangeModeratorSelect:
@@ -77,7 +206,7 @@ func (h *BotHandler) handleCallback(c telebot.Context) error {
 		// log.Printf("ChangeModeratorConfirm callback received for: %s - Handler not fully wired yet.", data)
 		// _ = c.Respond(&telebot.CallbackResponse{Text: "Handler not implemented yet."})
 		// return nil // Placeholder
-		return room.HandleChangeModeratorConfirmCallback(h.changeModeratorHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, c, data, h.msgs)
+		return room.HandleChangeModeratorConfirmCallback(h.changeModeratorHandler, h.getRoomsHandler, h.getPlayersInRoomHandler, h.getGameByRoomIDHandler, h.roomListRefreshMessage, h.roomDetailRefreshMessage, h, c, data, h.msgs)
 
 	// Existing Game Callbacks
 	case tgutil.UniqueConfirmAssignments:
@@ -27,16 +27,33 @@ func HandleStart(h *BotHandler, c telebot.Context, msgs *messages.Messages) erro
 			unique := parts[0]
 			data := parts[1]
 
-			// Check if it's a join room request
-			if unique == "join_room" {
-				roomID := data
+			// Invite links either take a seat or watch the room
+			if unique == room.DeepLinkWatchRoom {
+				return room.HandleWatchRoom(
+					h.watchRoomHandler,
+					h.getRoomsHandler,
+					h.getPlayersInRoomHandler,
+					h.getGameByRoomIDHandler,
+					h.roomListRefreshMessage,
+					h.roomDetailRefreshMessage,
+					h,
+					c,
+					data, // roomID or roomID-inviteCode
+					h.msgs,
+				)
+			}
+			if unique == room.DeepLinkJoinRoom {
+				roomID := data // roomID or roomID-inviteCode
 				// Reuse the existing Join Room callback logic
 				return room.HandleJoinRoom(
 					h.joinRoomHandler,
 					h.getRoomsHandler,
 					h.getPlayersInRoomHandler,
+					h.getGameByRoomIDHandler,
 					h.roomListRefreshMessage,
 					h.roomDetailRefreshMessage,
+					h,
+					h,
 					c, // Pass the original message context
 					roomID,
 					h.msgs,
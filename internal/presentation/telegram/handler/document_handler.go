@@ -1,12 +1,12 @@
 package telegram
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"strings"
 
 	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	scenario "telemafia/internal/presentation/telegram/handler/scenario"
 	messages "telemafia/internal/presentation/telegram/messages"
 	tgutil "telemafia/internal/shared/tgutil"
 
@@ -16,10 +16,12 @@ import (
 // HandleDocument handles json files to add scenario.
 func HandleDocument(
 	addScenarioJSONHandler *scenarioCommand.AddScenarioJSONHandler,
+	store scenario.PendingUploadStore,
 	c telebot.Context,
 	msgs *messages.Messages,
 ) error {
-	if c.Message().Document != nil && c.Message().Document.MIME != "application/json" {
+	// Exported scenarios come back as *.json files, whatever MIME type the client reports for them
+	if doc := c.Message().Document; doc != nil && doc.MIME != "application/json" && !strings.HasSuffix(strings.ToLower(doc.FileName), ".json") {
 		return c.Send(msgs.Scenario.AddScenarioJSONPrompt)
 	}
 
@@ -46,22 +48,5 @@ func HandleDocument(
 		return c.Send(msgs.Scenario.AddScenarioJSONPrompt) // Need new message key
 	}
 
-	cmd := scenarioCommand.AddScenarioJSONCommand{
-		Requester: *requester,
-		JSONData:  jsonData,
-	}
-
-	createdScenario, err := addScenarioJSONHandler.Handle(context.Background(), cmd)
-	if err != nil {
-		// Provide specific feedback for JSON errors vs other errors
-		if strings.Contains(err.Error(), "invalid JSON format") {
-			return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONInvalidJSON, err))
-		} else if strings.Contains(err.Error(), "cannot be empty") {
-			return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONValidationError, err))
-		} else {
-			return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONErrorGeneric, err))
-		}
-	}
-
-	return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONSuccess, createdScenario.Name, createdScenario.ID))
+	return scenario.SubmitScenarioJSON(addScenarioJSONHandler, store, c, requester, jsonData, msgs)
 }
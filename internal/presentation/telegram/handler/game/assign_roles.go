@@ -20,6 +20,7 @@ import (
 func HandleAssignRoles(
 	assignRolesHandler *gameCommand.AssignRolesHandler,
 	bot *telebot.Bot,
+	media RoleMedia,
 	c telebot.Context,
 	msgs *messages.Messages,
 ) error {
@@ -46,33 +47,47 @@ func HandleAssignRoles(
 
 	for user, role := range assignments {
 		targetUser := &telebot.User{ID: int64(user.ID)}
-		privateMsg, opts := PrepareAssignRoleMessage(msgs, role)
-		_, err := bot.Send(targetUser, privateMsg, opts...)
+		privateMsg, opts, uploaded := PrepareAssignRoleMessage(media, msgs, gameID, role)
+		sent, err := bot.Send(targetUser, privateMsg, opts...)
 		if err != nil {
 			log.Printf(msgs.Game.AssignRolesErrorSendingPrivate, user.ID, err)
 		}
+		uploaded(sent)
 	}
 
 	return c.Send(fmt.Sprintf(msgs.Game.AssignRolesSuccessPublic, gameID))
 }
 
-func PrepareAssignRoleMessage(msgs *messages.Messages, role entity.Role) (interface{}, []interface{}) {
+// RoleMedia finds the image of a role card
+type RoleMedia interface {
+	// RoleImage returns the file to send for the image of a role and a callback that caches a fresh upload
+	// from the sent message; ok is false when the role has no image
+	RoleImage(role entity.Role) (file telebot.File, uploaded func(*telebot.Message), ok bool)
+}
+
+// PrepareAssignRoleMessage builds the private message that tells a player their role: the role card image
+// with the role as caption, or just the text, and a button that explains the role again. The returned
+// callback must get the sent message so an uploaded image is cached.
+func PrepareAssignRoleMessage(media RoleMedia, msgs *messages.Messages, gameID gameEntity.GameID, role entity.Role) (interface{}, []interface{}, func(*telebot.Message)) {
 	confirmMsgText := fmt.Sprintf(msgs.Game.AssignRolesSuccessPrivate, role.Name, role.Side)
 	if role.Description != "" {
 		confirmMsgText = fmt.Sprintf("%s\nتوضیحات: ||%s||", confirmMsgText, common.EscapeMarkdownV2(role.Description))
 	}
 	var what interface{}
-	if role.ImageID != "" {
+	uploaded := func(*telebot.Message) {}
+	if file, cache, ok := media.RoleImage(role); ok {
 		what = &telebot.Photo{
-			File:       telebot.File{FileID: role.ImageID},
+			File:       file,
 			HasSpoiler: true,
 			Caption:    confirmMsgText,
 		}
+		uploaded = cache
 	} else {
 		what = confirmMsgText
 	}
 	opts := []interface{}{
+		MyRoleMarkup(gameID, msgs),
 		telebot.ModeMarkdownV2,
 	}
-	return what, opts
+	return what, opts, uploaded
 }
@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	gameQuery "telemafia/internal/domain/game/usecase/query"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
 	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
 	messages "telemafia/internal/presentation/telegram/messages"
 	sharedEntity "telemafia/internal/shared/entity"
@@ -23,9 +25,11 @@ import (
 
 // ---- Game Creation Callbacks ----
 
-// HandleSelectRoomForCreateGame processes the room selection during game creation.
+// HandleSelectRoomForCreateGame processes the room selection during game creation. Scenarios that fit the
+// room's current player count are listed first; the others follow greyed out with the counts they support.
 func HandleSelectRoomForCreateGame(
 	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
 	c telebot.Context,
 	roomID string,
 	msgs *messages.Messages,
@@ -41,27 +45,62 @@ func HandleSelectRoomForCreateGame(
 		return c.Respond(&telebot.CallbackResponse{Text: "No scenarios available.", ShowAlert: true})
 	}
 
+	players, err := getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: roomEntity.RoomID(roomID)})
+	if err != nil {
+		errMsg := fmt.Sprintf(msgs.Game.CreateGameErrorFetchPlayers, err)
+		log.Printf("Callback creategame_room: Error fetching players for room %s: %v", roomID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: errMsg, ShowAlert: true})
+	}
+
 	// Build scenario keyboard
 	markup := &telebot.ReplyMarkup{}
-	var rows []telebot.Row
+	var rows, unavailable []telebot.Row
 	for _, scenario := range scenarios {
-
-		log.Printf("Scenario name: %s", scenario.Name)
-		btn := markup.Data(
-			scenario.Name,
-			tgutil.UniqueCreateGameSelectScenario,
-			fmt.Sprintf("%s|%s", roomID, scenario.ID),
-		)
-		rows = append(rows, markup.Row(btn))
+		payload := fmt.Sprintf("%s|%s", roomID, scenario.ID)
+		if scenario.CheckPlayerCount(len(players)) != nil {
+			label := fmt.Sprintf(msgs.Game.CreateGameScenarioUnavailableButton, scenario.Name, PlayerBoundsLabel(scenario, msgs))
+			unavailable = append(unavailable, markup.Row(markup.Data(label, tgutil.UniqueCreateGameSelectScenario, payload)))
+			continue
+		}
+		rows = append(rows, markup.Row(markup.Data(scenario.Name, tgutil.UniqueCreateGameSelectScenario, payload)))
 	}
+	rows = append(rows, unavailable...)
 	rows = append(rows, markup.Row(markup.Data(msgs.Game.CreateGameCancelButton, tgutil.UniqueCancelGame)))
 	markup.Inline(rows...)
 
-	promptMsg := fmt.Sprintf(msgs.Game.CreateGameSelectScenarioPrompt)
-	return c.Edit(promptMsg, markup)
+	return c.Edit(fmt.Sprintf(msgs.Game.CreateGameSelectScenarioPrompt, len(players)), markup)
+}
+
+// PlayerBoundsLabel renders the player counts a scenario supports, e.g. "6 تا 12 نفر"
+func PlayerBoundsLabel(scenario *scenarioEntity.Scenario, msgs *messages.Messages) string {
+	min, max := scenario.PlayerBounds()
+	if min == 0 {
+		return msgs.Game.CreateGamePlayersUnknown
+	}
+	if max == 0 {
+		return fmt.Sprintf(msgs.Game.CreateGamePlayersOpen, min)
+	}
+	return fmt.Sprintf(msgs.Game.CreateGamePlayersRange, min, max)
 }
 
-// HandleSelectScenarioForCreateGame processes the scenario selection, creates the game, and shows confirmation.
+// ScenarioUnavailableReason explains why a room of n players cannot play the scenario, or returns "" if it can
+func ScenarioUnavailableReason(scenario *scenarioEntity.Scenario, n int, msgs *messages.Messages) string {
+	err := scenario.CheckPlayerCount(n)
+	if err == nil {
+		return ""
+	}
+	min, max := scenario.PlayerBounds()
+	switch {
+	case errors.Is(err, scenarioEntity.ErrTooFewPlayers):
+		return fmt.Sprintf(msgs.Game.CreateGameTooFewPlayers, scenario.Name, min, n)
+	case errors.Is(err, scenarioEntity.ErrTooManyPlayers):
+		return fmt.Sprintf(msgs.Game.CreateGameTooManyPlayers, scenario.Name, max, n)
+	default:
+		return fmt.Sprintf(msgs.Game.CreateGameRoleMismatch, scenario.Name, n, len(scenario.GetRoles(n)))
+	}
+}
+
+// HandleSelectScenarioForCreateGame checks the scenario against the room's players, creates the game, and shows confirmation.
 func HandleSelectScenarioForCreateGame(
 	createGameHandler *gameCommand.CreateGameHandler,
 	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
@@ -76,63 +115,54 @@ func HandleSelectScenarioForCreateGame(
 		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
 	}
 
-	// 1. Create the Game entity
-	cmd := gameCommand.CreateGameCommand{
-		RoomID:     roomEntity.RoomID(roomID),
-		ScenarioID: scenarioID,
-		Requester:  *requester,
-	}
-	game, err := createGameHandler.Handle(context.Background(), cmd)
-	if err != nil {
-		errMsg := fmt.Sprintf(msgs.Game.CreateGameErrorCreatingGame, err)
-		log.Printf("Callback creategame_scen: Error creating game: %v", err)
-		return c.Respond(&telebot.CallbackResponse{Text: errMsg, ShowAlert: true})
-	}
-
-	// 2. Fetch players for display
+	// 1. Fetch players and scenario, and refuse scenarios the room cannot play before a game is created
 	players, err := getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: roomEntity.RoomID(roomID)})
 	if err != nil {
 		errMsg := fmt.Sprintf(msgs.Game.CreateGameErrorFetchPlayers, err)
 		log.Printf("Callback creategame_scen: Error fetching players for room %s: %v", roomID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: errMsg, ShowAlert: true})
 	}
-	var playerNames []string
-	for _, p := range players {
-		if p != nil {
-			playerNames = append(playerNames, p.Username)
-		}
-	}
-
-	// 3. Fetch scenario details for display (and role count check)
 	scenario, err := getScenarioByIDHandler.Handle(context.Background(), scenarioQuery.GetScenarioByIDQuery{ID: scenarioID})
 	if err != nil {
 		errMsg := fmt.Sprintf(msgs.Game.CreateGameErrorFetchScenarioDetails, err)
 		log.Printf("Callback creategame_scen: Error fetching scenario %s: %v", scenarioID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: errMsg, ShowAlert: true})
 	}
-
-	// Flatten roles for display and count
-	flatRoles := scenario.GetRoles(len(players))
-	var roleNames []string
-	for _, role := range flatRoles {
-		roleNames = append(roleNames, role.Name)
+	if reason := ScenarioUnavailableReason(scenario, len(players), msgs); reason != "" {
+		log.Printf("Callback creategame_scen: Scenario %s does not fit %d players of room %s", scenarioID, len(players), roomID)
+		return c.Respond(&telebot.CallbackResponse{Text: reason, ShowAlert: true})
 	}
 
-	// Optional: Early check if player count matches role count
-	if len(players) != len(flatRoles) {
-		errMsg := fmt.Sprintf(msgs.Game.AssignRolesErrorPlayerMismatch, len(flatRoles), len(players), game.ID)
-		log.Printf("Callback creategame_scen: Mismatch players(%d) roles(%d) for game %s", len(players), len(flatRoles), game.ID)
+	// 2. Create the Game entity
+	cmd := gameCommand.CreateGameCommand{
+		RoomID:     roomEntity.RoomID(roomID),
+		ScenarioID: scenarioID,
+		Requester:  *requester,
+	}
+	game, err := createGameHandler.Handle(context.Background(), cmd)
+	if err != nil {
+		errMsg := fmt.Sprintf(msgs.Game.CreateGameErrorCreatingGame, err)
+		log.Printf("Callback creategame_scen: Error creating game: %v", err)
 		return c.Respond(&telebot.CallbackResponse{Text: errMsg, ShowAlert: true})
 	}
 
+	// 3. Flatten roles for display
+	var roleNames []string
+	for _, role := range scenario.GetRoles(len(players)) {
+		roleNames = append(roleNames, role.Name)
+	}
+
 	// 4. Build confirmation message and keyboard
 	markup := &telebot.ReplyMarkup{}
 	gameIDStr := string(game.ID)
-	markup.Inline(markup.Row(
-		markup.Data(msgs.Game.CreateGameStartButton, tgutil.UniqueStartGame+"|"+gameIDStr),   // Direct role assignment
-		markup.Data(msgs.Game.ChooseCardButton, tgutil.UniqueChooseCardStart+"|"+gameIDStr),  // Interactive role selection
-		markup.Data(msgs.Game.CreateGameCancelButton, tgutil.UniqueCancelGame+"|"+gameIDStr), // Cancel creation
-	))
+	markup.Inline(
+		markup.Row(
+			markup.Data(msgs.Game.CreateGameStartButton, tgutil.UniqueStartGame+"|"+gameIDStr),   // Direct role assignment
+			markup.Data(msgs.Game.ChooseCardButton, tgutil.UniqueChooseCardStart+"|"+gameIDStr),  // Interactive role selection
+			markup.Data(msgs.Game.CreateGameCancelButton, tgutil.UniqueCancelGame+"|"+gameIDStr), // Cancel creation
+		),
+		markup.Row(markup.Data(msgs.Game.ReadyCheckButton, tgutil.UniqueReadyCheckSelect+"|"+gameIDStr)), // Ask the players first
+	)
 
 	confirmMsg := fmt.Sprintf(msgs.Game.CreateGameConfirmPrompt,
 		strings.Join(roleNames, "\n- "),
@@ -144,6 +174,7 @@ func HandleSelectScenarioForCreateGame(
 func HandleStartCreatedGame(
 	assignRolesHandler *gameCommand.AssignRolesHandler,
 	bot *telebot.Bot, // Need bot to send private messages
+	media RoleMedia,
 	c telebot.Context,
 	gameID string,
 	msgs *messages.Messages,
@@ -173,19 +204,20 @@ func HandleStartCreatedGame(
 	for user, role := range assignments {
 		targetUser := &telebot.User{ID: int64(user.ID)}
 		// Need Room Name - should fetch game details first?
-		privateMsg, opts := PrepareAssignRoleMessage(msgs, role)
-		_, pmErr := bot.Send(targetUser, privateMsg, opts...)
+		privateMsg, opts, uploaded := PrepareAssignRoleMessage(media, msgs, gameEntity.GameID(gameID), role)
+		sent, pmErr := bot.Send(targetUser, privateMsg, opts...)
 		if pmErr != nil {
 			log.Printf(msgs.Game.AssignRolesErrorSendingPrivate, user.ID, pmErr)
 			// Collect errors?
 		}
+		uploaded(sent)
 		// Get username for public message (Requires fetching users?)
 		assignResults = append(assignResults, fmt.Sprintf("%s \\-\\> %s \\(%s\\)", user.GetProfileLink(), role.Name, role.Side))
 	}
 
 	// Edit original message to show success
 	finalMsg := fmt.Sprintf(msgs.Game.CreateGameStartedSuccess, strings.Join(assignResults, "\n"))
-	return c.Edit(finalMsg, telebot.ModeMarkdownV2, telebot.NoPreview)
+	return c.Edit(finalMsg, OpenPhasePanelMarkup(gameEntity.GameID(gameID), msgs), telebot.ModeMarkdownV2, telebot.NoPreview)
 }
 
 // --- Choose Card Flow Callbacks --- (NEW - Core Logic Implementation)
@@ -198,6 +230,29 @@ type BotHandlerInterface interface {
 	GetScenarioByIDHandler() *scenarioQuery.GetScenarioByIDHandler
 	AssignRolesHandler() *gameCommand.AssignRolesHandler
 	UpdateGameHandler() *gameCommand.UpdateGameHandler
+	StartNightHandler() *gameCommand.StartNightHandler
+	StartDayHandler() *gameCommand.StartDayHandler
+	StartVotingHandler() *gameCommand.StartVotingHandler
+	StartDefenseHandler() *gameCommand.StartDefenseHandler
+	EndGameHandler() *gameCommand.EndGameHandler
+	EliminatePlayerHandler() *gameCommand.EliminatePlayerHandler
+	RevivePlayerHandler() *gameCommand.RevivePlayerHandler
+	GetGameByRoomIDHandler() *gameQuery.GetGameByRoomIDHandler
+	OpenVoteHandler() *gameCommand.OpenVoteHandler
+	CastVoteHandler() *gameCommand.CastVoteHandler
+	CloseVoteHandler() *gameCommand.CloseVoteHandler
+	SubmitNightActionHandler() *gameCommand.SubmitNightActionHandler
+	ResolveNightHandler() *gameCommand.ResolveNightHandler
+	StartTimerHandler() *gameCommand.StartTimerHandler
+	StopTimerHandler() *gameCommand.StopTimerHandler
+	StartSpeakingTurnsHandler() *gameCommand.StartSpeakingTurnsHandler
+	NextSpeakerHandler() *gameCommand.NextSpeakerHandler
+	RequestChallengeHandler() *gameCommand.RequestChallengeHandler
+	GrantChallengeHandler() *gameCommand.GrantChallengeHandler
+	StartReadyCheckHandler() *gameCommand.StartReadyCheckHandler
+	MarkReadyHandler() *gameCommand.MarkReadyHandler
+	KickUnreadyPlayersHandler() *gameCommand.KickUnreadyPlayersHandler
+	SyncReadyCheckHandler() *gameCommand.SyncReadyCheckHandler
 	Bot() *telebot.Bot
 	GetInteractiveSelectionState(gameID gameEntity.GameID) (*tgutil.InteractiveSelectionState, bool)
 	SetInteractiveSelectionState(gameID gameEntity.GameID, state *tgutil.InteractiveSelectionState)
@@ -209,7 +264,21 @@ type BotHandlerInterface interface {
 	GetOrCreateAdminAssignmentTracker(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
 	GetAdminAssignmentTracker(gameID gameEntity.GameID) (*tgutil.RefreshingMessageBook, bool)
 	DeleteAdminAssignmentTracker(gameID gameEntity.GameID)
+	SaveInteractiveSelection(gameID gameEntity.GameID, state *tgutil.InteractiveSelectionState)
 	RefreshMessages(book *tgutil.RefreshingMessageBook)
+	GetOrCreatePhasePanel(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
+	GetOrCreateVoteBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
+	GetOrCreateNightSummary(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
+	GetOrCreateTimerBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
+	GetOrCreateTurnsBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
+	GetOrCreateReadyCheckBook(gameID gameEntity.GameID) *tgutil.RefreshingMessageBook
+	DeleteReadyCheckBook(gameID gameEntity.GameID)
+	NightActionDraft(key string) []sharedEntity.UserID
+	SetNightActionDraft(key string, targets []sharedEntity.UserID)
+	AddNightPrompt(gameID gameEntity.GameID, msg *tgutil.RefreshingMessage)
+	TakeNightPrompts(gameID gameEntity.GameID) []*tgutil.RefreshingMessage
+	RaiseRoomDetailRefresh()
+	RoleMedia
 }
 
 // HandleChooseCardStart initiates the interactive role selection process.
@@ -257,7 +326,21 @@ func HandleChooseCardStart(
 		return c.Respond(&telebot.CallbackResponse{Text: "Error fetching players.", ShowAlert: true})
 	}
 
-	// 3. Flatten and Shuffle Roles
+	// A ready-check, if one was run, must be complete
+	var playerIDs []sharedEntity.UserID
+	for _, p := range players {
+		if p != nil {
+			playerIDs = append(playerIDs, p.ID)
+		}
+	}
+	if err := game.RequireReady(playerIDs); err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.ReadyCheckNotReady, ShowAlert: true})
+	}
+
+	// 3. Flatten and Shuffle Roles; the room may have changed since the scenario was picked
+	if reason := ScenarioUnavailableReason(scenario, len(players), msgs); reason != "" {
+		return c.Respond(&telebot.CallbackResponse{Text: reason, ShowAlert: true})
+	}
 	shuffledRoles := scenario.GetShuffledRoles(len(players))
 	log.Printf("Shuffled Roles: %v", shuffledRoles)
 	if len(players) != len(shuffledRoles) {
@@ -277,15 +360,16 @@ func HandleChooseCardStart(
 	h.SetInteractiveSelectionState(gameID, newState)
 
 	// 5. Update Game State
-	game.State = gameEntity.GameStateRoleSelection
-	updateCmd := gameCommand.UpdateGameCommand{Game: game}
-	if err := h.UpdateGameHandler().Handle(context.Background(), updateCmd); err != nil {
+	updateCmd := gameCommand.UpdateGameCommand{GameID: gameID, Apply: func(g *gameEntity.Game) {
+		g.State = gameEntity.GameStateRoleSelection
+	}}
+	if _, err := h.UpdateGameHandler().Handle(context.Background(), updateCmd); err != nil {
 		log.Printf("ChooseCardStart: Failed to update game state for %s: %v", gameID, err)
 		// Non-fatal, but log. The interactive state might become stale if server restarts.
 	}
 
 	// 6. Prepare & Edit Admin's Tracking Message (Placeholder Text for now)
-	detailMessage, opts, err := telegram.RoomDetailMessage(h.GetRoomsHandler(), h.GetPlayersInRoomHandler(), msgs, requester.ID, string(game.Room.ID))
+	detailMessage, opts, err := telegram.RoomDetailMessage(h.GetRoomsHandler(), h.GetPlayersInRoomHandler(), h.GetGameByRoomIDHandler(), msgs, requester.ID, string(game.Room.ID))
 	_, err = h.Bot().Edit(c.Message(), detailMessage, opts...)
 	if err != nil {
 		log.Printf("ChooseCardStart: Failed to SEND new admin message for %s: %v", gameID, err)
@@ -346,6 +430,11 @@ func HandleChooseCardStart(
 		// No adding to refresh book in Step 1 -> Now handled by storing message
 	}
 
+	// 8. Persist the round so it can be resumed after a restart
+	newState.Mutex.Lock()
+	h.SaveInteractiveSelection(gameID, newState)
+	newState.Mutex.Unlock()
+
 	return c.Respond() // Acknowledge callback
 }
 
@@ -411,28 +500,31 @@ func HandlePlayerSelectsCard(
 	log.Printf("Player %d selected card %d (Role: %s) for game %s", player.ID, chosenIndex, selectedRole.Name, gameID)
 
 	// 4. Update Game Entity Assignments
-	// Fetch game again to ensure we have the latest state before updating
-	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
-	if err != nil || game == nil {
-		log.Printf("PlayerSelectsCard: Failed to fetch game %s to update assignment: %v", gameID, err)
-		// Non-fatal for selection, but log it
-	} else {
-		game.AssignRole(player.ID, selectedRole) // Assign in entity
-		updateCmd := gameCommand.UpdateGameCommand{Game: game}
-		if err := h.UpdateGameHandler().Handle(context.Background(), updateCmd); err != nil {
-			log.Printf("PlayerSelectsCard: Failed to update game assignment %s: %v", gameID, err)
+	// The change is applied to the stored game, so moves made meanwhile are kept
+	allSelected := len(state.Selections) == len(state.ShuffledRoles)
+	updateCmd := gameCommand.UpdateGameCommand{GameID: gameID, Apply: func(g *gameEntity.Game) {
+		g.AssignRole(player.ID, selectedRole) // Assign in entity
+		if allSelected {
+			g.SetRolesAssigned()
 		}
+	}}
+	if _, err := h.UpdateGameHandler().Handle(context.Background(), updateCmd); err != nil {
+		// Non-fatal for selection, but log it
+		log.Printf("PlayerSelectsCard: Failed to update game assignment %s: %v", gameID, err)
 	}
 
 	// 5. Confirm to Player & Clean Up Player Message -> EDIT instead of delete
 	// remove this player's message from the player refresher
 	h.RemovePlayerRoleActiveMessage(gameID, c.Message().Chat.ID)
-	confirmMsgText, opts := PrepareAssignRoleMessage(msgs, selectedRole)
+	confirmMsgText, opts, uploaded := PrepareAssignRoleMessage(h, msgs, gameID, selectedRole)
 	err = c.Edit(confirmMsgText, opts...) // Edit the original message
 	if err != nil {
+		// A text message cannot become a role card image, so cards are sent anew
 		log.Printf("PlayerSelectsCard: Failed to EDIT player confirmation message for user %d: %v", player.ID, err)
-		_ = c.Delete()                        // Edit the original message
-		err = c.Send(confirmMsgText, opts...) // Try sending the original message
+		_ = c.Delete() // Edit the original message
+		var sent *telebot.Message
+		sent, err = c.Bot().Send(c.Chat(), confirmMsgText, opts...) // Try sending the original message
+		uploaded(sent)
 	}
 
 	// 6. Trigger Refreshes (Added Refresh Logic)
@@ -443,21 +535,11 @@ func HandlePlayerSelectsCard(
 	log.Printf("PlayerSelectsCard: Raised refresh needed flags for game %s", gameID)
 
 	// 7. Check Completion & Update Admin Message
-	allSelected := len(state.Selections) == len(state.ShuffledRoles)
-
 	// --- Admin Message Update is now handled by the refresh timer ---
 	// We don't need to prepare/edit it here directly anymore.
 
 	if allSelected {
 		log.Printf("All roles selected for game %s", gameID)
-		// Final update to game state
-		if game != nil { // Re-use fetched game if successful
-			game.SetRolesAssigned()
-			updateCmd := gameCommand.UpdateGameCommand{Game: game}
-			if err := h.UpdateGameHandler().Handle(context.Background(), updateCmd); err != nil {
-				log.Printf("PlayerSelectsCard: Failed to set final game state for %s: %v", gameID, err)
-			}
-		}
 		// Trigger one last refresh to show the final state
 		h.RefreshMessages(adminRefresher)
 		log.Printf("All roles selected: Triggering final refresh and cleanup for game %s", gameID)
@@ -466,6 +548,9 @@ func HandlePlayerSelectsCard(
 		h.DeleteInteractiveSelectionState(gameID)
 		h.DeleteAdminAssignmentTracker(gameID) // Delete admin book
 		h.DeletePlayerRoleRefresher(gameID)    // Delete player book
+	} else {
+		// Persist the new pick (state lock is still held)
+		h.SaveInteractiveSelection(gameID, state)
 	}
 
 	return c.Respond() // Acknowledge button press
@@ -481,6 +566,7 @@ func HandleCancelCreateGame(h BotHandlerInterface, c telebot.Context, msgs *mess
 		h.DeleteInteractiveSelectionState(gameID)
 		h.DeleteAdminAssignmentTracker(gameID)
 		h.DeletePlayerRoleRefresher(gameID)
+		h.DeleteReadyCheckBook(gameID)
 		log.Printf("Cancelled game %s: Cleaned up interactive state and refresh books.", gameID)
 		// Optionally: Update game state to Cancelled if needed?
 	} else {
@@ -559,7 +645,11 @@ func PrepareAdminAssignmentMessage(game *gameEntity.Game, state *tgutil.Interact
 
 		if state.TakenIndices[cardIndex] {
 			if player, ok := selectionMap[cardIndex]; ok {
-				line += fmt.Sprintf("%s \\-\\> ||%s||", player.GetProfileLink(), role.Name) // Fallback to ID
+				link := player.GetProfileLink()
+				if game != nil && !game.Status(player.ID).Alive {
+					link = "~" + link + "~"
+				}
+				line += fmt.Sprintf("%s \\-\\> ||%s||", link, role.Name) // Fallback to ID
 			} else {
 				line += "(Error: Taken but no player found)"
 			}
@@ -576,6 +666,9 @@ func PrepareAdminAssignmentMessage(game *gameEntity.Game, state *tgutil.Interact
 	if allSelected {
 		// Use simplified message key without Markdown
 		messageText = fmt.Sprintf("All roles selected\\!\n%s", strings.Join(assignmentLines, "\n"))
+		if game != nil {
+			markup = OpenPhasePanelMarkup(game.ID, msgs)
+		}
 	} else {
 		// Use simplified message key without Markdown
 		messageText = fmt.Sprintf("Role Selection Progress:\n%s\nWaiting for players\\.\\.\\.", strings.Join(assignmentLines, "\n"))
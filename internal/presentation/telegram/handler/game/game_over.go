@@ -0,0 +1,137 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// HandleEndGameWinner finishes the game with the side the moderator picked as the winner
+func HandleEndGameWinner(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameIDStr, idxStr := splitLast(data)
+	sideIdx, err := strconv.Atoi(idxStr)
+	if gameIDStr == "" || err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+	gameID := gameEntity.GameID(gameIDStr)
+	ctx := context.Background()
+
+	game, err := h.GetGameByIDHandler().Handle(ctx, gameQuery.GetGameByIDQuery{ID: gameID})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	if game.Scenario == nil || sideIdx < 0 || sideIdx >= len(game.Scenario.Sides) {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.PhaseTransitionError, gameEntity.ErrUnknownSide), ShowAlert: true})
+	}
+
+	game, err = h.EndGameHandler().Handle(ctx, gameCommand.EndGameCommand{
+		Requester: *requester,
+		GameID:    gameID,
+		Winner:    game.Scenario.Sides[sideIdx].Name,
+	})
+	if err != nil {
+		log.Printf("EndGameWinner: failed for game %s: %v", gameID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.PhaseTransitionError, err), ShowAlert: true})
+	}
+	editPhasePanel(h, c, game, msgs)
+	AnnounceGameOver(h, game, msgs)
+	return c.Respond(&telebot.CallbackResponse{Text: PhaseLabel(game, msgs)})
+}
+
+// AnnounceGameOver sends every player of the room the winners and the final reveal of all assignments,
+// and refreshes the views that show the game
+func AnnounceGameOver(h BotHandlerInterface, game *gameEntity.Game, msgs *messages.Messages) {
+	players := roomPlayers(h, game)
+	AnnounceToRoom(h, game, FinalRevealText(game, players, msgs))
+	announceRoleCards(h, game, players, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	h.GetOrCreateNightSummary(game.ID).RaiseRefreshNeeded()
+	h.RaiseRoomDetailRefresh()
+}
+
+// Telegram sends at most this many photos in one album
+const maxAlbumSize = 10
+
+// announceRoleCards follows the final reveal with the role card images of the game, captioned with their players.
+// Each album is built again per recipient so images uploaded for the first one are reused by file ID.
+func announceRoleCards(h BotHandlerInterface, game *gameEntity.Game, players []*sharedEntity.User, msgs *messages.Messages) {
+	ids := make([]sharedEntity.UserID, 0, len(game.Assignments))
+	for id := range game.Assignments {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, recipient := range roomAudience(h, game) {
+		var album telebot.Album
+		var uploads []func(*telebot.Message)
+		flush := func() {
+			if len(album) == 0 {
+				return
+			}
+			sent, err := h.Bot().SendAlbum(&telebot.User{ID: int64(recipient.ID)}, album)
+			if err != nil {
+				log.Printf("AnnounceRoleCards: failed to send role cards to user %d: %v", recipient.ID, err)
+			}
+			for i := range sent {
+				if i < len(uploads) {
+					uploads[i](&sent[i])
+				}
+			}
+			album, uploads = nil, nil
+		}
+		for _, id := range ids {
+			role := game.Assignments[id]
+			file, uploaded, ok := h.RoleImage(role)
+			if !ok {
+				continue
+			}
+			album = append(album, &telebot.Photo{File: file, Caption: fmt.Sprintf(msgs.Game.FinalRevealCardCaption, playerName(players, id), role.Name)})
+			uploads = append(uploads, uploaded)
+			if len(album) == maxAlbumSize {
+				flush()
+			}
+		}
+		flush()
+	}
+}
+
+// FinalRevealText renders the end of the game: the winning sides, then every player's role and side
+func FinalRevealText(game *gameEntity.Game, players []*sharedEntity.User, msgs *messages.Messages) string {
+	header := msgs.Game.GameEndedAnnouncement
+	if game.HasWinner() {
+		header = fmt.Sprintf(msgs.Game.GameWonAnnouncement, strings.Join(game.Winners, "، "))
+	}
+
+	ids := make([]sharedEntity.UserID, 0, len(game.Assignments))
+	for id := range game.Assignments {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	lines := []string{header, "", msgs.Game.FinalRevealTitle}
+	for _, id := range ids {
+		role := game.Assignments[id]
+		format := msgs.Game.FinalRevealEntry
+		if !game.Status(id).Alive {
+			format = msgs.Game.FinalRevealDeadEntry
+		}
+		lines = append(lines, fmt.Sprintf(format, playerName(players, id), role.Name, role.Side))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,62 @@
+package telegram
+
+import (
+	"context"
+	"log"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	scenarioHandler "telemafia/internal/presentation/telegram/handler/scenario"
+	messages "telemafia/internal/presentation/telegram/messages"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// maxCaptionLength is Telegram's limit for photo captions
+const maxCaptionLength = 1024
+
+// MyRoleMarkup is the button under a role card that explains the role again
+func MyRoleMarkup(gameID gameEntity.GameID, msgs *messages.Messages) *telebot.ReplyMarkup {
+	markup := &telebot.ReplyMarkup{}
+	markup.Inline(markup.Row(markup.Data(msgs.Game.MyRoleButton, tgutil.UniqueMyRole, string(gameID))))
+	return markup
+}
+
+// HandleMyRole privately sends a player their assigned role with its side, description, abilities and tips
+func HandleMyRole(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(gameIDStr)})
+	if err != nil || game == nil {
+		log.Printf("MyRole: game %s not found: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.MyRoleNone, ShowAlert: true})
+	}
+	role, ok := game.Assignments[requester.ID]
+	if !ok {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.MyRoleNone, ShowAlert: true})
+	}
+
+	help := scenarioHandler.RoleHelp(msgs, game.Scenario, role)
+	recipient := &telebot.User{ID: int64(requester.ID)}
+	var what interface{} = help
+	uploaded := func(*telebot.Message) {}
+	if file, cache, ok := h.RoleImage(role); ok && len([]rune(help)) <= maxCaptionLength {
+		what = &telebot.Photo{File: file, HasSpoiler: true, Caption: help}
+		uploaded = cache
+	}
+	sent, err := c.Bot().Send(recipient, what)
+	if err != nil {
+		log.Printf("MyRole: failed to send role of user %d in game %s: %v", requester.ID, gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.MyRoleNone, ShowAlert: true})
+	}
+	uploaded(sent)
+
+	// The button on the room detail lives in another chat, so say where the role went
+	if c.Chat() == nil || c.Chat().ID != recipient.ID {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.MyRoleSent})
+	}
+	return c.Respond()
+}
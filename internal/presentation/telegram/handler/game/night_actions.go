@@ -0,0 +1,266 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// SendNightPrompts sends every alive role holder a private prompt per ability usable tonight
+func SendNightPrompts(h BotHandlerInterface, game *gameEntity.Game, msgs *messages.Messages) {
+	players := roomPlayers(h, game)
+	for _, actor := range game.NightActors() {
+		role := game.Assignments[actor]
+		for idx, ability := range role.Abilities {
+			if game.RemainingUses(actor, ability) == 0 {
+				continue
+			}
+			text, markup := PrepareNightActionPrompt(game, players, actor, idx, nil, msgs)
+			sent, err := h.Bot().Send(&telebot.User{ID: int64(actor)}, text, markup)
+			if err != nil {
+				log.Printf("NightPrompts: failed to send %s prompt to user %d: %v", ability.Name, actor, err)
+				continue
+			}
+			h.AddNightPrompt(game.ID, &tgutil.RefreshingMessage{ChatID: sent.Chat.ID, MessageID: sent.ID, Data: string(game.ID)})
+		}
+	}
+}
+
+// CloseNightPrompts removes the target buttons of the night action prompts sent for a game,
+// once tonight's actions are resolved or the night is over
+func CloseNightPrompts(h BotHandlerInterface, gameID gameEntity.GameID) {
+	for _, prompt := range h.TakeNightPrompts(gameID) {
+		msg := &telebot.Message{ID: prompt.MessageID, Chat: &telebot.Chat{ID: prompt.ChatID}}
+		if _, err := h.Bot().EditReplyMarkup(msg, &telebot.ReplyMarkup{}); err != nil && !strings.Contains(err.Error(), "message is not modified") {
+			log.Printf("NightPrompts: failed to close prompt %d of game %s: %v", prompt.MessageID, gameID, err)
+		}
+	}
+}
+
+// PrepareNightActionPrompt renders the target picker of one ability.
+// draft holds the targets picked so far; when empty, tonight's submitted choice is shown.
+func PrepareNightActionPrompt(
+	game *gameEntity.Game,
+	players []*sharedEntity.User,
+	actor sharedEntity.UserID,
+	abilityIdx int,
+	draft []sharedEntity.UserID,
+	msgs *messages.Messages,
+) (string, *telebot.ReplyMarkup) {
+	ability := game.Assignments[actor].Abilities[abilityIdx]
+	selected := draft
+	if len(selected) == 0 {
+		if action, ok := game.TonightAction(actor, ability.Name); ok {
+			selected = action.Targets
+		}
+	}
+
+	lines := []string{fmt.Sprintf(msgs.Game.NightActionChoose, ability.Count())}
+	if ability.Description != "" {
+		lines = append([]string{ability.Description}, lines...)
+	}
+	if remaining := game.RemainingUses(actor, ability); remaining > 0 {
+		lines = append(lines, fmt.Sprintf(msgs.Game.NightActionUsesLeft, remaining))
+	}
+	if len(selected) > 0 {
+		lines = append(lines, fmt.Sprintf(msgs.Game.NightActionChosen, playerNames(players, selected)))
+	}
+	markup := &telebot.ReplyMarkup{}
+	if nightResolved(game) {
+		lines = append(lines, msgs.Game.NightActionResolved)
+		markup.Inline()
+		return fmt.Sprintf(msgs.Game.NightActionPrompt, game.Phase.Number, ability.Name, strings.Join(lines, "\n")), markup
+	}
+	text := fmt.Sprintf(msgs.Game.NightActionPrompt, game.Phase.Number, ability.Name, strings.Join(lines, "\n"))
+
+	var rows []telebot.Row
+	for _, target := range game.ValidNightTargets(actor, ability) {
+		label := playerName(players, target)
+		if containsUser(selected, target) {
+			label = fmt.Sprintf(msgs.Game.NightActionButtonSelected, label)
+		}
+		payload := fmt.Sprintf("%s|%d|%d", game.ID, abilityIdx, target)
+		rows = append(rows, markup.Row(markup.Data(label, tgutil.UniqueNightActionPick, payload)))
+	}
+	markup.Inline(rows...)
+	return text, markup
+}
+
+// HandleNightActionPick toggles a target of an ability; the action is submitted once enough targets are picked
+func HandleNightActionPick(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	rest, targetStr := splitLast(data)
+	gameIDStr, idxStr := splitLast(rest)
+	target, errTarget := strconv.ParseInt(targetStr, 10, 64)
+	abilityIdx, errIdx := strconv.Atoi(idxStr)
+	if gameIDStr == "" || errTarget != nil || errIdx != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+	gameID := gameEntity.GameID(gameIDStr)
+
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	abilities := game.Assignments[requester.ID].Abilities
+	if abilityIdx < 0 || abilityIdx >= len(abilities) {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.NightActionError, gameEntity.ErrUnknownAbility), ShowAlert: true})
+	}
+	ability := abilities[abilityIdx]
+	if nightResolved(game) {
+		text, markup := PrepareNightActionPrompt(game, roomPlayers(h, game), requester.ID, abilityIdx, nil, msgs)
+		_ = c.Edit(text, markup)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.NightActionError, gameEntity.ErrNightAlreadyResolved), ShowAlert: true})
+	}
+
+	draftKey := fmt.Sprintf("%s|%d|%s", gameID, requester.ID, ability.Name)
+	draft := toggleUser(h.NightActionDraft(draftKey), sharedEntity.UserID(target))
+	if len(draft) > ability.Count() {
+		draft = draft[1:]
+	}
+
+	response := &telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.NightActionPartial, len(draft), ability.Count())}
+	if len(draft) == ability.Count() {
+		game, err = h.SubmitNightActionHandler().Handle(context.Background(), gameCommand.SubmitNightActionCommand{
+			Requester: *requester,
+			GameID:    gameID,
+			Ability:   ability.Name,
+			Targets:   draft,
+		})
+		if err != nil {
+			h.SetNightActionDraft(draftKey, nil)
+			return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.NightActionError, err), ShowAlert: true})
+		}
+		h.GetOrCreateNightSummary(gameID).RaiseRefreshNeeded()
+		response.Text = fmt.Sprintf(msgs.Game.NightActionSubmitted, playerNames(roomPlayers(h, game), draft))
+		draft = nil
+	}
+	h.SetNightActionDraft(draftKey, draft)
+
+	text, markup := PrepareNightActionPrompt(game, roomPlayers(h, game), requester.ID, abilityIdx, draft, msgs)
+	if err := c.Edit(text, markup); err != nil && !strings.Contains(err.Error(), "message is not modified") {
+		log.Printf("NightActionPick: failed to redraw prompt for user %d: %v", requester.ID, err)
+	}
+	return c.Respond(response)
+}
+
+// HandleShowNightSummary sends the moderator the night summary of a game
+func HandleShowNightSummary(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(gameIDStr)})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	if !canModerateGame(game, *requester) {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorPermissionDenied, ShowAlert: true})
+	}
+	SendNightSummary(h, c.Sender().ID, game)
+	return c.Respond()
+}
+
+// SendNightSummary sends the night summary to a moderator and keeps it current as actions come in
+func SendNightSummary(h BotHandlerInterface, chatID int64, game *gameEntity.Game) {
+	book := h.GetOrCreateNightSummary(game.ID)
+	text, opts, err := book.GetMessage(chatID, string(game.ID))
+	if err != nil {
+		log.Printf("NightSummary: failed to prepare summary of game %s: %v", game.ID, err)
+		return
+	}
+	sent, err := h.Bot().Send(&telebot.User{ID: chatID}, text, opts...)
+	if err != nil {
+		log.Printf("NightSummary: failed to send summary of game %s: %v", game.ID, err)
+		return
+	}
+	book.AddActiveMessage(chatID, &tgutil.RefreshingMessage{ChatID: chatID, MessageID: sent.ID, Data: string(game.ID)})
+}
+
+// PrepareNightSummaryMessage renders the actions of the current (or last) night for the moderator.
+// Roles and choices are spoilers so the summary can be opened in front of players.
+func PrepareNightSummaryMessage(game *gameEntity.Game, players []*sharedEntity.User, msgs *messages.Messages) (string, []interface{}, error) {
+	night := game.Phase.Number
+	link := func(id sharedEntity.UserID) string {
+		for _, p := range players {
+			if p != nil && p.ID == id {
+				return p.GetProfileLink()
+			}
+		}
+		return common.EscapeMarkdownV2(strconv.FormatInt(int64(id), 10))
+	}
+
+	var lines []string
+	for _, action := range game.ActionsOfNight(night) {
+		lines = append(lines, fmt.Sprintf(msgs.Game.NightSummaryEntry,
+			link(action.Actor),
+			common.EscapeMarkdownV2(game.Assignments[action.Actor].Name),
+			common.EscapeMarkdownV2(action.Ability),
+			common.EscapeMarkdownV2(playerNames(players, action.Targets)),
+		))
+	}
+	if game.Phase.Type == gameEntity.PhaseNight {
+		for _, actor := range game.NightActors() {
+			for _, ability := range game.UsableAbilities(actor) {
+				if _, done := game.TonightAction(actor, ability.Name); done {
+					continue
+				}
+				lines = append(lines, fmt.Sprintf(msgs.Game.NightSummaryPendingEntry,
+					link(actor),
+					common.EscapeMarkdownV2(game.Assignments[actor].Name),
+					common.EscapeMarkdownV2(ability.Name),
+				))
+			}
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, msgs.Game.NightSummaryEmpty)
+	}
+
+	label := phaseLabelOf(gameEntity.Phase{Type: gameEntity.PhaseNight, Number: night}, msgs)
+	text := fmt.Sprintf(msgs.Game.NightSummaryMessage, common.EscapeMarkdownV2(label), strings.Join(lines, "\n"))
+	return text, []interface{}{telebot.ModeMarkdownV2, telebot.NoPreview}, nil
+}
+
+// toggleUser adds id to ids, or removes it if present
+func toggleUser(ids []sharedEntity.UserID, id sharedEntity.UserID) []sharedEntity.UserID {
+	out := make([]sharedEntity.UserID, 0, len(ids)+1)
+	for _, existing := range ids {
+		if existing != id {
+			out = append(out, existing)
+		}
+	}
+	if len(out) == len(ids) {
+		out = append(out, id)
+	}
+	return out
+}
+
+func containsUser(ids []sharedEntity.UserID, id sharedEntity.UserID) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+// nightResolved reports whether the current phase is a night whose actions were already resolved
+func nightResolved(game *gameEntity.Game) bool {
+	return game.Phase.Type == gameEntity.PhaseNight && game.ResolvedNight >= game.Phase.Number
+}
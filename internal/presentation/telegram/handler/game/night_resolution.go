@@ -0,0 +1,97 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// HandleResolveNight applies tonight's actions from the moderator panel: the room learns who died,
+// players get their private results and the moderator gets the full outcome
+func HandleResolveNight(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, resolution, err := h.ResolveNightHandler().Handle(context.Background(), gameCommand.ResolveNightCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("ResolveNight: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.NightResolveError, err), ShowAlert: true})
+	}
+
+	CloseNightPrompts(h, game.ID)
+	players := roomPlayers(h, game)
+	AnnounceToRoom(h, game, NightDeathsText(game, resolution, players, msgs))
+	for _, result := range resolution.Results {
+		if _, err := h.Bot().Send(&telebot.User{ID: int64(result.Recipient)}, PrivateResultText(result, players, msgs)); err != nil {
+			log.Printf("ResolveNight: failed to send result to user %d: %v", result.Recipient, err)
+		}
+	}
+	if _, err := h.Bot().Send(c.Sender(), NightResolutionText(resolution, players, msgs)); err != nil {
+		log.Printf("ResolveNight: failed to send outcome of game %s: %v", game.ID, err)
+	}
+
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	if len(resolution.Deaths) > 0 {
+		h.RaiseRoomDetailRefresh()
+	}
+	if game.State == gameEntity.GameStateFinished {
+		AnnounceGameOver(h, game, msgs)
+	}
+	return c.Respond()
+}
+
+// NightDeathsText renders the announcement of who died during the night
+func NightDeathsText(game *gameEntity.Game, resolution gameEntity.NightResolution, players []*sharedEntity.User, msgs *messages.Messages) string {
+	if len(resolution.Deaths) == 0 {
+		return msgs.Game.NightResultNoDeaths
+	}
+	text := ""
+	for i, id := range resolution.Deaths {
+		if i > 0 {
+			text += "\n"
+		}
+		if identity, revealed := game.RevealedIdentity(id); revealed {
+			text += fmt.Sprintf(msgs.Game.NightResultDeathRevealed, playerName(players, id), identity)
+		} else {
+			text += fmt.Sprintf(msgs.Game.NightResultDeath, playerName(players, id))
+		}
+	}
+	return text
+}
+
+// PrivateResultText renders what one player learns from the night
+func PrivateResultText(result gameEntity.PrivateResult, players []*sharedEntity.User, msgs *messages.Messages) string {
+	if result.Blocked {
+		return fmt.Sprintf(msgs.Game.NightResultBlocked, result.Ability)
+	}
+	verdict := msgs.Game.NightResultInnocent
+	if result.Investigation == scenarioEntity.InvestigationGuilty {
+		verdict = msgs.Game.NightResultGuilty
+	}
+	return fmt.Sprintf(msgs.Game.NightResultInvestigation, result.Ability, playerName(players, result.Target), verdict)
+}
+
+// NightResolutionText renders the full outcome of the night for the moderator
+func NightResolutionText(resolution gameEntity.NightResolution, players []*sharedEntity.User, msgs *messages.Messages) string {
+	names := func(ids []sharedEntity.UserID) string {
+		if len(ids) == 0 {
+			return msgs.Game.NightResolutionNone
+		}
+		return playerNames(players, ids)
+	}
+	return fmt.Sprintf(msgs.Game.NightResolutionMessage, resolution.Night, names(resolution.Deaths), names(resolution.Saved), names(resolution.Blocked))
+}
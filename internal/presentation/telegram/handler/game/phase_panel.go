@@ -0,0 +1,393 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	roomQuery "telemafia/internal/domain/room/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// HandlePhasePanelCommand handles /panel [game_id].
+// Without an ID it opens the only running game the requester moderates, or lets them pick one.
+func HandlePhasePanelCommand(
+	h BotHandlerInterface,
+	getGamesHandler *gameQuery.GetGamesHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Send(msgs.Common.ErrorIdentifyRequester)
+	}
+
+	if gameIDStr := strings.TrimSpace(c.Message().Payload); gameIDStr != "" {
+		return sendPhasePanel(h, c, *requester, gameEntity.GameID(gameIDStr), msgs)
+	}
+
+	games, err := getGamesHandler.Handle(context.Background(), gameQuery.GetGamesQuery{})
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Game.ListGamesError, err))
+	}
+	var running []*gameEntity.Game
+	for _, g := range games {
+		if g.State != gameEntity.GameStateRolesAssigned && g.State != gameEntity.GameStateInProgress {
+			continue
+		}
+		if canModerateGame(g, *requester) {
+			running = append(running, g)
+		}
+	}
+
+	switch len(running) {
+	case 0:
+		return c.Send(msgs.Game.PhasePanelNoGames)
+	case 1:
+		return sendPhasePanel(h, c, *requester, running[0].ID, msgs)
+	}
+
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	for _, g := range running {
+		roomName := ""
+		if g.Room != nil {
+			roomName = g.Room.Name
+		}
+		text := fmt.Sprintf(msgs.Game.PhasePanelGameButton, roomName, PhaseLabel(g, msgs))
+		rows = append(rows, markup.Row(markup.Data(text, tgutil.UniquePhasePanelOpen, string(g.ID))))
+	}
+	markup.Inline(rows...)
+	return c.Send(msgs.Game.PhasePanelSelectGame, markup)
+}
+
+// HandleOpenPhasePanel sends the moderator panel of a game in response to a button
+func HandleOpenPhasePanel(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	if err := sendPhasePanel(h, c, *requester, gameEntity.GameID(gameIDStr), msgs); err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: err.Error(), ShowAlert: true})
+	}
+	return c.Respond()
+}
+
+// HandlePhaseTransition runs the phase command behind a panel button and announces the new phase to the room
+func HandlePhaseTransition(
+	h BotHandlerInterface,
+	c telebot.Context,
+	unique string,
+	gameIDStr string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameID := gameEntity.GameID(gameIDStr)
+	ctx := context.Background()
+
+	var game *gameEntity.Game
+	var err error
+	switch unique {
+	case tgutil.UniquePhaseStartNight:
+		game, err = h.StartNightHandler().Handle(ctx, gameCommand.StartNightCommand{Requester: *requester, GameID: gameID})
+	case tgutil.UniquePhaseStartDay:
+		game, err = h.StartDayHandler().Handle(ctx, gameCommand.StartDayCommand{Requester: *requester, GameID: gameID})
+	case tgutil.UniquePhaseStartVoting:
+		game, err = h.StartVotingHandler().Handle(ctx, gameCommand.StartVotingCommand{Requester: *requester, GameID: gameID})
+	case tgutil.UniquePhaseStartDefense:
+		game, err = h.StartDefenseHandler().Handle(ctx, gameCommand.StartDefenseCommand{Requester: *requester, GameID: gameID})
+	case tgutil.UniqueEndGameConfirm:
+		game, err = h.EndGameHandler().Handle(ctx, gameCommand.EndGameCommand{Requester: *requester, GameID: gameID})
+	default:
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.PhaseUnknownAction, ShowAlert: true})
+	}
+	if err != nil {
+		log.Printf("PhaseTransition: %s for game %s failed: %v", unique, gameID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.PhaseTransitionError, err), ShowAlert: true})
+	}
+
+	// The confirmation keyboard replaced the panel; put the panel back before the refresh picks it up
+	if unique == tgutil.UniqueEndGameConfirm {
+		editPhasePanel(h, c, game, msgs)
+	}
+	AfterPhaseChange(h, game, c.Sender().ID, msgs)
+	return c.Respond(&telebot.CallbackResponse{Text: PhaseLabel(game, msgs)})
+}
+
+// AfterPhaseChange refreshes the views of a game that moved to a new phase (or finished), announces it
+// to the room and, when a night starts, sends the night prompts and the summary to the moderator
+func AfterPhaseChange(h BotHandlerInterface, game *gameEntity.Game, moderatorChatID int64, msgs *messages.Messages) {
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	// Drops the pending abilities from summaries once the night is over
+	h.GetOrCreateNightSummary(game.ID).RaiseRefreshNeeded()
+	// A phase change ends the timer of the previous phase
+	h.GetOrCreateTimerBook(game.ID).RaiseRefreshNeeded()
+	// and closes the talk order of the previous day or defense
+	h.GetOrCreateTurnsBook(game.ID).RaiseRefreshNeeded()
+	// Prompts of the night that just ended can no longer be used
+	CloseNightPrompts(h, game.ID)
+
+	if game.State == gameEntity.GameStateFinished {
+		AnnounceGameOver(h, game, msgs)
+		return
+	}
+	AnnounceToRoom(h, game, fmt.Sprintf(msgs.Game.PhaseAnnouncement, PhaseLabel(game, msgs)))
+	if game.Phase.Type == gameEntity.PhaseNight {
+		SendNightPrompts(h, game, msgs)
+		SendNightSummary(h, moderatorChatID, game)
+	}
+}
+
+// HandleEndGameRequest asks the moderator to confirm ending the game, optionally declaring a winning side
+func HandleEndGameRequest(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(gameIDStr)})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	if !canModerateGame(game, *requester) {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorPermissionDenied, ShowAlert: true})
+	}
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	if game.Scenario != nil {
+		// Sides go by index: their names may not fit in the callback data
+		for idx, side := range game.Scenario.Sides {
+			label := fmt.Sprintf(msgs.Game.EndGameWinnerButton, side.Name)
+			rows = append(rows, markup.Row(markup.Data(label, tgutil.UniqueEndGameWinner, fmt.Sprintf("%s|%d", gameIDStr, idx))))
+		}
+	}
+	rows = append(rows, markup.Row(
+		markup.Data(msgs.Game.EndGameConfirmButton, tgutil.UniqueEndGameConfirm, gameIDStr),
+		markup.Data(msgs.Game.PhasePanelBackButton, tgutil.UniquePhasePanelBack, gameIDStr),
+	))
+	markup.Inline(rows...)
+	_ = c.Respond()
+	return c.Edit(msgs.Game.EndGameConfirmPrompt, markup)
+}
+
+// HandlePhasePanelBack restores the panel after the end-game confirmation was declined
+func HandlePhasePanelBack(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameEntity.GameID(gameIDStr)})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	editPhasePanel(h, c, game, msgs)
+	return c.Respond()
+}
+
+// PreparePhasePanelMessage renders the moderator panel: the current phase, every assignment and the allowed transitions
+func PreparePhasePanelMessage(game *gameEntity.Game, players []*sharedEntity.User, msgs *messages.Messages) (string, []interface{}, error) {
+	roomName := ""
+	if game.Room != nil {
+		roomName = game.Room.Name
+	}
+
+	var lines []string
+	for _, p := range players {
+		if p == nil {
+			continue
+		}
+		role, assigned := game.Assignments[p.ID]
+		if !assigned {
+			continue
+		}
+		status := game.Status(p.ID)
+		if status.Alive {
+			lines = append(lines, fmt.Sprintf(msgs.Game.PhasePanelAliveEntry, p.GetProfileLink(), common.EscapeMarkdownV2(role.Name)))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(msgs.Game.PhasePanelDeadEntry,
+			p.GetProfileLink(),
+			common.EscapeMarkdownV2(role.Name),
+			common.EscapeMarkdownV2(DeathCauseLabel(status.Cause, msgs)),
+			common.EscapeMarkdownV2(phaseLabelOf(status.DiedIn, msgs)),
+		))
+	}
+
+	text := fmt.Sprintf(msgs.Game.PhasePanelMessage,
+		common.EscapeMarkdownV2(roomName),
+		common.EscapeMarkdownV2(PhaseLabel(game, msgs)),
+		strings.Join(lines, "\n"),
+	)
+	if game.HasWinner() {
+		text += fmt.Sprintf(msgs.Game.PhasePanelWinners, common.EscapeMarkdownV2(strings.Join(game.Winners, "، ")))
+	}
+	if game.Vote.IsOpen() {
+		text += fmt.Sprintf(msgs.Game.PhasePanelVoteOpen, len(game.Vote.Ballots), len(game.AlivePlayers()))
+	}
+	text += phasePanelTurnsText(game, players, msgs)
+
+	markup := &telebot.ReplyMarkup{}
+	gameID := string(game.ID)
+	var buttons []telebot.Btn
+	for _, next := range game.AllowedTransitions() {
+		switch next {
+		case gameEntity.PhaseNight:
+			buttons = append(buttons, markup.Data(msgs.Game.StartNightButton, tgutil.UniquePhaseStartNight, gameID))
+		case gameEntity.PhaseDay:
+			buttons = append(buttons, markup.Data(msgs.Game.StartDayButton, tgutil.UniquePhaseStartDay, gameID))
+		case gameEntity.PhaseVoting:
+			buttons = append(buttons, markup.Data(msgs.Game.StartVotingButton, tgutil.UniquePhaseStartVoting, gameID))
+		case gameEntity.PhaseDefense:
+			buttons = append(buttons, markup.Data(msgs.Game.StartDefenseButton, tgutil.UniquePhaseStartDefense, gameID))
+		}
+	}
+	var rows []telebot.Row
+	if len(buttons) > 0 {
+		rows = append(rows, markup.Row(buttons...))
+	}
+	if game.Vote.IsOpen() {
+		rows = append(rows, markup.Row(markup.Data(msgs.Game.CloseVoteButton, tgutil.UniqueCloseVote, gameID)))
+	} else if game.Phase.Type == gameEntity.PhaseVoting && game.State != gameEntity.GameStateFinished {
+		rows = append(rows, markup.Row(markup.Data(msgs.Game.OpenVoteButton, tgutil.UniqueOpenVoteSelect, gameID)))
+	}
+	if game.Timer.IsRunning() {
+		rows = append(rows, markup.Row(markup.Data(msgs.Game.StopTimerButton, tgutil.UniqueStopTimer, gameID)))
+	} else if game.AutoAdvancePhase() != gameEntity.PhaseNone && game.State != gameEntity.GameStateFinished {
+		rows = append(rows, markup.Row(markup.Data(msgs.Game.TimerButton, tgutil.UniqueTimerSelect, gameID)))
+	}
+	rows = append(rows, phasePanelTurnsRows(game, players, markup, msgs)...)
+	if game.Phase.Type == gameEntity.PhaseNight && game.State != gameEntity.GameStateFinished {
+		nightRow := []telebot.Btn{markup.Data(msgs.Game.NightSummaryButton, tgutil.UniqueNightSummary, gameID)}
+		if game.ResolvedNight < game.Phase.Number {
+			nightRow = append(nightRow, markup.Data(msgs.Game.ResolveNightButton, tgutil.UniqueResolveNight, gameID))
+		}
+		rows = append(rows, markup.Row(nightRow...))
+	}
+	if game.State != gameEntity.GameStateFinished {
+		statusRow := []telebot.Btn{markup.Data(msgs.Game.EliminateButton, tgutil.UniqueEliminateSelect, gameID)}
+		if len(game.AlivePlayers()) < len(game.Assignments) {
+			statusRow = append(statusRow, markup.Data(msgs.Game.ReviveButton, tgutil.UniqueReviveSelect, gameID))
+		}
+		rows = append(rows, markup.Row(statusRow...))
+		rows = append(rows, markup.Row(markup.Data(msgs.Game.EndGameButton, tgutil.UniqueEndGame, gameID)))
+	}
+	markup.Inline(rows...)
+
+	return text, []interface{}{markup, telebot.ModeMarkdownV2, telebot.NoPreview}, nil
+}
+
+// PhaseLabel renders the current phase of a game for players, e.g. "🌙 شب 2"
+func PhaseLabel(game *gameEntity.Game, msgs *messages.Messages) string {
+	if game.State == gameEntity.GameStateFinished {
+		return msgs.Game.PhaseFinished
+	}
+	return phaseLabelOf(game.Phase, msgs)
+}
+
+// phaseLabelOf renders a phase regardless of the game state
+func phaseLabelOf(phase gameEntity.Phase, msgs *messages.Messages) string {
+	switch phase.Type {
+	case gameEntity.PhaseNight:
+		return fmt.Sprintf(msgs.Game.PhaseNight, phase.Number)
+	case gameEntity.PhaseDay:
+		return fmt.Sprintf(msgs.Game.PhaseDay, phase.Number)
+	case gameEntity.PhaseVoting:
+		return fmt.Sprintf(msgs.Game.PhaseVoting, phase.Number)
+	case gameEntity.PhaseDefense:
+		return fmt.Sprintf(msgs.Game.PhaseDefense, phase.Number)
+	default:
+		return msgs.Game.PhaseNotStarted
+	}
+}
+
+// AnnounceToRoom sends a public game announcement to every player and spectator of the game's room.
+// Spectators only ever get these; private role data goes to players alone.
+func AnnounceToRoom(h BotHandlerInterface, game *gameEntity.Game, text string) {
+	for _, p := range roomAudience(h, game) {
+		if _, err := h.Bot().Send(&telebot.User{ID: int64(p.ID)}, text); err != nil {
+			log.Printf("AnnounceToRoom: failed to notify user %d: %v", p.ID, err)
+		}
+	}
+}
+
+// roomAudience returns the players and spectators of the game's room
+func roomAudience(h BotHandlerInterface, game *gameEntity.Game) []*sharedEntity.User {
+	if game.Room == nil {
+		return nil
+	}
+	players, err := h.GetPlayersInRoomHandler().Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: game.Room.ID})
+	if err != nil {
+		log.Printf("AnnounceToRoom: failed to fetch players of room %s: %v", game.Room.ID, err)
+		return nil
+	}
+	var audience []*sharedEntity.User
+	for _, p := range append(players, game.Room.Spectators...) {
+		if p != nil {
+			audience = append(audience, p)
+		}
+	}
+	return audience
+}
+
+// OpenPhasePanelMarkup is the button that hands a game with assigned roles over to the phase panel
+func OpenPhasePanelMarkup(gameID gameEntity.GameID, msgs *messages.Messages) *telebot.ReplyMarkup {
+	markup := &telebot.ReplyMarkup{}
+	markup.Inline(markup.Row(markup.Data(msgs.Game.OpenPhasePanelButton, tgutil.UniquePhasePanelOpen, string(gameID))))
+	return markup
+}
+
+// sendPhasePanel sends a fresh panel to the requester and registers it for refreshes
+func sendPhasePanel(h BotHandlerInterface, c telebot.Context, requester sharedEntity.User, gameID gameEntity.GameID, msgs *messages.Messages) error {
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Game.AssignRolesErrorGameFind, gameID, err))
+	}
+	if !canModerateGame(game, requester) {
+		return c.Send(msgs.Common.ErrorPermissionDenied)
+	}
+
+	book := h.GetOrCreatePhasePanel(gameID)
+	text, opts, err := book.GetMessage(int64(requester.ID), string(gameID))
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err))
+	}
+	sent, err := h.Bot().Send(c.Sender(), text, opts...)
+	if err != nil {
+		log.Printf("PhasePanel: failed to send panel of game %s: %v", gameID, err)
+		return err
+	}
+	book.AddActiveMessage(sent.Chat.ID, &tgutil.RefreshingMessage{
+		ChatID:    sent.Chat.ID,
+		MessageID: sent.ID,
+		Data:      string(gameID),
+	})
+	return nil
+}
+
+// editPhasePanel redraws the panel in the message the callback came from
+func editPhasePanel(h BotHandlerInterface, c telebot.Context, game *gameEntity.Game, msgs *messages.Messages) {
+	book := h.GetOrCreatePhasePanel(game.ID)
+	text, opts, err := book.GetMessage(c.Sender().ID, string(game.ID))
+	if err != nil {
+		log.Printf("PhasePanel: failed to prepare panel of game %s: %v", game.ID, err)
+		return
+	}
+	if err := c.Edit(text, opts...); err != nil {
+		log.Printf("PhasePanel: failed to redraw panel of game %s: %v", game.ID, err)
+	}
+}
+
+// canModerateGame reports whether the user is a global admin or the moderator of the game's room
+func canModerateGame(game *gameEntity.Game, user sharedEntity.User) bool {
+	if user.Admin {
+		return true
+	}
+	return game.Room != nil && game.Room.Moderator != nil && game.Room.Moderator.ID == user.ID
+}
@@ -0,0 +1,239 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	roomQuery "telemafia/internal/domain/room/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// HandleEliminateSelect replaces the panel with the list of alive players
+func HandleEliminateSelect(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	game, players, err := loadGameWithPlayers(h, gameEntity.GameID(gameIDStr))
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	for _, p := range players {
+		if !game.IsAlive(p.ID) {
+			continue
+		}
+		payload := fmt.Sprintf("%s|%d", game.ID, p.ID)
+		rows = append(rows, markup.Row(markup.Data(playerButtonText(p), tgutil.UniqueEliminateCause, payload)))
+	}
+	if len(rows) == 0 {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.NoAlivePlayers, ShowAlert: true})
+	}
+	rows = append(rows, markup.Row(markup.Data(msgs.Game.PhasePanelBackButton, tgutil.UniquePhasePanelBack, gameIDStr)))
+	markup.Inline(rows...)
+
+	_ = c.Respond()
+	return c.Edit(msgs.Game.EliminateSelectPrompt, markup)
+}
+
+// HandleEliminateCause asks how the selected player left the game
+func HandleEliminateCause(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	gameID, playerID, ok := parseGamePlayer(data)
+	if !ok {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+	_, players, err := loadGameWithPlayers(h, gameID)
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+
+	markup := &telebot.ReplyMarkup{}
+	causeButton := func(cause gameEntity.DeathCause) telebot.Btn {
+		return markup.Data(DeathCauseLabel(cause, msgs), tgutil.UniqueEliminateConfirm, fmt.Sprintf("%s|%d|%s", gameID, playerID, cause))
+	}
+	markup.Inline(
+		markup.Row(causeButton(gameEntity.DeathCauseKilled), causeButton(gameEntity.DeathCauseLynched), causeButton(gameEntity.DeathCauseRemoved)),
+		markup.Row(markup.Data(msgs.Game.PhasePanelBackButton, tgutil.UniquePhasePanelBack, string(gameID))),
+	)
+
+	_ = c.Respond()
+	return c.Edit(fmt.Sprintf(msgs.Game.EliminateCausePrompt, playerName(players, playerID)), markup)
+}
+
+// HandleEliminateConfirm records the death, announces it to the room and restores the panel
+func HandleEliminateConfirm(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	rest, cause := splitLast(data)
+	gameID, playerID, ok := parseGamePlayer(rest)
+	if !ok || cause == "" {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+
+	game, err := h.EliminatePlayerHandler().Handle(context.Background(), gameCommand.EliminatePlayerCommand{
+		Requester: *requester,
+		GameID:    gameID,
+		PlayerID:  playerID,
+		Cause:     gameEntity.DeathCause(cause),
+	})
+	if err != nil {
+		log.Printf("EliminateConfirm: failed for player %d in game %s: %v", playerID, gameID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.PlayerStatusError, err), ShowAlert: true})
+	}
+
+	players := roomPlayers(h, game)
+	name := playerName(players, playerID)
+	announcement := fmt.Sprintf(msgs.Game.EliminationAnnouncement, name, DeathCauseLabel(gameEntity.DeathCause(cause), msgs))
+	if identity, revealed := game.RevealedIdentity(playerID); revealed {
+		announcement = fmt.Sprintf(msgs.Game.EliminationRevealedAnnouncement, name, DeathCauseLabel(gameEntity.DeathCause(cause), msgs), identity)
+	}
+	AnnounceToRoom(h, game, announcement)
+	if game.State == gameEntity.GameStateFinished {
+		AnnounceGameOver(h, game, msgs)
+	}
+
+	afterPlayerStatusChange(h, c, game, msgs)
+	return c.Respond()
+}
+
+// HandleReviveSelect replaces the panel with the list of dead players
+func HandleReviveSelect(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	game, players, err := loadGameWithPlayers(h, gameEntity.GameID(gameIDStr))
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	for _, p := range players {
+		if _, assigned := game.Assignments[p.ID]; !assigned || game.Status(p.ID).Alive {
+			continue
+		}
+		payload := fmt.Sprintf("%s|%d", game.ID, p.ID)
+		rows = append(rows, markup.Row(markup.Data(playerButtonText(p), tgutil.UniqueReviveConfirm, payload)))
+	}
+	if len(rows) == 0 {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.NoDeadPlayers, ShowAlert: true})
+	}
+	rows = append(rows, markup.Row(markup.Data(msgs.Game.PhasePanelBackButton, tgutil.UniquePhasePanelBack, gameIDStr)))
+	markup.Inline(rows...)
+
+	_ = c.Respond()
+	return c.Edit(msgs.Game.ReviveSelectPrompt, markup)
+}
+
+// HandleReviveConfirm brings the selected player back and restores the panel
+func HandleReviveConfirm(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameID, playerID, ok := parseGamePlayer(data)
+	if !ok {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+
+	game, err := h.RevivePlayerHandler().Handle(context.Background(), gameCommand.RevivePlayerCommand{
+		Requester: *requester,
+		GameID:    gameID,
+		PlayerID:  playerID,
+	})
+	if err != nil {
+		log.Printf("ReviveConfirm: failed for player %d in game %s: %v", playerID, gameID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.PlayerStatusError, err), ShowAlert: true})
+	}
+
+	AnnounceToRoom(h, game, fmt.Sprintf(msgs.Game.ReviveAnnouncement, playerName(roomPlayers(h, game), playerID)))
+	afterPlayerStatusChange(h, c, game, msgs)
+	return c.Respond()
+}
+
+// DeathCauseLabel renders a death cause for players
+func DeathCauseLabel(cause gameEntity.DeathCause, msgs *messages.Messages) string {
+	switch cause {
+	case gameEntity.DeathCauseKilled:
+		return msgs.Game.DeathCauseKilled
+	case gameEntity.DeathCauseLynched:
+		return msgs.Game.DeathCauseLynched
+	default:
+		return msgs.Game.DeathCauseRemoved
+	}
+}
+
+// afterPlayerStatusChange redraws the panel in place and refreshes every view that lists the players
+func afterPlayerStatusChange(h BotHandlerInterface, c telebot.Context, game *gameEntity.Game, msgs *messages.Messages) {
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	h.RaiseRoomDetailRefresh()
+}
+
+// loadGameWithPlayers fetches a game and the current players of its room
+func loadGameWithPlayers(h BotHandlerInterface, gameID gameEntity.GameID) (*gameEntity.Game, []*sharedEntity.User, error) {
+	game, err := h.GetGameByIDHandler().Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return game, roomPlayers(h, game), nil
+}
+
+// roomPlayers returns the players of the game's room, or none if they cannot be fetched
+func roomPlayers(h BotHandlerInterface, game *gameEntity.Game) []*sharedEntity.User {
+	if game.Room == nil {
+		return nil
+	}
+	players, err := h.GetPlayersInRoomHandler().Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: game.Room.ID})
+	if err != nil {
+		log.Printf("Failed to fetch players of room %s: %v", game.Room.ID, err)
+		return nil
+	}
+	return players
+}
+
+// playerName returns the first name of a player, falling back to the ID
+func playerName(players []*sharedEntity.User, id sharedEntity.UserID) string {
+	for _, p := range players {
+		if p != nil && p.ID == id {
+			return playerButtonText(p)
+		}
+	}
+	return strconv.FormatInt(int64(id), 10)
+}
+
+func playerButtonText(p *sharedEntity.User) string {
+	if p.FirstName != "" {
+		return p.FirstName
+	}
+	if p.Username != "" {
+		return "@" + p.Username
+	}
+	return strconv.FormatInt(int64(p.ID), 10)
+}
+
+// parseGamePlayer splits "gameID|userID"
+func parseGamePlayer(data string) (gameEntity.GameID, sharedEntity.UserID, bool) {
+	gameIDStr, userIDStr := splitLast(data)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if gameIDStr == "" || err != nil {
+		return "", 0, false
+	}
+	return gameEntity.GameID(gameIDStr), sharedEntity.UserID(userID), true
+}
+
+// splitLast splits data at its last '|'
+func splitLast(data string) (string, string) {
+	i := strings.LastIndex(data, "|")
+	if i < 0 {
+		return data, ""
+	}
+	return data[:i], data[i+1:]
+}
@@ -0,0 +1,269 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	roomEntity "telemafia/internal/domain/room/entity"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// readyCheckTimeouts are the deadlines offered to the moderator when starting a ready-check
+var readyCheckTimeouts = []time.Duration{1 * time.Minute, 2 * time.Minute, 5 * time.Minute}
+
+// HandleReadyCheckSelect replaces the game confirmation with the ready-check timeouts
+func HandleReadyCheckSelect(c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	markup := &telebot.ReplyMarkup{}
+	row := []telebot.Btn{markup.Data(msgs.Game.ReadyCheckNoTimeoutButton, tgutil.UniqueStartReadyCheck, gameIDStr+"|0")}
+	for _, d := range readyCheckTimeouts {
+		row = append(row, markup.Data(
+			fmt.Sprintf(msgs.Game.ReadyCheckTimeoutButton, int(d/time.Minute)),
+			tgutil.UniqueStartReadyCheck,
+			fmt.Sprintf("%s|%d", gameIDStr, int(d/time.Second)),
+		))
+	}
+	markup.Inline(
+		markup.Row(row...),
+		markup.Row(markup.Data(msgs.Game.CreateGameCancelButton, tgutil.UniqueCancelGame, gameIDStr)),
+	)
+	_ = c.Respond()
+	return c.Edit(msgs.Game.ReadyCheckTimeoutPrompt, markup)
+}
+
+// HandleStartReadyCheck sends every player the ready button and turns the moderator's message into the live roster
+func HandleStartReadyCheck(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameIDStr, secondsStr := splitLast(data)
+	seconds, err := strconv.Atoi(secondsStr)
+	if gameIDStr == "" || err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+
+	game, err := h.StartReadyCheckHandler().Handle(context.Background(), gameCommand.StartReadyCheckCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+		Timeout:   time.Duration(seconds) * time.Second,
+	})
+	if err != nil {
+		log.Printf("StartReadyCheck: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.ReadyCheckError, err), ShowAlert: true})
+	}
+
+	// A restarted check starts from a clean slate
+	h.DeleteReadyCheckBook(game.ID)
+	book := h.GetOrCreateReadyCheckBook(game.ID)
+	for _, userID := range game.ReadyCheck.Players {
+		sendReadyPrompt(h, book, game.ID, int64(userID))
+	}
+
+	text, opts, err := book.GetMessage(c.Sender().ID, string(game.ID))
+	if err != nil {
+		log.Printf("StartReadyCheck: failed to prepare roster of game %s: %v", game.ID, err)
+		return c.Respond()
+	}
+	if _, err := h.Bot().Edit(c.Message(), text, opts...); err != nil {
+		log.Printf("StartReadyCheck: failed to show roster of game %s: %v", game.ID, err)
+	} else {
+		book.AddActiveMessage(c.Sender().ID, &tgutil.RefreshingMessage{ChatID: c.Message().Chat.ID, MessageID: c.Message().ID, Data: string(game.ID)})
+	}
+	return c.Respond()
+}
+
+// HandleMarkReady records a player's confirmation
+func HandleMarkReady(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.MarkReadyHandler().Handle(context.Background(), gameCommand.MarkReadyCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("MarkReady: failed for user %d in game %s: %v", requester.ID, gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.ReadyCheckError, err), ShowAlert: true})
+	}
+	h.GetOrCreateReadyCheckBook(game.ID).RaiseRefreshNeeded()
+	return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.ReadyCheckMarked})
+}
+
+// HandleKickUnready drops the players who did not confirm from the room
+func HandleKickUnready(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, drop, err := h.KickUnreadyPlayersHandler().Handle(context.Background(), gameCommand.KickUnreadyPlayersCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("KickUnready: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.ReadyCheckError, err), ShowAlert: true})
+	}
+	FinishReadyCheckDrop(h, game, drop, msgs)
+	return c.Respond()
+}
+
+// FinishReadyCheckDrop tells the dropped players they left the room, asks the waitlisted users who
+// took their seats to confirm, and redraws the roster
+func FinishReadyCheckDrop(h BotHandlerInterface, game *gameEntity.Game, drop gameCommand.ReadyCheckDrop, msgs *messages.Messages) {
+	book := h.GetOrCreateReadyCheckBook(game.ID)
+	roomName := ""
+	if game.Room != nil {
+		roomName = game.Room.Name
+	}
+	for _, userID := range drop.Kicked {
+		book.RemoveActiveMessage(int64(userID))
+		if _, err := h.Bot().Send(&telebot.User{ID: int64(userID)}, fmt.Sprintf(msgs.Game.ReadyCheckKickedNotice, roomName)); err != nil {
+			log.Printf("ReadyCheck: failed to notify dropped user %d: %v", userID, err)
+		}
+	}
+	for _, user := range drop.Promoted {
+		if _, err := h.Bot().Send(&telebot.User{ID: int64(user.ID)}, fmt.Sprintf(msgs.Room.WaitlistPromoted, roomName)); err != nil {
+			log.Printf("ReadyCheck: failed to notify promoted user %d: %v", user.ID, err)
+		}
+		sendReadyPrompt(h, book, game.ID, int64(user.ID))
+	}
+	if len(drop.Kicked) > 0 || len(drop.Promoted) > 0 {
+		h.RaiseRoomDetailRefresh()
+	}
+	book.RaiseRefreshNeeded()
+}
+
+// FollowReadyCheckSeats updates the ready-check of a room's game after players took or gave up seats:
+// newcomers get the ready button, players who left have their button taken away, and the roster is redrawn
+func FollowReadyCheckSeats(h BotHandlerInterface, roomID roomEntity.RoomID, seated, unseated []sharedEntity.UserID, msgs *messages.Messages) {
+	game, err := h.SyncReadyCheckHandler().Handle(context.Background(), gameCommand.SyncReadyCheckCommand{
+		RoomID:   roomID,
+		Seated:   seated,
+		Unseated: unseated,
+	})
+	if err != nil {
+		log.Printf("ReadyCheck: failed to follow seat changes of room %s: %v", roomID, err)
+		return
+	}
+	if game == nil {
+		return
+	}
+	book := h.GetOrCreateReadyCheckBook(game.ID)
+	roomName := ""
+	if game.Room != nil {
+		roomName = game.Room.Name
+	}
+	for _, userID := range unseated {
+		if prompt, ok := book.GetActiveMessage(int64(userID)); ok {
+			book.RemoveActiveMessage(int64(userID))
+			closed := &telebot.Message{ID: prompt.MessageID, Chat: &telebot.Chat{ID: prompt.ChatID}}
+			if _, err := h.Bot().Edit(closed, fmt.Sprintf(msgs.Game.ReadyCheckLeftSeat, roomName), &telebot.ReplyMarkup{}); err != nil {
+				log.Printf("ReadyCheck: failed to close ready prompt of user %d: %v", userID, err)
+			}
+		}
+	}
+	for _, userID := range seated {
+		if _, ok := book.GetActiveMessage(int64(userID)); !ok {
+			sendReadyPrompt(h, book, game.ID, int64(userID))
+		}
+	}
+	book.RaiseRefreshNeeded()
+}
+
+// sendReadyPrompt sends a player the ready button and tracks it in the ready-check book
+func sendReadyPrompt(h BotHandlerInterface, book *tgutil.RefreshingMessageBook, gameID gameEntity.GameID, chatID int64) {
+	text, opts, err := book.GetMessage(chatID, string(gameID))
+	if err != nil {
+		log.Printf("ReadyCheck: failed to prepare ready prompt of game %s: %v", gameID, err)
+		return
+	}
+	sent, err := h.Bot().Send(&telebot.User{ID: chatID}, text, opts...)
+	if err != nil {
+		log.Printf("ReadyCheck: failed to send ready prompt to user %d: %v", chatID, err)
+		return
+	}
+	book.AddActiveMessage(chatID, &tgutil.RefreshingMessage{ChatID: chatID, MessageID: sent.ID, Data: string(gameID)})
+}
+
+// PrepareReadyCheckMessage renders the ready-check: players asked see their ready button,
+// anyone else (the moderator) sees the roster of the room with the next steps
+func PrepareReadyCheckMessage(game *gameEntity.Game, players []*sharedEntity.User, viewer sharedEntity.UserID, now time.Time, msgs *messages.Messages) (string, []interface{}, error) {
+	check := game.ReadyCheck
+	markup := &telebot.ReplyMarkup{}
+	if check == nil || game.State != gameEntity.GameStateWaitingForPlayers {
+		markup.Inline()
+		return msgs.Game.ReadyCheckClosed, []interface{}{markup}, nil
+	}
+	roomName := ""
+	if game.Room != nil {
+		roomName = game.Room.Name
+	}
+	countdown := ""
+	if check.IsTimed() {
+		countdown = fmt.Sprintf(msgs.Game.ReadyCheckCountdownLine, formatCountdown(check.Remaining(now)))
+	}
+
+	if containsUser(check.Players, viewer) {
+		if check.IsReady(viewer) {
+			markup.Inline()
+			return fmt.Sprintf(msgs.Game.ReadyCheckPlayerReady, roomName) + countdown, []interface{}{markup}, nil
+		}
+		markup.Inline(markup.Row(markup.Data(msgs.Game.ReadyButton, tgutil.UniqueMarkReady, string(game.ID))))
+		return fmt.Sprintf(msgs.Game.ReadyCheckPlayerPrompt, roomName) + countdown, []interface{}{markup}, nil
+	}
+
+	// The roster follows the room, so players who joined after the check show up as not ready
+	lines := make([]string, 0, len(players))
+	var seated []sharedEntity.UserID
+	ready := 0
+	for _, p := range players {
+		if p == nil {
+			continue
+		}
+		seated = append(seated, p.ID)
+		format := msgs.Game.ReadyCheckEntryWaiting
+		if check.IsReady(p.ID) {
+			format = msgs.Game.ReadyCheckEntryReady
+			ready++
+		}
+		lines = append(lines, fmt.Sprintf(format, playerButtonText(p)))
+	}
+	text := fmt.Sprintf(msgs.Game.ReadyCheckRoster, roomName, ready, len(seated), strings.Join(lines, "\n"))
+	allReady := len(seated) > 0 && game.RequireReady(seated) == nil
+	switch {
+	case allReady:
+		text += msgs.Game.ReadyCheckAllReadyLine
+	case check.TimedOut:
+		text += msgs.Game.ReadyCheckTimedOutLine
+	default:
+		text += countdown
+	}
+
+	gameIDStr := string(game.ID)
+	var rows []telebot.Row
+	if allReady {
+		rows = append(rows, markup.Row(
+			markup.Data(msgs.Game.CreateGameStartButton, tgutil.UniqueStartGame, gameIDStr),
+			markup.Data(msgs.Game.ChooseCardButton, tgutil.UniqueChooseCardStart, gameIDStr),
+		))
+	} else if len(check.Waiting()) > 0 {
+		rows = append(rows, markup.Row(markup.Data(msgs.Game.ReadyCheckKickButton, tgutil.UniqueKickUnready, gameIDStr)))
+	}
+	rows = append(rows, markup.Row(
+		markup.Data(msgs.Game.ReadyCheckRestartButton, tgutil.UniqueReadyCheckSelect, gameIDStr),
+		markup.Data(msgs.Game.CreateGameCancelButton, tgutil.UniqueCancelGame, gameIDStr),
+	))
+	markup.Inline(rows...)
+	return text, []interface{}{markup}, nil
+}
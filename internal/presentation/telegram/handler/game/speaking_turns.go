@@ -0,0 +1,238 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	messages "telemafia/internal/presentation/telegram/messages"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// HandleStartTurns starts the talk order from the moderator panel and sends it to every player of the room
+func HandleStartTurns(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.StartSpeakingTurnsHandler().Handle(context.Background(), gameCommand.StartSpeakingTurnsCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("StartTurns: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.TurnsError, err), ShowAlert: true})
+	}
+
+	book := h.GetOrCreateTurnsBook(game.ID)
+	for _, p := range roomPlayers(h, game) {
+		if p == nil {
+			continue
+		}
+		chatID := int64(p.ID)
+		text, opts, err := book.GetMessage(chatID, string(game.ID))
+		if err != nil {
+			log.Printf("StartTurns: failed to prepare talk order of game %s: %v", game.ID, err)
+			continue
+		}
+		sent, err := h.Bot().Send(&telebot.User{ID: chatID}, text, opts...)
+		if err != nil {
+			log.Printf("StartTurns: failed to send talk order to user %d: %v", chatID, err)
+			continue
+		}
+		book.AddActiveMessage(chatID, &tgutil.RefreshingMessage{ChatID: chatID, MessageID: sent.ID, Data: string(game.ID)})
+	}
+	notifySpeaker(h, game, msgs)
+
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond()
+}
+
+// HandleNextSpeaker ends the current turn from the moderator panel; data is "gameID|1" to skip the speaker
+func HandleNextSpeaker(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameIDStr, skipStr := splitLast(data)
+	if gameIDStr == "" {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+	game, err := h.NextSpeakerHandler().Handle(context.Background(), gameCommand.NextSpeakerCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+		Skip:      skipStr == "1",
+	})
+	if err != nil {
+		log.Printf("NextSpeaker: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.TurnsError, err), ShowAlert: true})
+	}
+
+	if game.Turns.IsRunning() {
+		notifySpeaker(h, game, msgs)
+	} else {
+		AnnounceToRoom(h, game, fmt.Sprintf(msgs.Game.TurnsFinishedAnnouncement, PhaseLabel(game, msgs)))
+	}
+	h.GetOrCreateTurnsBook(game.ID).RaiseRefreshNeeded()
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond()
+}
+
+// HandleRequestChallenge records a player's request for a challenge from the current speaker
+func HandleRequestChallenge(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.RequestChallengeHandler().Handle(context.Background(), gameCommand.RequestChallengeCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("RequestChallenge: user %d failed in game %s: %v", requester.ID, gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.TurnsError, err), ShowAlert: true})
+	}
+	h.GetOrCreateTurnsBook(game.ID).RaiseRefreshNeeded()
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond(&telebot.CallbackResponse{Text: msgs.Game.TurnsChallengeRequested})
+}
+
+// HandleGrantChallenge grants the challenge of the current turn; data is "gameID|userID"
+func HandleGrantChallenge(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameIDStr, userIDStr := splitLast(data)
+	challenger, err := strconv.ParseInt(userIDStr, 10, 64)
+	if gameIDStr == "" || err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+	game, err := h.GrantChallengeHandler().Handle(context.Background(), gameCommand.GrantChallengeCommand{
+		Requester:  *requester,
+		GameID:     gameEntity.GameID(gameIDStr),
+		Challenger: sharedEntity.UserID(challenger),
+	})
+	if err != nil {
+		log.Printf("GrantChallenge: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.TurnsError, err), ShowAlert: true})
+	}
+
+	if speaker, ok := game.Turns.Speaker(); ok {
+		text := fmt.Sprintf(msgs.Game.TurnsChallengeGranted, playerName(roomPlayers(h, game), speaker))
+		if _, err := h.Bot().Send(&telebot.User{ID: challenger}, text); err != nil {
+			log.Printf("GrantChallenge: failed to notify user %d: %v", challenger, err)
+		}
+	}
+	h.GetOrCreateTurnsBook(game.ID).RaiseRefreshNeeded()
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond()
+}
+
+// PrepareTurnsMessage renders the talk order for one player, with a challenge button while someone else speaks
+func PrepareTurnsMessage(game *gameEntity.Game, players []*sharedEntity.User, viewer sharedEntity.UserID, msgs *messages.Messages) (string, []interface{}, error) {
+	turns := game.Turns
+	markup := &telebot.ReplyMarkup{}
+	if turns == nil {
+		// The phase moved on; drop the button
+		markup.Inline()
+		return msgs.Game.TurnsMessageClosed, []interface{}{markup}, nil
+	}
+
+	speaker, running := turns.Speaker()
+	lines := make([]string, 0, len(turns.Order))
+	for i, userID := range turns.Order {
+		format := msgs.Game.TurnsEntryWaiting
+		switch {
+		case running && userID == speaker:
+			format = msgs.Game.TurnsEntryCurrent
+		case turns.WasSkipped(userID):
+			format = msgs.Game.TurnsEntrySkipped
+		case turns.HasSpoken(userID):
+			format = msgs.Game.TurnsEntrySpoken
+		}
+		lines = append(lines, fmt.Sprintf(format, i+1, playerName(players, userID)))
+	}
+	text := fmt.Sprintf(msgs.Game.TurnsMessage, phaseLabelOf(turns.Phase, msgs), strings.Join(lines, "\n"))
+	if turns.Challenger != 0 {
+		text += fmt.Sprintf(msgs.Game.TurnsChallengerLine, playerName(players, turns.Challenger))
+	}
+	if !running {
+		text += msgs.Game.TurnsMessageOver
+	}
+
+	if running && viewer != speaker && game.IsAlive(viewer) && turns.Challenger == 0 && !turns.HasRequested(viewer) {
+		markup.Inline(markup.Row(markup.Data(msgs.Game.RequestChallengeButton, tgutil.UniqueRequestChallenge, string(game.ID))))
+	} else {
+		markup.Inline()
+	}
+	return text, []interface{}{markup}, nil
+}
+
+// phasePanelTurnsText renders the speaking turns for the moderator panel (MarkdownV2)
+func phasePanelTurnsText(game *gameEntity.Game, players []*sharedEntity.User, msgs *messages.Messages) string {
+	turns := game.Turns
+	if turns == nil {
+		return ""
+	}
+	speaker, ok := turns.Speaker()
+	if !ok {
+		return msgs.Game.PhasePanelTurnsOver
+	}
+	text := fmt.Sprintf(msgs.Game.PhasePanelSpeaker, common.EscapeMarkdownV2(playerName(players, speaker)))
+	if turns.Challenger != 0 {
+		text += fmt.Sprintf(msgs.Game.PhasePanelChallenger, common.EscapeMarkdownV2(playerName(players, turns.Challenger)))
+	}
+	if len(turns.Requests) > 0 {
+		text += fmt.Sprintf(msgs.Game.PhasePanelChallengeRequests, common.EscapeMarkdownV2(playerNames(players, turns.Requests)))
+	}
+	return text
+}
+
+// phasePanelTurnsRows returns the speaking turn buttons of the moderator panel
+func phasePanelTurnsRows(game *gameEntity.Game, players []*sharedEntity.User, markup *telebot.ReplyMarkup, msgs *messages.Messages) []telebot.Row {
+	if game.State == gameEntity.GameStateFinished {
+		return nil
+	}
+	gameID := string(game.ID)
+	if !game.Turns.IsRunning() {
+		if game.Phase.Type != gameEntity.PhaseDay && game.Phase.Type != gameEntity.PhaseDefense {
+			return nil
+		}
+		return []telebot.Row{markup.Row(markup.Data(msgs.Game.StartTurnsButton, tgutil.UniqueStartTurns, gameID))}
+	}
+	rows := []telebot.Row{markup.Row(
+		markup.Data(msgs.Game.NextSpeakerButton, tgutil.UniqueNextSpeaker, gameID+"|0"),
+		markup.Data(msgs.Game.SkipSpeakerButton, tgutil.UniqueNextSpeaker, gameID+"|1"),
+	)}
+	if game.Turns.Challenger == 0 {
+		for _, userID := range game.Turns.Requests {
+			label := fmt.Sprintf(msgs.Game.GrantChallengeButton, playerName(players, userID))
+			rows = append(rows, markup.Row(markup.Data(label, tgutil.UniqueGrantChallenge, fmt.Sprintf("%s|%d", gameID, userID))))
+		}
+	}
+	return rows
+}
+
+// notifySpeaker tells the current speaker that it is their turn
+func notifySpeaker(h BotHandlerInterface, game *gameEntity.Game, msgs *messages.Messages) {
+	speaker, ok := game.Turns.Speaker()
+	if !ok {
+		return
+	}
+	text := fmt.Sprintf(msgs.Game.TurnsYourTurn, PhaseLabel(game, msgs))
+	if _, err := h.Bot().Send(&telebot.User{ID: int64(speaker)}, text); err != nil {
+		log.Printf("SpeakingTurns: failed to notify speaker %d: %v", speaker, err)
+	}
+}
@@ -0,0 +1,155 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	messages "telemafia/internal/presentation/telegram/messages"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// timerDurations are the lengths offered to the moderator when starting a phase timer
+var timerDurations = []time.Duration{1 * time.Minute, 2 * time.Minute, 3 * time.Minute, 5 * time.Minute}
+
+// HandleTimerSelect replaces the panel with the timer durations, with and without auto-advance
+func HandleTimerSelect(c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	for _, d := range timerDurations {
+		seconds := int(d / time.Second)
+		minutes := int(d / time.Minute)
+		rows = append(rows, markup.Row(
+			markup.Data(fmt.Sprintf(msgs.Game.TimerDurationButton, minutes), tgutil.UniqueStartTimer, fmt.Sprintf("%s|%d|0", gameIDStr, seconds)),
+			markup.Data(fmt.Sprintf(msgs.Game.TimerAutoDurationButton, minutes), tgutil.UniqueStartTimer, fmt.Sprintf("%s|%d|1", gameIDStr, seconds)),
+		))
+	}
+	rows = append(rows, markup.Row(markup.Data(msgs.Game.PhasePanelBackButton, tgutil.UniquePhasePanelBack, gameIDStr)))
+	markup.Inline(rows...)
+	_ = c.Respond()
+	return c.Edit(msgs.Game.TimerDurationPrompt, markup)
+}
+
+// HandleStartTimer starts the timer and sends the countdown to every player of the room and to the moderator
+func HandleStartTimer(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	rest, autoStr := splitLast(data)
+	gameIDStr, secondsStr := splitLast(rest)
+	seconds, err := strconv.Atoi(secondsStr)
+	if gameIDStr == "" || err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+
+	game, err := h.StartTimerHandler().Handle(context.Background(), gameCommand.StartTimerCommand{
+		Requester:   *requester,
+		GameID:      gameEntity.GameID(gameIDStr),
+		Duration:    time.Duration(seconds) * time.Second,
+		AutoAdvance: autoStr == "1",
+	})
+	if err != nil {
+		log.Printf("StartTimer: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.TimerError, err), ShowAlert: true})
+	}
+
+	book := h.GetOrCreateTimerBook(game.ID)
+	recipients := map[int64]bool{c.Sender().ID: true}
+	for _, p := range roomPlayers(h, game) {
+		if p != nil {
+			recipients[int64(p.ID)] = true
+		}
+	}
+	for chatID := range recipients {
+		text, opts, err := book.GetMessage(chatID, string(game.ID))
+		if err != nil {
+			log.Printf("StartTimer: failed to prepare countdown of game %s: %v", game.ID, err)
+			continue
+		}
+		sent, err := h.Bot().Send(&telebot.User{ID: chatID}, text, opts...)
+		if err != nil {
+			log.Printf("StartTimer: failed to send countdown to user %d: %v", chatID, err)
+			continue
+		}
+		book.AddActiveMessage(chatID, &tgutil.RefreshingMessage{ChatID: chatID, MessageID: sent.ID, Data: string(game.ID)})
+	}
+
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond()
+}
+
+// HandleStopTimer cancels the timer from the moderator panel
+func HandleStopTimer(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, err := h.StopTimerHandler().Handle(context.Background(), gameCommand.StopTimerCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("StopTimer: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.TimerError, err), ShowAlert: true})
+	}
+	AnnounceToRoom(h, game, fmt.Sprintf(msgs.Game.TimerStoppedAnnouncement, PhaseLabel(game, msgs)))
+	h.GetOrCreateTimerBook(game.ID).RaiseRefreshNeeded()
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond()
+}
+
+// FinishTimerTick announces what a timer tick brought: a warning, the end of the time,
+// and the new phase when the timer moved the game on
+func FinishTimerTick(h BotHandlerInterface, game *gameEntity.Game, timedPhase gameEntity.Phase, tick gameEntity.TimerTick, msgs *messages.Messages) {
+	label := phaseLabelOf(timedPhase, msgs)
+	if tick.Warning > 0 {
+		AnnounceToRoom(h, game, fmt.Sprintf(msgs.Game.TimerWarningAnnouncement, int(tick.Warning/time.Second), label))
+	}
+	if !tick.Expired {
+		return
+	}
+	AnnounceToRoom(h, game, fmt.Sprintf(msgs.Game.TimerExpiredAnnouncement, label))
+	h.GetOrCreateTimerBook(game.ID).RaiseRefreshNeeded()
+	if !tick.Advanced {
+		h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+		return
+	}
+	var moderatorChatID int64
+	if game.Room != nil && game.Room.Moderator != nil {
+		moderatorChatID = int64(game.Room.Moderator.ID)
+	}
+	AfterPhaseChange(h, game, moderatorChatID, msgs)
+}
+
+// PrepareTimerMessage renders the countdown of a game's phase timer
+func PrepareTimerMessage(game *gameEntity.Game, now time.Time, msgs *messages.Messages) (string, []interface{}, error) {
+	timer := game.Timer
+	if timer == nil {
+		return msgs.Game.TimerMessageStopped, nil, nil
+	}
+	label := phaseLabelOf(timer.Phase, msgs)
+	if !timer.IsRunning() {
+		return fmt.Sprintf(msgs.Game.TimerMessageExpired, label), nil, nil
+	}
+	remaining := formatCountdown(timer.Remaining(now))
+	if timer.AutoAdvance {
+		next := phaseLabelOf(gameEntity.Phase{Type: game.AutoAdvancePhase(), Number: timer.Phase.Number}, msgs)
+		return fmt.Sprintf(msgs.Game.TimerMessageAuto, label, remaining, next), nil, nil
+	}
+	return fmt.Sprintf(msgs.Game.TimerMessage, label, remaining), nil, nil
+}
+
+// formatCountdown renders a duration as m:ss, rounding up so the countdown reaches 0:00 only at the deadline
+func formatCountdown(d time.Duration) string {
+	seconds := int((d + time.Second - 1) / time.Second)
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
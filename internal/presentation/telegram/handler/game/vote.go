@@ -0,0 +1,246 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// voteDurations are the lengths offered to the moderator when opening a vote
+var voteDurations = []time.Duration{30 * time.Second, 60 * time.Second, 90 * time.Second, 120 * time.Second}
+
+// HandleOpenVoteSelect replaces the panel with the vote durations to choose from
+func HandleOpenVoteSelect(c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	markup := &telebot.ReplyMarkup{}
+	var buttons []telebot.Btn
+	for _, d := range voteDurations {
+		seconds := int(d / time.Second)
+		buttons = append(buttons, markup.Data(fmt.Sprintf(msgs.Game.VoteDurationButton, seconds), tgutil.UniqueOpenVote, fmt.Sprintf("%s|%d", gameIDStr, seconds)))
+	}
+	markup.Inline(
+		markup.Row(buttons...),
+		markup.Row(markup.Data(msgs.Game.PhasePanelBackButton, tgutil.UniquePhasePanelBack, gameIDStr)),
+	)
+	_ = c.Respond()
+	return c.Edit(msgs.Game.VoteDurationPrompt, markup)
+}
+
+// HandleOpenVote opens the vote and sends the ballot to every player of the room and to the moderator
+func HandleOpenVote(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameIDStr, secondsStr := splitLast(data)
+	seconds, err := strconv.Atoi(secondsStr)
+	if gameIDStr == "" || err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+
+	game, err := h.OpenVoteHandler().Handle(context.Background(), gameCommand.OpenVoteCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+		Duration:  time.Duration(seconds) * time.Second,
+	})
+	if err != nil {
+		log.Printf("OpenVote: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.VoteError, err), ShowAlert: true})
+	}
+
+	book := h.GetOrCreateVoteBook(game.ID)
+	recipients := map[int64]bool{c.Sender().ID: true}
+	for _, p := range roomPlayers(h, game) {
+		if p != nil {
+			recipients[int64(p.ID)] = true
+		}
+	}
+	for chatID := range recipients {
+		text, opts, err := book.GetMessage(chatID, string(game.ID))
+		if err != nil {
+			log.Printf("OpenVote: failed to prepare ballot of game %s: %v", game.ID, err)
+			continue
+		}
+		sent, err := h.Bot().Send(&telebot.User{ID: chatID}, text, opts...)
+		if err != nil {
+			log.Printf("OpenVote: failed to send ballot to user %d: %v", chatID, err)
+			continue
+		}
+		book.AddActiveMessage(chatID, &tgutil.RefreshingMessage{ChatID: chatID, MessageID: sent.ID, Data: string(game.ID)})
+	}
+
+	editPhasePanel(h, c, game, msgs)
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	return c.Respond()
+}
+
+// HandleCastVote records the ballot of the player who pressed a candidate button
+func HandleCastVote(h BotHandlerInterface, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	gameID, targetID, ok := parseGamePlayer(data)
+	if !ok {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackInvalidData, ShowAlert: true})
+	}
+
+	game, err := h.CastVoteHandler().Handle(context.Background(), gameCommand.CastVoteCommand{
+		Requester: *requester,
+		GameID:    gameID,
+		TargetID:  targetID,
+	})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.VoteError, err), ShowAlert: true})
+	}
+
+	// Track the ballot this button belongs to, so it keeps refreshing even if it was sent before a restart
+	book := h.GetOrCreateVoteBook(gameID)
+	book.AddActiveMessage(c.Chat().ID, &tgutil.RefreshingMessage{ChatID: c.Chat().ID, MessageID: c.Message().ID, Data: string(gameID)})
+	book.RaiseRefreshNeeded()
+	h.GetOrCreatePhasePanel(gameID).RaiseRefreshNeeded()
+
+	return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.VoteCastConfirmation, playerName(roomPlayers(h, game), targetID))})
+}
+
+// HandleCloseVote closes the vote early from the moderator panel
+func HandleCloseVote(h BotHandlerInterface, c telebot.Context, gameIDStr string, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	game, vote, err := h.CloseVoteHandler().Handle(context.Background(), gameCommand.CloseVoteCommand{
+		Requester: *requester,
+		GameID:    gameEntity.GameID(gameIDStr),
+	})
+	if err != nil {
+		log.Printf("CloseVote: failed for game %s: %v", gameIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Game.VoteError, err), ShowAlert: true})
+	}
+	FinishVote(h, game, vote, msgs)
+	editPhasePanel(h, c, game, msgs)
+	return c.Respond()
+}
+
+// FinishVote announces the result of a closed vote and refreshes every view that shows it
+func FinishVote(h BotHandlerInterface, game *gameEntity.Game, vote *gameEntity.Vote, msgs *messages.Messages) {
+	players := roomPlayers(h, game)
+	AnnounceToRoom(h, game, VoteResultText(game, vote, players, msgs))
+
+	h.GetOrCreateVoteBook(game.ID).RaiseRefreshNeeded()
+	h.GetOrCreatePhasePanel(game.ID).RaiseRefreshNeeded()
+	if vote.Outcome == gameEntity.VoteOutcomeLynch {
+		h.RaiseRoomDetailRefresh()
+	}
+	if game.State == gameEntity.GameStateFinished {
+		AnnounceGameOver(h, game, msgs)
+	}
+}
+
+// VoteResultText renders the announcement of a closed vote
+func VoteResultText(game *gameEntity.Game, vote *gameEntity.Vote, players []*sharedEntity.User, msgs *messages.Messages) string {
+	switch vote.Outcome {
+	case gameEntity.VoteOutcomeLynch:
+		lynched := vote.Leaders[0]
+		name := playerName(players, lynched)
+		votes := len(vote.Ballots)
+		for _, count := range vote.Tally() {
+			if count.Target == lynched {
+				votes = len(count.Voters)
+			}
+		}
+		if identity, revealed := game.RevealedIdentity(lynched); revealed {
+			return fmt.Sprintf(msgs.Game.VoteResultLynchRevealed, name, votes, identity)
+		}
+		return fmt.Sprintf(msgs.Game.VoteResultLynch, name, votes)
+	case gameEntity.VoteOutcomeRevote:
+		return fmt.Sprintf(msgs.Game.VoteResultRevote, playerNames(players, vote.Leaders))
+	case gameEntity.VoteOutcomeDefense:
+		return fmt.Sprintf(msgs.Game.VoteResultDefense, playerNames(players, vote.Leaders))
+	default:
+		return msgs.Game.VoteResultNoLynch
+	}
+}
+
+// PrepareVoteMessage renders the live tally of the game's vote for one viewer.
+// Alive players get a button per candidate while the vote is open; everyone else only sees the tally.
+func PrepareVoteMessage(game *gameEntity.Game, players []*sharedEntity.User, viewer int64, now time.Time, msgs *messages.Messages) (string, []interface{}, error) {
+	vote := game.Vote
+	if vote == nil {
+		return "", nil, gameEntity.ErrNoOpenVote
+	}
+
+	var lines []string
+	tally := vote.Tally()
+	for _, count := range tally {
+		line := fmt.Sprintf(msgs.Game.VoteTallyEntry, playerName(players, count.Target), len(count.Voters))
+		if len(count.Voters) > 0 {
+			line += fmt.Sprintf(msgs.Game.VoteTallyVoters, playerNames(players, count.Voters))
+		}
+		lines = append(lines, line)
+	}
+	var notVoted []sharedEntity.UserID
+	for _, id := range game.AlivePlayers() {
+		if _, voted := vote.Ballots[id]; !voted {
+			notVoted = append(notVoted, id)
+		}
+	}
+	if len(notVoted) > 0 {
+		lines = append(lines, "", fmt.Sprintf(msgs.Game.VoteNotVoted, playerNames(players, notVoted)))
+	}
+
+	status := msgs.Game.VoteStatusClosed
+	if vote.IsOpen() {
+		remaining := int(math.Ceil(vote.ClosesAt.Sub(now).Seconds()))
+		if remaining < 0 {
+			remaining = 0
+		}
+		status = fmt.Sprintf(msgs.Game.VoteStatusOpen, remaining)
+	}
+	text := fmt.Sprintf(msgs.Game.VoteMessage, vote.Day, vote.Round, status, strings.Join(lines, "\n"))
+
+	// An empty keyboard removes the buttons once the vote is closed
+	markup := &telebot.ReplyMarkup{}
+	voter := sharedEntity.UserID(viewer)
+	var rows []telebot.Row
+	if vote.IsOpen() && game.IsAlive(voter) {
+		votes := make(map[sharedEntity.UserID]int, len(tally))
+		for _, count := range tally {
+			votes[count.Target] = len(count.Voters)
+		}
+		// Buttons keep the candidate order so they do not move while the tally changes
+		for _, target := range vote.Candidates {
+			if target == voter || !game.IsAlive(target) {
+				continue
+			}
+			format := msgs.Game.VoteButton
+			if ballot, voted := vote.Ballots[voter]; voted && ballot == target {
+				format = msgs.Game.VoteButtonSelected
+			}
+			label := fmt.Sprintf(format, playerName(players, target), votes[target])
+			rows = append(rows, markup.Row(markup.Data(label, tgutil.UniqueCastVote, fmt.Sprintf("%s|%d", game.ID, target))))
+		}
+	}
+	markup.Inline(rows...)
+	return text, []interface{}{markup}, nil
+}
+
+// playerNames joins the names of several players
+func playerNames(players []*sharedEntity.User, ids []sharedEntity.UserID) string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, playerName(players, id))
+	}
+	return strings.Join(names, "، ")
+}
@@ -0,0 +1,54 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"log"
+
+	mediaCommand "telemafia/internal/domain/media/usecase/command"
+	mediaQuery "telemafia/internal/domain/media/usecase/query"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+
+	"gopkg.in/telebot.v4"
+)
+
+// RoleImage returns the file to send for the image of a role. Local images are uploaded the first time
+// and their Telegram file ID is cached for this bot; roles without a local image fall back to image_id.
+func (h *BotHandler) RoleImage(role scenarioEntity.Role) (telebot.File, func(*telebot.Message), bool) {
+	noCache := func(*telebot.Message) {}
+	if role.Image == "" {
+		if role.ImageID == "" {
+			return telebot.File{}, nil, false
+		}
+		return telebot.File{FileID: role.ImageID}, noCache, true
+	}
+
+	botID := h.bot.Me.ID
+	resolved, err := h.resolveMediaHandler.Handle(context.Background(), mediaQuery.ResolveMediaQuery{BotID: botID, Path: role.Image})
+	if err != nil {
+		log.Printf("RoleImage: failed to resolve the image of role '%s': %v", role.Name, err)
+		if role.ImageID == "" {
+			return telebot.File{}, nil, false
+		}
+		return telebot.File{FileID: role.ImageID}, noCache, true
+	}
+	if resolved.FileID != "" {
+		return telebot.File{FileID: resolved.FileID}, noCache, true
+	}
+
+	uploaded := func(msg *telebot.Message) {
+		if msg == nil || msg.Photo == nil || msg.Photo.FileID == "" {
+			return
+		}
+		err := h.rememberMediaHandler.Handle(context.Background(), mediaCommand.RememberMediaCommand{
+			BotID:    botID,
+			Path:     resolved.Path,
+			Checksum: resolved.Checksum,
+			FileID:   msg.Photo.FileID,
+		})
+		if err != nil {
+			log.Printf("RoleImage: failed to cache the upload of '%s': %v", resolved.Path, err)
+		}
+	}
+	return telebot.FromReader(bytes.NewReader(resolved.Data)), uploaded, true
+}
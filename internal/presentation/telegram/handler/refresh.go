@@ -1,11 +1,17 @@
 package telegram
 
 import (
+	"context"
 	"log"
 	"strings" // Import messages
 	"telemafia/internal/shared/tgutil"
 	"time"
 
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	game "telemafia/internal/presentation/telegram/handler/game"
+
 	"gopkg.in/telebot.v4"
 )
 
@@ -63,6 +69,45 @@ func (h *BotHandler) StartRefreshTimer() {
 		}
 		h.playerRefreshMutex.RUnlock()
 
+		// --- Game Phase Panel Refresh ---
+		h.phasePanelsMutex.RLock()
+		for gameID, book := range h.phasePanels {
+			if book.ConsumeRefreshNeeded() {
+				log.Printf("Refresh needed for Phase Panel Game ID: %s", gameID)
+				h.RefreshMessages(book)
+			}
+		}
+		h.phasePanelsMutex.RUnlock()
+
+		// --- Night Summary Refresh ---
+		h.nightSummariesMutex.RLock()
+		for gameID, book := range h.nightSummaries {
+			if book.ConsumeRefreshNeeded() {
+				log.Printf("Refresh needed for Night Summary Game ID: %s", gameID)
+				h.RefreshMessages(book)
+			}
+		}
+		h.nightSummariesMutex.RUnlock()
+
+		// --- Speaking Turns Refresh ---
+		h.turnsBooksMutex.RLock()
+		for gameID, book := range h.turnsBooks {
+			if book.ConsumeRefreshNeeded() {
+				log.Printf("Refresh needed for Speaking Turns Game ID: %s", gameID)
+				h.RefreshMessages(book)
+			}
+		}
+		h.turnsBooksMutex.RUnlock()
+
+		// --- Day Vote Refresh ---
+		h.refreshVotes()
+
+		// --- Phase Timer Refresh ---
+		h.refreshTimers()
+
+		// --- Ready-check Refresh ---
+		h.refreshReadyChecks()
+
 		// --- Room List Refresh ---
 		if h.roomListRefreshMessage.ConsumeRefreshNeeded() {
 			h.RefreshMessages(h.roomListRefreshMessage)
@@ -74,3 +119,132 @@ func (h *BotHandler) StartRefreshTimer() {
 		}
 	}
 }
+
+// voteCountdownInterval is how often open ballots are redrawn to keep their countdown current
+const voteCountdownInterval = 10 * time.Second
+
+// refreshVotes closes the votes that ran out of time and keeps the ballots of the others current.
+// A book is refreshed one last time and dropped once its vote is over.
+func (h *BotHandler) refreshVotes() {
+	h.voteBooksMutex.RLock()
+	books := make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook, len(h.voteBooks))
+	for gameID, book := range h.voteBooks {
+		books[gameID] = book
+	}
+	h.voteBooksMutex.RUnlock()
+
+	now := time.Now()
+	for gameID, book := range books {
+		gameData, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
+		if err != nil {
+			log.Printf("Vote refresh: failed to load game %s: %v", gameID, err)
+			h.DeleteVoteBook(gameID)
+			continue
+		}
+
+		if gameData.Vote.Expired(now) {
+			closedGame, vote, err := h.closeVoteHandler.Handle(context.Background(), gameCommand.CloseVoteCommand{GameID: gameID})
+			if err != nil {
+				log.Printf("Vote refresh: failed to close expired vote of game %s: %v", gameID, err)
+				// Closing fails for good once the game is gone, finished or no longer voting; only an open vote is retried
+				current, loadErr := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
+				if loadErr != nil || current.State == gameEntity.GameStateFinished || !current.Vote.IsOpen() {
+					h.DeleteVoteBook(gameID)
+				}
+				continue
+			}
+			game.FinishVote(h, closedGame, vote, h.msgs)
+			gameData = closedGame
+		}
+
+		if !gameData.Vote.IsOpen() {
+			h.RefreshMessages(book)
+			h.DeleteVoteBook(gameID)
+			continue
+		}
+		countdownDue := now.Sub(gameData.Vote.OpenedAt)%voteCountdownInterval < time.Second
+		if book.ConsumeRefreshNeeded() || countdownDue {
+			h.RefreshMessages(book)
+		}
+	}
+}
+
+// timerCountdownInterval is how often countdown messages are redrawn
+const timerCountdownInterval = 10 * time.Second
+
+// refreshTimers ticks the timers of every tracked game: warnings and expiries are announced,
+// and countdowns are redrawn. A book is refreshed one last time and dropped once its timer is over.
+func (h *BotHandler) refreshTimers() {
+	h.timerBooksMutex.RLock()
+	books := make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook, len(h.timerBooks))
+	for gameID, book := range h.timerBooks {
+		books[gameID] = book
+	}
+	h.timerBooksMutex.RUnlock()
+
+	for gameID, book := range books {
+		before, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: gameID})
+		if err != nil {
+			log.Printf("Timer refresh: failed to load game %s: %v", gameID, err)
+			h.DeleteTimerBook(gameID)
+			continue
+		}
+		var timedPhase gameEntity.Phase
+		if before.Timer != nil {
+			timedPhase = before.Timer.Phase
+		}
+
+		gameData, tick, err := h.tickTimerHandler.Handle(context.Background(), gameCommand.TickTimerCommand{GameID: gameID})
+		if err != nil {
+			log.Printf("Timer refresh: failed to tick timer of game %s: %v", gameID, err)
+			continue
+		}
+		game.FinishTimerTick(h, gameData, timedPhase, tick, h.msgs)
+
+		if !gameData.Timer.IsRunning() {
+			h.RefreshMessages(book)
+			h.DeleteTimerBook(gameID)
+			continue
+		}
+		remaining := gameData.Timer.Remaining(time.Now())
+		if book.ConsumeRefreshNeeded() || remaining%timerCountdownInterval < time.Second {
+			h.RefreshMessages(book)
+		}
+	}
+}
+
+// readyCountdownInterval is how often the messages of a timed ready-check are redrawn
+const readyCountdownInterval = 10 * time.Second
+
+// refreshReadyChecks drops the non-responders of ready-checks that ran out of time and keeps the
+// buttons and rosters current. A book is dropped without a redraw once roles are handed out,
+// since the moderator's roster has by then become the game message.
+func (h *BotHandler) refreshReadyChecks() {
+	h.readyBooksMutex.RLock()
+	books := make(map[gameEntity.GameID]*tgutil.RefreshingMessageBook, len(h.readyBooks))
+	for gameID, book := range h.readyBooks {
+		books[gameID] = book
+	}
+	h.readyBooksMutex.RUnlock()
+
+	for gameID, book := range books {
+		gameData, timedOut, drop, err := h.tickReadyCheckHandler.Handle(context.Background(), gameCommand.TickReadyCheckCommand{GameID: gameID})
+		if err != nil {
+			log.Printf("Ready-check refresh: failed to tick ready-check of game %s: %v", gameID, err)
+			h.DeleteReadyCheckBook(gameID)
+			continue
+		}
+		if gameData.ReadyCheck == nil || gameData.State != gameEntity.GameStateWaitingForPlayers {
+			h.DeleteReadyCheckBook(gameID)
+			continue
+		}
+		if timedOut {
+			game.FinishReadyCheckDrop(h, gameData, drop, h.msgs)
+		}
+		check := gameData.ReadyCheck
+		countdownDue := check.IsTimed() && check.Remaining(time.Now())%readyCountdownInterval < time.Second
+		if book.ConsumeRefreshNeeded() || countdownDue {
+			h.RefreshMessages(book)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package telegram
+
+import (
+	"context"
+	"log"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	sharedEntity "telemafia/internal/shared/entity"
+	"telemafia/internal/shared/tgutil"
+)
+
+// SaveInteractiveSelection stores the "Choose Card" state of a game together with the
+// player/admin messages currently tracked for it. The caller must hold state.Mutex.
+func (h *BotHandler) SaveInteractiveSelection(gameID gameEntity.GameID, state *tgutil.InteractiveSelectionState) {
+	selection := &gameEntity.RoleSelection{
+		GameID:        gameID,
+		ShuffledRoles: state.ShuffledRoles,
+		Choices:       make(map[sharedEntity.UserID]gameEntity.RoleChoice, len(state.Selections)),
+	}
+	for userID, s := range state.Selections {
+		selection.Choices[userID] = gameEntity.RoleChoice{ChosenIndex: s.ChosenIndex, Player: s.Player}
+	}
+	if book, ok := h.GetPlayerRoleRefresher(gameID); ok {
+		selection.Messages = append(selection.Messages, trackedMessages(book, gameEntity.RoleSelectionAudiencePlayer)...)
+	}
+	if book, ok := h.GetAdminAssignmentTracker(gameID); ok {
+		selection.Messages = append(selection.Messages, trackedMessages(book, gameEntity.RoleSelectionAudienceAdmin)...)
+	}
+
+	if err := h.saveRoleSelectionHandler.Handle(context.Background(), gameCommand.SaveRoleSelectionCommand{Selection: selection}); err != nil {
+		log.Printf("Failed to save role selection for game %s: %v", gameID, err)
+	}
+}
+
+// RestoreInteractiveSelections rebuilds the in-memory "Choose Card" state and refresh books
+// from storage, so rounds interrupted by a restart keep working.
+func (h *BotHandler) RestoreInteractiveSelections() {
+	selections, err := h.getRoleSelectionsHandler.Handle(context.Background(), gameQuery.GetRoleSelectionsQuery{})
+	if err != nil {
+		log.Printf("Failed to load saved role selections: %v", err)
+		return
+	}
+
+	for _, selection := range selections {
+		game, err := h.getGameByIDHandler.Handle(context.Background(), gameQuery.GetGameByIDQuery{ID: selection.GameID})
+		if err != nil || game == nil || game.State != gameEntity.GameStateRoleSelection {
+			log.Printf("Discarding saved role selection for game %s: game missing or no longer selecting roles (%v)", selection.GameID, err)
+			h.DeleteInteractiveSelectionState(selection.GameID)
+			continue
+		}
+
+		state := &tgutil.InteractiveSelectionState{
+			ShuffledRoles: selection.ShuffledRoles,
+			Selections:    make(map[sharedEntity.UserID]tgutil.PlayerSelection, len(selection.Choices)),
+			TakenIndices:  selection.TakenIndices(),
+		}
+		for userID, choice := range selection.Choices {
+			state.Selections[userID] = tgutil.PlayerSelection{ChosenIndex: choice.ChosenIndex, Player: choice.Player}
+		}
+		h.SetInteractiveSelectionState(selection.GameID, state)
+
+		playerRefresher := h.GetOrCreatePlayerRoleRefresher(selection.GameID)
+		adminRefresher := h.GetOrCreateAdminAssignmentTracker(selection.GameID)
+		for _, msg := range selection.Messages {
+			refreshMsg := &tgutil.RefreshingMessage{ChatID: msg.ChatID, MessageID: msg.MessageID, Data: string(selection.GameID)}
+			switch msg.Audience {
+			case gameEntity.RoleSelectionAudiencePlayer:
+				playerRefresher.AddActiveMessage(msg.ChatID, refreshMsg)
+			case gameEntity.RoleSelectionAudienceAdmin:
+				adminRefresher.AddActiveMessage(msg.ChatID, refreshMsg)
+			}
+		}
+		// Redraw everything once so buttons reflect the restored state
+		playerRefresher.RaiseRefreshNeeded()
+		adminRefresher.RaiseRefreshNeeded()
+		log.Printf("Restored role selection for game %s (%d/%d cards taken)", selection.GameID, len(selection.Choices), len(selection.ShuffledRoles))
+	}
+}
+
+func trackedMessages(book *tgutil.RefreshingMessageBook, audience gameEntity.RoleSelectionAudience) []gameEntity.RoleSelectionMessage {
+	active := book.GetAllActiveMessages()
+	messages := make([]gameEntity.RoleSelectionMessage, 0, len(active))
+	for _, msg := range active {
+		messages = append(messages, gameEntity.RoleSelectionMessage{Audience: audience, ChatID: msg.ChatID, MessageID: msg.MessageID})
+	}
+	return messages
+}
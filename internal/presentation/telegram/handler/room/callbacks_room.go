@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 
 	"gopkg.in/telebot.v4"
 
+	gameQuery "telemafia/internal/domain/game/usecase/query"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomCommand "telemafia/internal/domain/room/usecase/command"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
@@ -26,6 +28,11 @@ type RefreshNotifier interface {
 	GetActiveMessage(chatID int64) (*tgutil.RefreshingMessage, bool)
 }
 
+// SeatWatcher is told who took and who gave up a seat of a room, so an open ready-check follows the room
+type SeatWatcher interface {
+	SeatsChanged(roomID roomEntity.RoomID, seated, unseated []sharedEntity.UserID)
+}
+
 // HandleLeaveRoomSelectCallback shows confirmation for leaving a room
 func HandleLeaveRoomSelectCallback(
 	leaveRoomHandler *roomCommand.LeaveRoomHandler,
@@ -33,6 +40,7 @@ func HandleLeaveRoomSelectCallback(
 	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
 	roomList RefreshNotifier,
 	roomDetail RefreshNotifier,
+	seats SeatWatcher,
 	c telebot.Context,
 	data string,
 	msgs *messages.Messages,
@@ -48,10 +56,13 @@ func HandleLeaveRoomSelectCallback(
 		RoomID:    roomID,
 	}
 
-	if err := leaveRoomHandler.Handle(context.Background(), cmd); err != nil {
+	room, promoted, err := leaveRoomHandler.Handle(context.Background(), cmd)
+	if err != nil {
 		log.Printf("Error leaving room '%s': %v", roomID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
 	}
+	notifyPromoted(c.Bot(), room, promoted, msgs)
+	seats.SeatsChanged(roomID, userIDs(promoted), []sharedEntity.UserID{requester.ID})
 
 	chatID := c.Sender().ID
 	roomDetail.RemoveActiveMessage(chatID)
@@ -73,6 +84,7 @@ func HandleLeaveRoomSelectCallback(
 func HandleLeaveRoomConfirmCallback(
 	leaveRoomHandler *roomCommand.LeaveRoomHandler,
 	refreshNotifier RefreshNotifier,
+	seats SeatWatcher,
 	c telebot.Context,
 	data string,
 	msgs *messages.Messages,
@@ -88,11 +100,14 @@ func HandleLeaveRoomConfirmCallback(
 		RoomID:    roomID,
 	}
 
-	if err := leaveRoomHandler.Handle(context.Background(), cmd); err != nil {
+	room, promoted, err := leaveRoomHandler.Handle(context.Background(), cmd)
+	if err != nil {
 		log.Printf("Error leaving room '%s': %v", roomID, err)
 		_ = c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
 		return c.Edit(msgs.Room.LeaveCallbackEditFail)
 	}
+	notifyPromoted(c.Bot(), room, promoted, msgs)
+	seats.SeatsChanged(roomID, userIDs(promoted), []sharedEntity.UserID{requester.ID})
 
 	refreshNotifier.RaiseRefreshNeeded()
 	_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Room.LeaveCallbackSuccess})
@@ -157,8 +172,11 @@ func HandleJoinRoomCallback(
 	joinRoomHandler *roomCommand.JoinRoomHandler,
 	getRoomsHandler *roomQuery.GetRoomsHandler,
 	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
 	roomList RefreshNotifier,
 	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	seats SeatWatcher,
 	c telebot.Context,
 	data string,
 	msgs *messages.Messages,
@@ -174,10 +192,18 @@ func HandleJoinRoomCallback(
 		RoomID:    roomID,
 	}
 
-	if err := joinRoomHandler.Handle(context.Background(), cmd); err != nil {
+	result, err := joinRoomHandler.Handle(context.Background(), cmd)
+	if errors.Is(err, roomEntity.ErrPasswordRequired) {
+		_ = c.Respond()
+		return sendAdmissionError(getRoomsHandler, prompter, c, roomID, RoomInputJoin, err, msgs)
+	}
+	if err != nil {
 		log.Printf("Error handling join room callback for room '%s': %v", roomID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
 	}
+	if !result.Waitlisted {
+		seats.SeatsChanged(roomID, []sharedEntity.UserID{user.ID}, nil)
+	}
 	chatID := c.Sender().ID
 	roomList.RemoveActiveMessage(chatID)
 	roomDetail.AddActiveMessage(chatID, &tgutil.RefreshingMessage{
@@ -187,17 +213,98 @@ func HandleJoinRoomCallback(
 	})
 	roomList.RaiseRefreshNeeded()
 	roomDetail.RaiseRefreshNeeded()
-	_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Room.JoinSuccess})
-	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersInRoomHandler, msgs, user.ID, data)
+	if result.Waitlisted {
+		_ = c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.JoinWaitlisted, result.Position), ShowAlert: true})
+	} else {
+		_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Room.JoinSuccess})
+	}
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, msgs, user.ID, data)
+	if err != nil {
+		return err
+	}
+	return c.Edit(message, opts...)
+}
+
+// HandleWatchRoomCallback handles the inline watch button: the user follows the room as a spectator
+// and sees its detail view
+func HandleWatchRoomCallback(
+	watchRoomHandler *roomCommand.WatchRoomHandler,
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomList RefreshNotifier,
+	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	roomID := roomEntity.RoomID(data)
+	user := tgutil.ToUser(c.Sender())
+	if user == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyUser, ShowAlert: true})
+	}
+
+	_, err := watchRoomHandler.Handle(context.Background(), roomCommand.WatchRoomCommand{Requester: *user, RoomID: roomID})
+	if errors.Is(err, roomEntity.ErrPasswordRequired) {
+		_ = c.Respond()
+		return sendAdmissionError(getRoomsHandler, prompter, c, roomID, RoomInputWatch, err, msgs)
+	}
+	if err != nil {
+		log.Printf("Error watching room '%s': %v", roomID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	chatID := c.Sender().ID
+	roomList.RemoveActiveMessage(chatID)
+	roomDetail.AddActiveMessage(chatID, &tgutil.RefreshingMessage{
+		MessageID: c.Message().ID,
+		ChatID:    c.Message().Chat.ID,
+		Data:      string(roomID),
+	})
+	roomDetail.RaiseRefreshNeeded()
+	_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Room.WatchSuccess})
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, msgs, user.ID, data)
 	if err != nil {
 		return err
 	}
 	return c.Edit(message, opts...)
 }
 
-// HandleKickUserSelectCallback shows the list of users to kick from a room.
+// HandleWatchRoom watches a room from an invite link. Payload: roomID, or roomID-code for private rooms
+func HandleWatchRoom(
+	watchRoomHandler *roomCommand.WatchRoomHandler,
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomList RefreshNotifier,
+	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	roomIDStr, inviteCode, _ := strings.Cut(data, "-")
+	roomID := roomEntity.RoomID(roomIDStr)
+	user := tgutil.ToUser(c.Sender())
+	if user == nil {
+		return c.Send(msgs.Common.ErrorIdentifyUser)
+	}
+
+	_, err := watchRoomHandler.Handle(context.Background(), roomCommand.WatchRoomCommand{Requester: *user, RoomID: roomID, InviteCode: inviteCode})
+	if err != nil {
+		log.Printf("Error watching room '%s' from an invite link: %v", roomID, err)
+		return sendAdmissionError(getRoomsHandler, prompter, c, roomID, RoomInputWatch, err, msgs)
+	}
+	roomList.RemoveActiveMessage(c.Sender().ID)
+	roomDetail.RaiseRefreshNeeded()
+	_ = c.Send(msgs.Room.WatchSuccess)
+	return sendRoomDetail(getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, roomDetail, c, roomID, msgs)
+}
+
+// HandleKickUserSelectCallback shows the list of users to kick from a room: its players, then those
+// waiting for a seat and those watching.
 func HandleKickUserSelectCallback(
-	getPlayersHandler *roomQuery.GetPlayersInRoomHandler,
+	getRoomHandler *roomQuery.GetRoomHandler,
 	c telebot.Context,
 	roomIDStr string, // Room ID passed as data
 	msgs *messages.Messages,
@@ -208,23 +315,37 @@ func HandleKickUserSelectCallback(
 		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
 	}
 
-	// Fetch players in the room
-	players, err := getPlayersHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: roomID})
+	// Fetch the room for its players, waitlist and spectators
+	room, err := getRoomHandler.Handle(context.Background(), roomQuery.GetRoomQuery{RoomID: roomID})
 	if err != nil {
-		log.Printf("KickUserSelect: Error fetching players for room '%s': %v", roomID, err)
+		log.Printf("KickUserSelect: Error fetching room '%s': %v", roomID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
 	}
 
 	markup := &telebot.ReplyMarkup{}
 	var userRows []telebot.Row
 	playersToKickCount := 0
-
-	for _, player := range players {
+	addUser := func(user *sharedEntity.User, label string) {
 		playersToKickCount++
 		// Create payload: roomID|userIDToKick
-		payload := fmt.Sprintf("%s|%d", roomIDStr, player.ID)
-		btn := markup.Data(player.FirstName, tgutil.UniqueKickUserConfirm, payload)
-		userRows = append(userRows, markup.Row(btn))
+		payload := fmt.Sprintf("%s|%d", roomIDStr, user.ID)
+		userRows = append(userRows, markup.Row(markup.Data(label, tgutil.UniqueKickUserConfirm, payload)))
+	}
+
+	for _, player := range room.Players {
+		if player != nil {
+			addUser(player, player.FirstName)
+		}
+	}
+	for _, user := range room.Waitlist {
+		if user != nil {
+			addUser(user, fmt.Sprintf(msgs.Room.KickWaitlistedButton, user.FirstName))
+		}
+	}
+	for _, user := range room.Spectators {
+		if user != nil && room.WaitlistPosition(user.ID) == 0 {
+			addUser(user, fmt.Sprintf(msgs.Room.KickSpectatorButton, user.FirstName))
+		}
 	}
 
 	if playersToKickCount == 0 {
@@ -250,8 +371,10 @@ func HandleKickUserConfirmCallback(
 	kickUserHandler *roomCommand.KickUserHandler,
 	getRoomsHandler *roomQuery.GetRoomsHandler, // Need these to reconstruct RoomDetailMessage
 	getPlayersHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
 	roomList RefreshNotifier,
 	roomDetail RefreshNotifier,
+	seats SeatWatcher,
 	c telebot.Context,
 	data string, // Payload: roomID|userIDToKick
 	msgs *messages.Messages,
@@ -283,10 +406,13 @@ func HandleKickUserConfirmCallback(
 		RoomID:    roomID,
 		PlayerID:  sharedEntity.UserID(userIDToKick),
 	}
-	if err := kickUserHandler.Handle(context.Background(), kickCmd); err != nil {
+	room, promoted, err := kickUserHandler.Handle(context.Background(), kickCmd)
+	if err != nil {
 		log.Printf("KickUserConfirm: Error kicking user %d from room %s: %v", userIDToKick, roomID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.KickUserCallbackError, err), ShowAlert: true})
 	}
+	notifyPromoted(c.Bot(), room, promoted, msgs)
+	seats.SeatsChanged(roomID, userIDs(promoted), []sharedEntity.UserID{kickCmd.PlayerID})
 
 	// Success - trigger refreshes and edit back to room detail
 	roomList.RaiseRefreshNeeded()
@@ -299,7 +425,7 @@ func HandleKickUserConfirmCallback(
 
 	// Prepare and edit the message back to the standard room detail
 	// Note: We pass requester.Admin which should be true here
-	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, msgs, requester.ID, roomIDStr)
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, getGameByRoomIDHandler, msgs, requester.ID, roomIDStr)
 	if err != nil {
 		log.Printf("KickUserConfirm: Error preparing room detail after kick for room '%s': %v", roomID, err)
 		// Can't easily recover the message here, just log
@@ -362,8 +488,10 @@ func HandleChangeModeratorConfirmCallback(
 	changeModeratorHandler *roomCommand.ChangeModeratorHandler, // Inject the new use case handler
 	getRoomsHandler *roomQuery.GetRoomsHandler,
 	getPlayersHandler *roomQuery.GetPlayersInRoomHandler, // Needed to fetch the user details
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
 	roomList RefreshNotifier,
 	roomDetail RefreshNotifier,
+	seats SeatWatcher,
 	c telebot.Context,
 	data string, // Payload: roomID|userIDToMakeModerator
 	msgs *messages.Messages,
@@ -415,10 +543,18 @@ func HandleChangeModeratorConfirmCallback(
 		RoomID:       roomID,
 		NewModerator: newModeratorUser,
 	}
-	if err := changeModeratorHandler.Handle(context.Background(), changeCmd); err != nil {
+	result, err := changeModeratorHandler.Handle(context.Background(), changeCmd)
+	if err != nil {
 		log.Printf("ChangeModConfirm: Error changing moderator for room %s: %v", roomID, err)
 		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.ChangeModeratorCallbackError, err), ShowAlert: true})
 	}
+	seats.SeatsChanged(roomID, result.Seated, result.Unseated)
+	if result.Position > 0 {
+		// Every seat was taken, so the previous moderator waits for one
+		if _, err := c.Bot().Send(&telebot.User{ID: int64(result.Previous.ID)}, fmt.Sprintf(msgs.Room.JoinWaitlisted, result.Position)); err != nil {
+			log.Printf("ChangeModConfirm: failed to notify previous moderator %d of room %s: %v", result.Previous.ID, roomID, err)
+		}
+	}
 
 	// Success - trigger refreshes and edit back to room detail
 	roomList.RaiseRefreshNeeded()
@@ -429,7 +565,7 @@ func HandleChangeModeratorConfirmCallback(
 	_ = c.Respond(&telebot.CallbackResponse{Text: ackMsg})
 
 	// Prepare and edit the message back to the standard room detail
-	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, msgs, requester.ID, roomIDStr)
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, getGameByRoomIDHandler, msgs, requester.ID, roomIDStr)
 	if err != nil {
 		log.Printf("ChangeModConfirm: Error preparing room detail after change for room '%s': %v", roomID, err)
 		return nil // Can't easily recover message
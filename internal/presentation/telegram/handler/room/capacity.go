@@ -0,0 +1,148 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"gopkg.in/telebot.v4"
+
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	roomQuery "telemafia/internal/domain/room/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	tgutil "telemafia/internal/shared/tgutil"
+)
+
+// Capacities offered by the capacity editor, besides no limit
+const (
+	minCapacityChoice = 5
+	maxCapacityChoice = 20
+	capacityPerRow    = 4
+)
+
+// HandleCapacitySelectCallback replaces the room detail with the capacity choices
+func HandleCapacitySelectCallback(
+	getRoomHandler *roomQuery.GetRoomHandler,
+	c telebot.Context,
+	roomIDStr string, // Room ID passed as data
+	msgs *messages.Messages,
+) error {
+	room, err := getRoomHandler.Handle(context.Background(), roomQuery.GetRoomQuery{RoomID: roomEntity.RoomID(roomIDStr)})
+	if err != nil {
+		log.Printf("CapacitySelect: Error fetching room '%s': %v", roomIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	text, markup := PrepareCapacityEditor(room, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleSetCapacityCallback sets the capacity of a room and returns to its detail. Payload: roomID|max
+func HandleSetCapacityCallback(
+	setRoomCapacityHandler *roomCommand.SetRoomCapacityHandler,
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomList RefreshNotifier,
+	roomDetail RefreshNotifier,
+	seats SeatWatcher,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+
+	parts := strings.Split(data, "|")
+	if len(parts) != 2 {
+		log.Printf("SetCapacity: Invalid payload format: %s", data)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid data"), ShowAlert: true})
+	}
+	maxPlayers, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid capacity"), ShowAlert: true})
+	}
+
+	room, promoted, err := setRoomCapacityHandler.Handle(context.Background(), roomCommand.SetRoomCapacityCommand{
+		Requester:  *requester,
+		RoomID:     roomEntity.RoomID(parts[0]),
+		MaxPlayers: maxPlayers,
+	})
+	if err != nil {
+		log.Printf("SetCapacity: Error setting capacity of room %s: %v", parts[0], err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.CapacityError, err), ShowAlert: true})
+	}
+	notifyPromoted(c.Bot(), room, promoted, msgs)
+	seats.SeatsChanged(room.ID, userIDs(promoted), nil)
+
+	roomList.RaiseRefreshNeeded()
+	roomDetail.RaiseRefreshNeeded()
+	_ = c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.CapacitySet, room.Name, capacityLabel(room.MaxPlayers, msgs))})
+
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, getGameByRoomIDHandler, msgs, requester.ID, parts[0])
+	if err != nil {
+		log.Printf("SetCapacity: Error preparing room detail for room '%s': %v", parts[0], err)
+		return nil
+	}
+	return c.Edit(message, opts...)
+}
+
+// PrepareCapacityEditor renders the capacity choices of a room
+func PrepareCapacityEditor(room *roomEntity.Room, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	markup := &telebot.ReplyMarkup{}
+	roomIDStr := string(room.ID)
+	rows := []telebot.Row{markup.Row(markup.Data(msgs.Room.CapacityUnlimited, tgutil.UniqueSetCapacity, roomIDStr+"|0"))}
+	var row []telebot.Btn
+	for n := minCapacityChoice; n <= maxCapacityChoice; n++ {
+		row = append(row, markup.Data(fmt.Sprintf(msgs.Room.CapacityOption, n), tgutil.UniqueSetCapacity, fmt.Sprintf("%s|%d", roomIDStr, n)))
+		if len(row) == capacityPerRow {
+			rows = append(rows, markup.Row(row...))
+			row = nil
+		}
+	}
+	if len(row) > 0 {
+		rows = append(rows, markup.Row(row...))
+	}
+	// The seat editor's back button already returns to the room detail
+	rows = append(rows, markup.Row(markup.Data(msgs.Room.LeaveCancelButton, tgutil.UniqueSeatsBack, roomIDStr)))
+	markup.Inline(rows...)
+	return fmt.Sprintf(msgs.Room.CapacityPrompt, room.Name, capacityLabel(room.MaxPlayers, msgs)), markup
+}
+
+// capacityLabel renders a capacity for buttons and prompts
+func capacityLabel(maxPlayers int, msgs *messages.Messages) string {
+	if maxPlayers == 0 {
+		return msgs.Room.CapacityUnlimited
+	}
+	return fmt.Sprintf(msgs.Room.CapacityOption, maxPlayers)
+}
+
+// userIDs returns the IDs of users, skipping nil entries
+func userIDs(users []*sharedEntity.User) []sharedEntity.UserID {
+	ids := make([]sharedEntity.UserID, 0, len(users))
+	for _, user := range users {
+		if user != nil {
+			ids = append(ids, user.ID)
+		}
+	}
+	return ids
+}
+
+// notifyPromoted privately tells waitlisted users that they got a seat in the room
+func notifyPromoted(bot telebot.API, room *roomEntity.Room, promoted []*sharedEntity.User, msgs *messages.Messages) {
+	for _, user := range promoted {
+		if user == nil {
+			continue
+		}
+		if _, err := bot.Send(&telebot.User{ID: int64(user.ID)}, fmt.Sprintf(msgs.Room.WaitlistPromoted, room.Name)); err != nil {
+			log.Printf("Waitlist: failed to notify promoted user %d of room %s: %v", user.ID, room.ID, err)
+		}
+	}
+}
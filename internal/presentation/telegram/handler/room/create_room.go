@@ -3,6 +3,7 @@ package telegram
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -36,10 +37,23 @@ func HandleCreateRoom(
 		return c.Send(msgs.Common.ErrorPermissionDenied)
 	}
 
+	// An optional capacity follows the name: /create_room name | max
+	name, maxPlayers := args, 0
+	if i := strings.LastIndex(args, "|"); i >= 0 {
+		name = strings.TrimSpace(args[:i])
+		capacityStr := strings.TrimSpace(args[i+1:])
+		n, err := strconv.Atoi(capacityStr)
+		if err != nil || n <= 0 {
+			return c.Send(fmt.Sprintf(msgs.Room.CreateInvalidCapacity, capacityStr))
+		}
+		maxPlayers = n
+	}
+
 	cmd := roomCommand.CreateRoomCommand{
-		ID:      roomEntity.RoomID(fmt.Sprintf("room_%d", time.Now().UnixNano())), // Generate unique ID
-		Name:    args,
-		Creator: user, // Pass the full User struct
+		ID:         roomEntity.RoomID(fmt.Sprintf("room_%d", time.Now().UnixNano())), // Generate unique ID
+		Name:       name,
+		Creator:    user, // Pass the full User struct
+		MaxPlayers: maxPlayers,
 	}
 
 	createdRoom, err := createRoomHandler.Handle(context.Background(), cmd)
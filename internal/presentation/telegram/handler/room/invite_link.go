@@ -1,34 +1,153 @@
 package telegram
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"strings"
 
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	roomQuery "telemafia/internal/domain/room/usecase/query"
 	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	tgutil "telemafia/internal/shared/tgutil"
 
 	"gopkg.in/telebot.v4"
 )
 
-// HandleGetInviteLinkCallback responds with the room's invite link.
+// Actions of the invite reset button
+const (
+	inviteActionNew    = "new"
+	inviteActionRevoke = "revoke"
+)
+
+// Deep link payload prefixes; the room ID and invite code follow after a '-'
+const (
+	DeepLinkJoinRoom  = "join_room"
+	DeepLinkWatchRoom = "watch_room"
+)
+
+// HandleGetInviteLinkCallback responds with the room's invite links to its players, spectators and moderator,
+// and to admins. Admins and the room's moderator also get buttons to regenerate or revoke it.
 func HandleGetInviteLinkCallback(
 	bot *telebot.Bot, // Need bot instance to get username
+	getRoomHandler *roomQuery.GetRoomHandler,
 	c telebot.Context,
 	data string, // roomID
 	msgs *messages.Messages,
 ) error {
-	roomID := data
-	botUsername := bot.Me.Username
-
-	// Construct the deep link
-	// Format: tg://resolve?domain=YourBotUsername&start=join_room-ROOMID
-	payload := fmt.Sprintf("join_room-%s", roomID)
-	inviteLink := fmt.Sprintf("https://t.me/%s?start=%s", botUsername, payload)
+	room, err := getRoomHandler.Handle(context.Background(), roomQuery.GetRoomQuery{RoomID: roomEntity.RoomID(data)})
+	if err != nil {
+		log.Printf("InviteLink: Error fetching room '%s': %v", data, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+	// The link carries the invite code, which lets anyone into an unlisted or password room
+	if !canSeeInvite(room, requester) {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Room.InviteNotMember, ShowAlert: true})
+	}
 
-	// Respond to the callback privately with the link
-	responseText := fmt.Sprintf(msgs.Room.InviteLinkResponse, inviteLink)
+	responseText, markup := PrepareInviteLinkMessage(bot.Me.Username, room, requester, msgs)
 
 	// Acknowledge the callback first (silently) to remove loading state
 	_ = c.Respond()
 
 	// Send the link as a new message in the chat
-	return c.Send(responseText, &telebot.SendOptions{})
+	opts := []interface{}{&telebot.SendOptions{}}
+	if markup != nil {
+		opts = append(opts, markup)
+	}
+	return c.Send(responseText, opts...)
+}
+
+// HandleResetInviteCallback regenerates or revokes the invite link of a room. Payload: roomID|new or roomID|revoke
+func HandleResetInviteCallback(
+	resetInviteHandler *roomCommand.ResetInviteHandler,
+	bot *telebot.Bot,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+
+	parts := strings.Split(data, "|")
+	if len(parts) != 2 {
+		log.Printf("ResetInvite: Invalid payload format: %s", data)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid data"), ShowAlert: true})
+	}
+	revoke := parts[1] == inviteActionRevoke
+
+	room, err := resetInviteHandler.Handle(context.Background(), roomCommand.ResetInviteCommand{
+		Requester: *requester,
+		RoomID:    roomEntity.RoomID(parts[0]),
+		Revoke:    revoke,
+	})
+	if err != nil {
+		log.Printf("ResetInvite: Error resetting invite of room %s: %v", parts[0], err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.InviteError, err), ShowAlert: true})
+	}
+
+	if revoke {
+		_ = c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.InviteRevoked, room.Name)})
+	} else {
+		_ = c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.InviteRegenerated, room.Name)})
+	}
+	text, markup := PrepareInviteLinkMessage(bot.Me.Username, room, requester, msgs)
+	return c.Edit(text, markup)
+}
+
+// InviteLink returns the deep link that joins a room, or "" when the link of a private room was revoked.
+// Public rooms without an invite code keep the plain link.
+func InviteLink(botUsername string, room *roomEntity.Room) string {
+	return deepLink(botUsername, DeepLinkJoinRoom, room)
+}
+
+// WatchLink returns the deep link that watches a room, or "" when the link of a private room was revoked
+func WatchLink(botUsername string, room *roomEntity.Room) string {
+	return deepLink(botUsername, DeepLinkWatchRoom, room)
+}
+
+func deepLink(botUsername, action string, room *roomEntity.Room) string {
+	// Format: https://t.me/YourBotUsername?start=join_room-ROOMID-CODE
+	payload := fmt.Sprintf("%s-%s", action, room.ID)
+	if room.InviteCode != "" {
+		payload += "-" + room.InviteCode
+	} else if room.EffectiveVisibility() != roomEntity.VisibilityPublic {
+		return ""
+	}
+	return fmt.Sprintf("https://t.me/%s?start=%s", botUsername, payload)
+}
+
+// PrepareInviteLinkMessage renders the invite link of a room, with the reset buttons for admins and its moderator
+func PrepareInviteLinkMessage(botUsername string, room *roomEntity.Room, requester *sharedEntity.User, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	text := msgs.Room.InviteLinkRevoked
+	if link := InviteLink(botUsername, room); link != "" {
+		text = fmt.Sprintf(msgs.Room.InviteLinkResponse, link) + "\n" + fmt.Sprintf(msgs.Room.InviteWatchLink, WatchLink(botUsername, room))
+	}
+
+	isRoomModerator := requester != nil && room.Moderator != nil && room.Moderator.ID == requester.ID
+	if requester == nil || (!requester.Admin && !isRoomModerator) {
+		return text, nil
+	}
+	markup := &telebot.ReplyMarkup{}
+	roomIDStr := string(room.ID)
+	row := markup.Row(markup.Data(msgs.Room.InviteRegenerateButton, tgutil.UniqueResetInvite, roomIDStr+"|"+inviteActionNew))
+	if room.InviteCode != "" {
+		row = append(row, markup.Data(msgs.Room.InviteRevokeButton, tgutil.UniqueResetInvite, roomIDStr+"|"+inviteActionRevoke))
+	}
+	markup.Inline(row)
+	return text, markup
+}
+
+// canSeeInvite reports whether the user plays in, watches or moderates the room, or is an admin
+func canSeeInvite(room *roomEntity.Room, user *sharedEntity.User) bool {
+	isRoomModerator := room.Moderator != nil && room.Moderator.ID == user.ID
+	return user.Admin || isRoomModerator || room.HasPlayer(user.ID) || room.IsSpectator(user.ID)
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomCommand "telemafia/internal/domain/room/usecase/command"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
 	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
 	tgutil "telemafia/internal/shared/tgutil"
 
 	"gopkg.in/telebot.v4"
@@ -15,13 +18,17 @@ import (
 
 // RefreshNotifier is defined in create_room.go (same package)
 
-// HandleJoinRoom handles the /join_room command (now a function)
+// HandleJoinRoom handles the /join_room command and invite links (now a function).
+// Invite links carry the room's invite code after the room ID: roomID-code
 func HandleJoinRoom(
 	joinRoomHandler *roomCommand.JoinRoomHandler,
 	getRoomsHandler *roomQuery.GetRoomsHandler,
 	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
 	roomList RefreshNotifier,
 	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	seats SeatWatcher,
 	c telebot.Context,
 	data string,
 	msgs *messages.Messages,
@@ -29,20 +36,44 @@ func HandleJoinRoom(
 	if data == "" {
 		return c.Send(msgs.Room.JoinPrompt)
 	}
-	roomID := roomEntity.RoomID(data)
+	roomIDStr, inviteCode, _ := strings.Cut(data, "-")
 	user := tgutil.ToUser(c.Sender())
 	if user == nil {
 		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyUser, ShowAlert: true})
 	}
 
 	cmd := roomCommand.JoinRoomCommand{
-		Requester: *user,
-		RoomID:    roomID,
+		Requester:  *user,
+		RoomID:     roomEntity.RoomID(roomIDStr),
+		InviteCode: inviteCode,
 	}
+	return joinRoomInChat(joinRoomHandler, getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, roomList, roomDetail, prompter, seats, c, cmd, msgs)
+}
 
-	if err := joinRoomHandler.Handle(context.Background(), cmd); err != nil {
-		log.Printf("Error handling join room callback for room '%s': %v", roomID, err)
-		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+// joinRoomInChat joins a room from a chat message and sends its detail view
+func joinRoomInChat(
+	joinRoomHandler *roomCommand.JoinRoomHandler,
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomList RefreshNotifier,
+	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	seats SeatWatcher,
+	c telebot.Context,
+	cmd roomCommand.JoinRoomCommand,
+	msgs *messages.Messages,
+) error {
+	roomID := cmd.RoomID
+	result, err := joinRoomHandler.Handle(context.Background(), cmd)
+	if err != nil {
+		log.Printf("Error joining room '%s': %v", roomID, err)
+		return sendAdmissionError(getRoomsHandler, prompter, c, roomID, RoomInputJoin, err, msgs)
+	}
+	if result.Waitlisted {
+		_ = c.Send(fmt.Sprintf(msgs.Room.JoinWaitlisted, result.Position))
+	} else {
+		seats.SeatsChanged(roomID, []sharedEntity.UserID{cmd.Requester.ID}, nil)
 	}
 	chatID := c.Sender().ID
 	listMessage, listExists := roomList.GetActiveMessage(chatID)
@@ -55,7 +86,7 @@ func HandleJoinRoom(
 	})
 	roomList.RaiseRefreshNeeded()
 	roomDetail.RaiseRefreshNeeded()
-	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersInRoomHandler, msgs, user.ID, data)
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, msgs, cmd.Requester.ID, string(roomID))
 	if err != nil {
 		return err
 	}
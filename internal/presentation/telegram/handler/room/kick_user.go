@@ -21,6 +21,7 @@ import (
 func HandleKickUser(
 	kickUserHandler *roomCommand.KickUserHandler,
 	refreshNotifier RefreshNotifier,
+	seats SeatWatcher,
 	c telebot.Context,
 	msgs *messages.Messages,
 ) error {
@@ -47,9 +48,12 @@ func HandleKickUser(
 		PlayerID:  sharedEntity.UserID(userID),
 	}
 
-	if err := kickUserHandler.Handle(context.Background(), cmd); err != nil {
+	room, promoted, err := kickUserHandler.Handle(context.Background(), cmd)
+	if err != nil {
 		return c.Send(fmt.Sprintf(msgs.Room.KickError, userID, roomIDStr, err))
 	}
+	notifyPromoted(c.Bot(), room, promoted, msgs)
+	seats.SeatsChanged(room.ID, userIDs(promoted), []sharedEntity.UserID{cmd.PlayerID})
 
 	refreshNotifier.RaiseRefreshNeeded()
 	return c.Send(fmt.Sprintf(msgs.Room.KickSuccess, userID, roomIDStr))
@@ -8,6 +8,7 @@ import (
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomCommand "telemafia/internal/domain/room/usecase/command"
 	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
 	tgutil "telemafia/internal/shared/tgutil"
 
 	"gopkg.in/telebot.v4"
@@ -19,6 +20,7 @@ import (
 func HandleLeaveRoom(
 	leaveRoomHandler *roomCommand.LeaveRoomHandler,
 	refreshNotifier RefreshNotifier,
+	seats SeatWatcher,
 	c telebot.Context,
 	msgs *messages.Messages,
 ) error {
@@ -35,9 +37,12 @@ func HandleLeaveRoom(
 		RoomID:    roomEntity.RoomID(roomIDStr),
 		Requester: *user,
 	}
-	if err := leaveRoomHandler.Handle(context.Background(), cmd); err != nil {
+	room, promoted, err := leaveRoomHandler.Handle(context.Background(), cmd)
+	if err != nil {
 		return c.Send(fmt.Sprintf(msgs.Room.LeaveError, roomIDStr, err))
 	}
+	notifyPromoted(c.Bot(), room, promoted, msgs)
+	seats.SeatsChanged(room.ID, userIDs(promoted), []sharedEntity.UserID{user.ID})
 
 	refreshNotifier.RaiseRefreshNeeded()
 	return c.Send(fmt.Sprintf(msgs.Room.LeaveSuccess, roomIDStr))
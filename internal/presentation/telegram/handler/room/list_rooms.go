@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	roomEntity "telemafia/internal/domain/room/entity"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
 	messages "telemafia/internal/presentation/telegram/messages"
 	tgutil "telemafia/internal/shared/tgutil"
@@ -27,17 +28,33 @@ func PrepareRoomListMessage(
 	markup := &telebot.ReplyMarkup{}
 	var rows []telebot.Row
 
-	if len(rooms) == 0 {
+	// Unlisted rooms are only reachable through their invite link
+	var listed []*roomEntity.Room
+	for _, room := range rooms {
+		if room.IsListed() {
+			listed = append(listed, room)
+		}
+	}
+
+	if len(listed) == 0 {
 		response.WriteString(msgs.Room.ListNoRooms)
 	} else {
 		response.WriteString(msgs.Room.ListTitle)
-		for _, room := range rooms {
+		for _, room := range listed {
 			players, _ := getPlayersInRoomHandler.Handle(context.Background(), roomQuery.GetPlayersInRoomQuery{RoomID: room.ID})
 			playerCount := len(players)
 
-			btnText := fmt.Sprintf(msgs.Room.JoinButtonText, room.Name, playerCount)
+			name := room.Name
+			if room.EffectiveVisibility() == roomEntity.VisibilityPassword {
+				name = fmt.Sprintf(msgs.Room.ListPasswordMarker, name)
+			}
+			btnText := fmt.Sprintf(msgs.Room.JoinButtonText, name, playerCount)
+			if room.MaxPlayers > 0 {
+				btnText = fmt.Sprintf(msgs.Room.JoinButtonTextCapacity, name, playerCount, room.MaxPlayers)
+			}
 			btnJoin := markup.Data(btnText, tgutil.UniqueJoinRoom, string(room.ID))
-			rows = append(rows, markup.Row(btnJoin))
+			btnWatch := markup.Data(msgs.Room.WatchButton, tgutil.UniqueWatchRoom, string(room.ID))
+			rows = append(rows, markup.Row(btnJoin, btnWatch))
 		}
 	}
 	markup.Inline(rows...)
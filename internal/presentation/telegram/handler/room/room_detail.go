@@ -3,9 +3,13 @@ package telegram
 import (
 	"context"
 	"fmt"
+	"strings"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
 	roomEntity "telemafia/internal/domain/room/entity"
 	roomQuery "telemafia/internal/domain/room/usecase/query"
 	"telemafia/internal/presentation/telegram/messages"
+	"telemafia/internal/shared/common"
 	sharedEntity "telemafia/internal/shared/entity"
 	"telemafia/internal/shared/tgutil"
 
@@ -14,10 +18,11 @@ import (
 
 // RoomDetailMessage generates the content and markup for the room detail view.
 // It shows admin buttons if the viewer (identified by chatID) is a global admin
-// or the moderator of this specific room.
+// or the moderator of this specific room. Players who died in the room's game are struck through.
 func RoomDetailMessage(
 	getRoomsHandler *roomQuery.GetRoomsHandler, // Consider changing to GetRoomByID handler
 	getPlayersHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
 	msgs *messages.Messages,
 	requesterID sharedEntity.UserID, // ID of the user viewing the message
 	roomID string,
@@ -47,10 +52,16 @@ func RoomDetailMessage(
 		return fmt.Sprintf(msgs.Room.RoomNotFound, roomID), nil, nil // Return user-friendly error message
 	}
 
+	// The room's game, if any, tells which players are dead
+	game, err := getGameByRoomIDHandler.Handle(context.Background(), gameQuery.GetGameByRoomIDQuery{RoomID: room.ID})
+	if err != nil {
+		game = nil
+	}
+
 	// Construct player list string
 	playerNames := ""
 	for i, player := range players {
-		playerNames += fmt.Sprintf("%d \\- %s\n", i+1, player.GetProfileLink())
+		playerNames += fmt.Sprintf(msgs.Room.SeatEntry, i+1, playerEntry(player, game, msgs)) + "\n"
 	}
 
 	// Determine if the viewer has admin privileges for this room
@@ -66,6 +77,26 @@ func RoomDetailMessage(
 		room.Name,
 		moderatorLink,
 		playerNames)
+	if room.MaxPlayers > 0 {
+		messageText += fmt.Sprintf(msgs.Room.RoomDetailCapacity, len(players), room.MaxPlayers)
+	}
+	if len(room.Waitlist) > 0 {
+		waitlist := ""
+		for i, user := range room.Waitlist {
+			waitlist += fmt.Sprintf(msgs.Room.WaitlistEntry, i+1, user.GetProfileLink()) + "\n"
+		}
+		messageText += fmt.Sprintf(msgs.Room.RoomDetailWaitlist, waitlist)
+	}
+	if len(room.Spectators) > 0 {
+		spectators := make([]string, 0, len(room.Spectators))
+		for _, user := range room.Spectators {
+			spectators = append(spectators, user.GetProfileLink())
+		}
+		messageText += fmt.Sprintf(msgs.Room.RoomDetailSpectators, strings.Join(spectators, "، "))
+	}
+	if room.EffectiveVisibility() != roomEntity.VisibilityPublic {
+		messageText += fmt.Sprintf(msgs.Room.RoomDetailVisibility, common.EscapeMarkdownV2(visibilityLabel(room.EffectiveVisibility(), msgs)))
+	}
 
 	// Create buttons
 	markup := &telebot.ReplyMarkup{}
@@ -74,8 +105,20 @@ func RoomDetailMessage(
 	leaveButton := markup.Data(msgs.Room.LeaveButton, tgutil.UniqueLeaveRoomSelectRoom, roomID)
 	inviteButton := markup.Data(msgs.Room.InviteLinkButton, tgutil.UniqueGetInviteLink, roomID)
 
-	// Arrange the first row
+	// Arrange the first row; spectators can take a seat, anyone else outside the room can watch
 	firstRow := markup.Row(leaveButton, inviteButton)
+	isModerator := room.Moderator != nil && room.Moderator.ID == requesterID
+	if room.IsSpectator(requesterID) {
+		firstRow = append(firstRow, markup.Data(msgs.Room.TakeSeatButton, tgutil.UniqueJoinRoom, roomID))
+	} else if !room.HasPlayer(requesterID) && !isModerator && room.WaitlistPosition(requesterID) == 0 {
+		firstRow = append(firstRow, markup.Data(msgs.Room.WatchButton, tgutil.UniqueWatchRoom, roomID))
+	}
+	// Players of a running game can see their role again at any time
+	if game != nil && game.State != gameEntity.GameStateFinished {
+		if _, assigned := game.Assignments[requesterID]; assigned {
+			firstRow = append(firstRow, markup.Data(msgs.Game.MyRoleButton, tgutil.UniqueMyRole, string(game.ID)))
+		}
+	}
 
 	// Prepare admin rows (if viewer is room admin)
 	adminRows := []telebot.Row{}
@@ -86,6 +129,12 @@ func RoomDetailMessage(
 		actionRow := markup.Row(kickButton, modButton) // Add buttons to the same row
 		adminRows = append(adminRows, actionRow)
 
+		// Seat Order, Capacity and Visibility Row
+		seatsButton := markup.Data(msgs.Room.SeatsButton, tgutil.UniqueSeatsSelect, roomID)
+		capacityButton := markup.Data(msgs.Room.CapacityButton, tgutil.UniqueCapacitySelect, roomID)
+		visibilityButton := markup.Data(msgs.Room.VisibilityButton, tgutil.UniqueVisibilitySelect, roomID)
+		adminRows = append(adminRows, markup.Row(seatsButton, capacityButton, visibilityButton))
+
 		// Start Game Button Row (Separate Row)
 		startButton := markup.Data(msgs.Game.StartButton, tgutil.UniqueCreateGameSelectRoom, roomID)
 		startRow := markup.Row(startButton)
@@ -104,3 +153,15 @@ func RoomDetailMessage(
 	}
 	return messageText, opts, nil
 }
+
+// playerEntry renders a player of the room, struck through with the revealed identity (if any) when dead
+func playerEntry(player *sharedEntity.User, game *gameEntity.Game, msgs *messages.Messages) string {
+	link := player.GetProfileLink()
+	if game == nil || game.Status(player.ID).Alive {
+		return link
+	}
+	if identity, revealed := game.RevealedIdentity(player.ID); revealed {
+		return fmt.Sprintf(msgs.Game.DeadPlayerRevealedEntry, link, common.EscapeMarkdownV2(identity))
+	}
+	return fmt.Sprintf(msgs.Game.DeadPlayerEntry, link)
+}
@@ -0,0 +1,150 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"gopkg.in/telebot.v4"
+
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	roomQuery "telemafia/internal/domain/room/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	tgutil "telemafia/internal/shared/tgutil"
+)
+
+// HandleSeatsSelectCallback replaces the room detail with the seat editor
+func HandleSeatsSelectCallback(
+	getRoomHandler *roomQuery.GetRoomHandler,
+	c telebot.Context,
+	roomIDStr string, // Room ID passed as data
+	msgs *messages.Messages,
+) error {
+	room, err := getRoomHandler.Handle(context.Background(), roomQuery.GetRoomQuery{RoomID: roomEntity.RoomID(roomIDStr)})
+	if err != nil {
+		log.Printf("SeatsSelect: Error fetching room '%s': %v", roomIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	if len(room.Players) == 0 {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Room.SeatsNoPlayers})
+	}
+	_ = c.Respond()
+	text, markup := PrepareSeatsEditor(room, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleMoveSeatCallback moves a player one seat up or down. Payload: roomID|userID|offset
+func HandleMoveSeatCallback(
+	moveSeatHandler *roomCommand.MoveSeatHandler,
+	roomDetail RefreshNotifier,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+
+	parts := strings.Split(data, "|")
+	if len(parts) != 3 {
+		log.Printf("MoveSeat: Invalid payload format: %s", data)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid data"), ShowAlert: true})
+	}
+	playerID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid user ID"), ShowAlert: true})
+	}
+	offset, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid offset"), ShowAlert: true})
+	}
+
+	room, err := moveSeatHandler.Handle(context.Background(), roomCommand.MoveSeatCommand{
+		Requester: *requester,
+		RoomID:    roomEntity.RoomID(parts[0]),
+		PlayerID:  sharedEntity.UserID(playerID),
+		Offset:    offset,
+	})
+	if err != nil {
+		log.Printf("MoveSeat: Error moving user %d in room %s: %v", playerID, parts[0], err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.SeatsError, err), ShowAlert: true})
+	}
+
+	roomDetail.RaiseRefreshNeeded()
+	_ = c.Respond()
+	text, markup := PrepareSeatsEditor(room, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleShuffleSeatsCallback seats the players of a room in a random order
+func HandleShuffleSeatsCallback(
+	shuffleSeatsHandler *roomCommand.ShuffleSeatsHandler,
+	roomDetail RefreshNotifier,
+	c telebot.Context,
+	roomIDStr string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+
+	room, err := shuffleSeatsHandler.Handle(context.Background(), roomCommand.ShuffleSeatsCommand{
+		Requester: *requester,
+		RoomID:    roomEntity.RoomID(roomIDStr),
+	})
+	if err != nil {
+		log.Printf("ShuffleSeats: Error shuffling room %s: %v", roomIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.SeatsError, err), ShowAlert: true})
+	}
+
+	roomDetail.RaiseRefreshNeeded()
+	_ = c.Respond()
+	text, markup := PrepareSeatsEditor(room, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleSeatsBackCallback puts the room detail back in place of the seat editor
+func HandleSeatsBackCallback(
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	c telebot.Context,
+	roomIDStr string,
+	msgs *messages.Messages,
+) error {
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, getGameByRoomIDHandler, msgs, sharedEntity.UserID(c.Sender().ID), roomIDStr)
+	if err != nil {
+		log.Printf("SeatsBack: Error preparing room detail for room '%s': %v", roomIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	return c.Edit(message, opts...)
+}
+
+// PrepareSeatsEditor renders the seats of a room with up/down buttons for every player
+func PrepareSeatsEditor(room *roomEntity.Room, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	markup := &telebot.ReplyMarkup{}
+	roomIDStr := string(room.ID)
+	var lines []string
+	var rows []telebot.Row
+	for i, player := range room.Players {
+		if player == nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(msgs.Room.SeatsPromptEntry, i+1, player.FirstName))
+		up := markup.Data(fmt.Sprintf(msgs.Room.SeatUpButton, player.FirstName), tgutil.UniqueMoveSeat, fmt.Sprintf("%s|%d|-1", roomIDStr, player.ID))
+		down := markup.Data(fmt.Sprintf(msgs.Room.SeatDownButton, player.FirstName), tgutil.UniqueMoveSeat, fmt.Sprintf("%s|%d|1", roomIDStr, player.ID))
+		rows = append(rows, markup.Row(up, down))
+	}
+	rows = append(rows, markup.Row(markup.Data(msgs.Room.ShuffleSeatsButton, tgutil.UniqueShuffleSeats, roomIDStr)))
+	rows = append(rows, markup.Row(markup.Data(msgs.Room.LeaveCancelButton, tgutil.UniqueSeatsBack, roomIDStr)))
+	markup.Inline(rows...)
+	return fmt.Sprintf(msgs.Room.SeatsPrompt, room.Name, strings.Join(lines, "\n")), markup
+}
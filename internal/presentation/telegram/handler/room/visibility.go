@@ -0,0 +1,265 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"gopkg.in/telebot.v4"
+
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	roomQuery "telemafia/internal/domain/room/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	tgutil "telemafia/internal/shared/tgutil"
+)
+
+// RoomInputAction tells what a private text message asked for by the bot is used for
+type RoomInputAction string
+
+const (
+	RoomInputJoin     RoomInputAction = "join"     // Password to take a seat
+	RoomInputWatch    RoomInputAction = "watch"    // Password to watch
+	RoomInputPassword RoomInputAction = "password" // New password of a password room
+)
+
+// PendingRoomInput is a text message the bot is waiting for from a user
+type PendingRoomInput struct {
+	RoomID roomEntity.RoomID
+	Action RoomInputAction
+}
+
+// RoomInputPrompter remembers which users were asked to type something for a room
+type RoomInputPrompter interface {
+	SetPendingRoomInput(userID int64, input PendingRoomInput)
+}
+
+// HandleVisibilitySelectCallback replaces the room detail with the visibility choices
+func HandleVisibilitySelectCallback(
+	getRoomHandler *roomQuery.GetRoomHandler,
+	c telebot.Context,
+	roomIDStr string, // Room ID passed as data
+	msgs *messages.Messages,
+) error {
+	room, err := getRoomHandler.Handle(context.Background(), roomQuery.GetRoomQuery{RoomID: roomEntity.RoomID(roomIDStr)})
+	if err != nil {
+		log.Printf("VisibilitySelect: Error fetching room '%s': %v", roomIDStr, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	text, markup := PrepareVisibilityEditor(room, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleSetVisibilityCallback sets the visibility of a room and returns to its detail. Password rooms
+// first ask the moderator for the password in private chat. Payload: roomID|visibility
+func HandleSetVisibilityCallback(
+	setRoomVisibilityHandler *roomCommand.SetRoomVisibilityHandler,
+	getRoomHandler *roomQuery.GetRoomHandler,
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomList RefreshNotifier,
+	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorIdentifyRequester, ShowAlert: true})
+	}
+
+	parts := strings.Split(data, "|")
+	if len(parts) != 2 {
+		log.Printf("SetVisibility: Invalid payload format: %s", data)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid data"), ShowAlert: true})
+	}
+	roomID := roomEntity.RoomID(parts[0])
+	visibility := roomEntity.Visibility(parts[1])
+
+	if visibility == roomEntity.VisibilityPassword {
+		room, err := getRoomHandler.Handle(context.Background(), roomQuery.GetRoomQuery{RoomID: roomID})
+		if err != nil {
+			return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+		}
+		isRoomModerator := room.Moderator != nil && room.Moderator.ID == requester.ID
+		if !requester.Admin && !isRoomModerator {
+			return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorPermissionDenied, ShowAlert: true})
+		}
+		prompter.SetPendingRoomInput(c.Sender().ID, PendingRoomInput{RoomID: roomID, Action: RoomInputPassword})
+		_ = c.Respond()
+		return c.Send(fmt.Sprintf(msgs.Room.VisibilityPasswordPrompt, room.Name))
+	}
+
+	room, err := setRoomVisibilityHandler.Handle(context.Background(), roomCommand.SetRoomVisibilityCommand{
+		Requester:  *requester,
+		RoomID:     roomID,
+		Visibility: visibility,
+	})
+	if err != nil {
+		log.Printf("SetVisibility: Error setting visibility of room %s: %v", roomID, err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.VisibilityError, err), ShowAlert: true})
+	}
+
+	roomList.RaiseRefreshNeeded()
+	roomDetail.RaiseRefreshNeeded()
+	_ = c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Room.VisibilitySet, room.Name, visibilityLabel(room.EffectiveVisibility(), msgs))})
+
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersHandler, getGameByRoomIDHandler, msgs, requester.ID, parts[0])
+	if err != nil {
+		log.Printf("SetVisibility: Error preparing room detail for room '%s': %v", parts[0], err)
+		return nil
+	}
+	return c.Edit(message, opts...)
+}
+
+// HandleRoomInputReply uses a private text message the bot asked for: a password to join or watch a room,
+// or the new password of a password room
+func HandleRoomInputReply(
+	joinRoomHandler *roomCommand.JoinRoomHandler,
+	watchRoomHandler *roomCommand.WatchRoomHandler,
+	setRoomVisibilityHandler *roomCommand.SetRoomVisibilityHandler,
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomList RefreshNotifier,
+	roomDetail RefreshNotifier,
+	prompter RoomInputPrompter,
+	seats SeatWatcher,
+	c telebot.Context,
+	input PendingRoomInput,
+	msgs *messages.Messages,
+) error {
+	user := tgutil.ToUser(c.Sender())
+	if user == nil {
+		return c.Send(msgs.Common.ErrorIdentifyUser)
+	}
+	text := strings.TrimSpace(c.Text())
+
+	switch input.Action {
+	case RoomInputJoin:
+		cmd := roomCommand.JoinRoomCommand{Requester: *user, RoomID: input.RoomID, Password: text}
+		return joinRoomInChat(joinRoomHandler, getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, roomList, roomDetail, prompter, seats, c, cmd, msgs)
+
+	case RoomInputWatch:
+		_, err := watchRoomHandler.Handle(context.Background(), roomCommand.WatchRoomCommand{Requester: *user, RoomID: input.RoomID, Password: text})
+		if err != nil {
+			log.Printf("Error watching room '%s' with a password: %v", input.RoomID, err)
+			return sendAdmissionError(getRoomsHandler, prompter, c, input.RoomID, RoomInputWatch, err, msgs)
+		}
+		roomDetail.RaiseRefreshNeeded()
+		_ = c.Send(msgs.Room.WatchSuccess)
+		return sendRoomDetail(getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, roomDetail, c, input.RoomID, msgs)
+
+	case RoomInputPassword:
+		room, err := setRoomVisibilityHandler.Handle(context.Background(), roomCommand.SetRoomVisibilityCommand{
+			Requester:  *user,
+			RoomID:     input.RoomID,
+			Visibility: roomEntity.VisibilityPassword,
+			Password:   text,
+		})
+		if err != nil {
+			log.Printf("Error setting the password of room %s: %v", input.RoomID, err)
+			return c.Send(fmt.Sprintf(msgs.Room.VisibilityError, err))
+		}
+		roomList.RaiseRefreshNeeded()
+		roomDetail.RaiseRefreshNeeded()
+		return c.Send(fmt.Sprintf(msgs.Room.VisibilitySet, room.Name, visibilityLabel(room.EffectiveVisibility(), msgs)))
+	}
+	return nil
+}
+
+// PrepareVisibilityEditor renders the visibility choices of a room
+func PrepareVisibilityEditor(room *roomEntity.Room, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	markup := &telebot.ReplyMarkup{}
+	roomIDStr := string(room.ID)
+	var rows []telebot.Row
+	for _, visibility := range []roomEntity.Visibility{roomEntity.VisibilityPublic, roomEntity.VisibilityUnlisted, roomEntity.VisibilityPassword} {
+		rows = append(rows, markup.Row(markup.Data(visibilityLabel(visibility, msgs), tgutil.UniqueSetVisibility, roomIDStr+"|"+string(visibility))))
+	}
+	// The seat editor's back button already returns to the room detail
+	rows = append(rows, markup.Row(markup.Data(msgs.Room.LeaveCancelButton, tgutil.UniqueSeatsBack, roomIDStr)))
+	markup.Inline(rows...)
+	return fmt.Sprintf(msgs.Room.VisibilityPrompt, room.Name, visibilityLabel(room.EffectiveVisibility(), msgs)), markup
+}
+
+// visibilityLabel renders a visibility for buttons and prompts
+func visibilityLabel(visibility roomEntity.Visibility, msgs *messages.Messages) string {
+	switch visibility {
+	case roomEntity.VisibilityUnlisted:
+		return msgs.Room.VisibilityUnlisted
+	case roomEntity.VisibilityPassword:
+		return msgs.Room.VisibilityPassword
+	default:
+		return msgs.Room.VisibilityPublic
+	}
+}
+
+// sendAdmissionError explains why a user was not let into a room, asking for the password when the room needs one
+func sendAdmissionError(
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	prompter RoomInputPrompter,
+	c telebot.Context,
+	roomID roomEntity.RoomID,
+	action RoomInputAction,
+	err error,
+	msgs *messages.Messages,
+) error {
+	switch {
+	case errors.Is(err, roomEntity.ErrPasswordRequired):
+		prompter.SetPendingRoomInput(c.Sender().ID, PendingRoomInput{RoomID: roomID, Action: action})
+		_, sendErr := c.Bot().Send(c.Sender(), fmt.Sprintf(msgs.Room.PasswordPrompt, roomName(getRoomsHandler, roomID)))
+		return sendErr
+	case errors.Is(err, roomEntity.ErrWrongPassword):
+		_, sendErr := c.Bot().Send(c.Sender(), msgs.Room.PasswordWrong)
+		return sendErr
+	default:
+		_, sendErr := c.Bot().Send(c.Sender(), fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err))
+		return sendErr
+	}
+}
+
+// sendRoomDetail sends the detail view of a room as a new private message and keeps it refreshed
+func sendRoomDetail(
+	getRoomsHandler *roomQuery.GetRoomsHandler,
+	getPlayersInRoomHandler *roomQuery.GetPlayersInRoomHandler,
+	getGameByRoomIDHandler *gameQuery.GetGameByRoomIDHandler,
+	roomDetail RefreshNotifier,
+	c telebot.Context,
+	roomID roomEntity.RoomID,
+	msgs *messages.Messages,
+) error {
+	message, opts, err := RoomDetailMessage(getRoomsHandler, getPlayersInRoomHandler, getGameByRoomIDHandler, msgs, tgutil.ToUser(c.Sender()).ID, string(roomID))
+	if err != nil {
+		return err
+	}
+	msg, err := c.Bot().Send(c.Sender(), message, opts...)
+	if err != nil {
+		return err
+	}
+	roomDetail.AddActiveMessage(c.Sender().ID, &tgutil.RefreshingMessage{
+		MessageID: msg.ID,
+		ChatID:    msg.Chat.ID,
+		Data:      string(roomID),
+	})
+	return nil
+}
+
+// roomName returns the name of a room for prompts, falling back to its ID
+func roomName(getRoomsHandler *roomQuery.GetRoomsHandler, roomID roomEntity.RoomID) string {
+	rooms, err := getRoomsHandler.Handle(context.Background(), roomQuery.GetRoomsQuery{})
+	if err != nil {
+		return string(roomID)
+	}
+	for _, room := range rooms {
+		if room.ID == roomID {
+			return room.Name
+		}
+	}
+	return string(roomID)
+}
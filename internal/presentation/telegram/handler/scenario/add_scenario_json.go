@@ -1,8 +1,6 @@
 package telegram
 
 import (
-	"context"
-	"fmt"
 	"strings"
 
 	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
@@ -15,6 +13,7 @@ import (
 // HandleAddScenarioJSON handles the /add_scenario_json command.
 func HandleAddScenarioJSON(
 	addScenarioJSONHandler *scenarioCommand.AddScenarioJSONHandler,
+	store PendingUploadStore,
 	c telebot.Context,
 	msgs *messages.Messages,
 ) error {
@@ -28,22 +27,5 @@ func HandleAddScenarioJSON(
 		return c.Send(msgs.Common.ErrorPermissionDenied)
 	}
 
-	cmd := scenarioCommand.AddScenarioJSONCommand{
-		Requester: *requester,
-		JSONData:  jsonData,
-	}
-
-	createdScenario, err := addScenarioJSONHandler.Handle(context.Background(), cmd)
-	if err != nil {
-		// Provide specific feedback for JSON errors vs other errors
-		if strings.Contains(err.Error(), "invalid JSON format") {
-			return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONInvalidJSON, err))
-		} else if strings.Contains(err.Error(), "cannot be empty") {
-			return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONValidationError, err))
-		} else {
-			return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONErrorGeneric, err))
-		}
-	}
-
-	return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONSuccess, createdScenario.Name, createdScenario.ID))
+	return SubmitScenarioJSON(addScenarioJSONHandler, store, c, requester, jsonData, msgs)
 }
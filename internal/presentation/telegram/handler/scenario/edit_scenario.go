@@ -0,0 +1,592 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strconv"
+	"strings"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	tgutil "telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// Choices offered by the scenario editor
+var populationRateChoices = []float32{0.2, 0.25, 0.3, 0.33334, 0.4, 0.5}
+
+const (
+	maxAddedAtChoice = 20
+	addedAtPerRow    = 7
+	noChoice         = -1 // Payload for "none" in the population rate and default role choices
+)
+
+// ScenarioInputAction tells what a private text message asked for by the scenario editor is used for
+type ScenarioInputAction string
+
+const (
+	ScenarioInputSideName    ScenarioInputAction = "side_name"    // Name of a new side
+	ScenarioInputSideDefault ScenarioInputAction = "side_default" // Default role of the new side named in Value
+	ScenarioInputRenameSide  ScenarioInputAction = "rename_side"  // New name of Side
+	ScenarioInputRoleName    ScenarioInputAction = "role_name"    // Name of a new role of Side
+	ScenarioInputDefaultRole ScenarioInputAction = "default_role" // Name of the default role of Side
+)
+
+// PendingScenarioInput is a text message the scenario editor is waiting for from an admin
+type PendingScenarioInput struct {
+	ScenarioID string
+	Side       string
+	Action     ScenarioInputAction
+	Value      string
+}
+
+// ScenarioEditor gives the scenario editor its handlers and remembers who was asked to type a name
+type ScenarioEditor interface {
+	GetScenarioByIDHandler() *scenarioQuery.GetScenarioByIDHandler
+	AddSideHandler() *scenarioCommand.AddSideHandler
+	RenameSideHandler() *scenarioCommand.RenameSideHandler
+	RemoveSideHandler() *scenarioCommand.RemoveSideHandler
+	SetPopulationRateHandler() *scenarioCommand.SetPopulationRateHandler
+	SetDefaultRoleHandler() *scenarioCommand.SetDefaultRoleHandler
+	AddRoleHandler() *scenarioCommand.AddRoleHandler
+	RemoveRoleHandler() *scenarioCommand.RemoveRoleHandler
+	SetRoleAddedAtHandler() *scenarioCommand.SetRoleAddedAtHandler
+	SetPendingScenarioInput(userID int64, input PendingScenarioInput)
+}
+
+// errInvalidEditorData reports a malformed editor button payload
+var errInvalidEditorData = errors.New("invalid data")
+
+// errEditorRoleChanged reports a role button drawn before the roles of its side changed
+var errEditorRoleChanged = errors.New("the roles of this side have changed, open the side again")
+
+// HandleEditScenario handles the /edit_scenario command: the editor of the given scenario, or a scenario picker
+func HandleEditScenario(
+	editor ScenarioEditor,
+	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil || !requester.Admin {
+		return c.Send(msgs.Common.ErrorPermissionDenied)
+	}
+
+	scenarioID := strings.TrimSpace(c.Message().Payload)
+	if scenarioID != "" {
+		scenario, err := editor.GetScenarioByIDHandler().Handle(context.Background(), scenarioQuery.GetScenarioByIDQuery{ID: scenarioID})
+		if err != nil {
+			return c.Send(fmt.Sprintf(msgs.Scenario.EditorError, err))
+		}
+		text, markup := PrepareScenarioEditor(scenario, msgs)
+		return c.Send(text, markup)
+	}
+
+	scenarios, err := getAllScenariosHandler.Handle(context.Background(), scenarioQuery.GetAllScenariosQuery{})
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Scenario.EditorError, err))
+	}
+	if len(scenarios) == 0 {
+		return c.Send(msgs.Scenario.EditNoScenarios)
+	}
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	for _, scenario := range scenarios {
+		rows = append(rows, markup.Row(markup.Data(scenario.Name, tgutil.UniqueScenarioEditOpen, scenario.ID)))
+	}
+	rows = append(rows, markup.Row(markup.Data(msgs.Scenario.EditorDoneButton, tgutil.UniqueCancel)))
+	markup.Inline(rows...)
+	return c.Send(msgs.Scenario.EditSelectPrompt, markup)
+}
+
+// HandleEditScenarioOpenCallback shows the sides of a scenario. Payload: scenarioID
+func HandleEditScenarioOpenCallback(editor ScenarioEditor, c telebot.Context, scenarioID string, msgs *messages.Messages) error {
+	if _, ok := editorRequester(c, msgs); !ok {
+		return nil
+	}
+	scenario, err := editor.GetScenarioByIDHandler().Handle(context.Background(), scenarioQuery.GetScenarioByIDQuery{ID: scenarioID})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	text, markup := PrepareScenarioEditor(scenario, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleEditScenarioSideCallback shows the roles and settings of a side. Payload: scenarioID|sideIndex
+func HandleEditScenarioSideCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	if _, ok := editorRequester(c, msgs); !ok {
+		return nil
+	}
+	scenario, sideIdx, _, err := loadEditorSide(editor, data)
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	text, markup := PrepareSideEditor(scenario, sideIdx, msgs)
+	return c.Edit(text, markup)
+}
+
+// HandleEditScenarioAskCallback asks the admin to type a name in private chat. Payload: scenarioID|sideIndex|action,
+// with sideIndex -1 when adding a side
+func HandleEditScenarioAskCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	if _, ok := editorRequester(c, msgs); !ok {
+		return nil
+	}
+	parts := strings.Split(data, "|")
+	if len(parts) != 3 {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, errInvalidEditorData), ShowAlert: true})
+	}
+	input := PendingScenarioInput{ScenarioID: parts[0], Action: ScenarioInputAction(parts[2])}
+	prompt := msgs.Scenario.EditorAskSideName
+	if input.Action != ScenarioInputSideName {
+		scenario, sideIdx, _, err := loadEditorSide(editor, parts[0]+"|"+parts[1])
+		if err != nil {
+			return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+		}
+		input.Side = scenario.Sides[sideIdx].Name
+		switch input.Action {
+		case ScenarioInputRenameSide:
+			prompt = fmt.Sprintf(msgs.Scenario.EditorAskRenameSide, input.Side)
+		case ScenarioInputRoleName:
+			prompt = fmt.Sprintf(msgs.Scenario.EditorAskRoleName, input.Side)
+		case ScenarioInputDefaultRole:
+			prompt = fmt.Sprintf(msgs.Scenario.EditorAskDefaultRole, input.Side)
+		default:
+			return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, errInvalidEditorData), ShowAlert: true})
+		}
+	}
+	editor.SetPendingScenarioInput(c.Sender().ID, input)
+	_ = c.Respond()
+	_, err := c.Bot().Send(c.Sender(), prompt)
+	return err
+}
+
+// HandleScenarioInputReply uses a name the scenario editor asked for and sends the updated editor
+func HandleScenarioInputReply(editor ScenarioEditor, c telebot.Context, input PendingScenarioInput, msgs *messages.Messages) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil {
+		return c.Send(msgs.Common.ErrorIdentifyUser)
+	}
+	text := strings.TrimSpace(c.Text())
+	ctx := context.Background()
+
+	var scenario *scenarioEntity.Scenario
+	var err error
+	side := input.Side
+	switch input.Action {
+	case ScenarioInputSideName:
+		// The new side needs a default role before it is valid, so ask for that next
+		editor.SetPendingScenarioInput(c.Sender().ID, PendingScenarioInput{ScenarioID: input.ScenarioID, Action: ScenarioInputSideDefault, Value: text})
+		return c.Send(fmt.Sprintf(msgs.Scenario.EditorAskSideDefault, text))
+	case ScenarioInputSideDefault:
+		side = input.Value
+		scenario, err = editor.AddSideHandler().Handle(ctx, scenarioCommand.AddSideCommand{Requester: *requester, ScenarioID: input.ScenarioID, Name: input.Value, DefaultRole: text})
+	case ScenarioInputRenameSide:
+		side = text
+		scenario, err = editor.RenameSideHandler().Handle(ctx, scenarioCommand.RenameSideCommand{Requester: *requester, ScenarioID: input.ScenarioID, Side: input.Side, NewName: text})
+	case ScenarioInputRoleName:
+		scenario, err = editor.AddRoleHandler().Handle(ctx, scenarioCommand.AddRoleCommand{Requester: *requester, ScenarioID: input.ScenarioID, Side: input.Side, RoleName: text})
+	case ScenarioInputDefaultRole:
+		scenario, err = editor.SetDefaultRoleHandler().Handle(ctx, scenarioCommand.SetDefaultRoleCommand{Requester: *requester, ScenarioID: input.ScenarioID, Side: input.Side, RoleName: text})
+	default:
+		return nil
+	}
+	if err != nil {
+		log.Printf("ScenarioEditor: %s of scenario %s failed: %v", input.Action, input.ScenarioID, err)
+		return c.Send(fmt.Sprintf(msgs.Scenario.EditorError, err))
+	}
+	view, markup := prepareEditorView(scenario, sideIndex(scenario, side), msgs)
+	return c.Send(view, markup)
+}
+
+// HandleEditScenarioRemoveSideCallback removes a side. Payload: scenarioID|sideIndex
+func HandleEditScenarioRemoveSideCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester, ok := editorRequester(c, msgs)
+	if !ok {
+		return nil
+	}
+	scenario, sideIdx, _, err := loadEditorSide(editor, data)
+	if err == nil {
+		scenario, err = editor.RemoveSideHandler().Handle(context.Background(), scenarioCommand.RemoveSideCommand{
+			Requester:  *requester,
+			ScenarioID: scenario.ID,
+			Side:       scenario.Sides[sideIdx].Name,
+		})
+	}
+	return showEditResult(c, scenario, noChoice, err, msgs)
+}
+
+// HandleEditScenarioRemoveRoleCallback removes a role. Payload: scenarioID|sideIndex|roleIndex|roleTag
+func HandleEditScenarioRemoveRoleCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester, ok := editorRequester(c, msgs)
+	if !ok {
+		return nil
+	}
+	scenario, sideIdx, rest, err := loadEditorSide(editor, data)
+	var roleIdx int
+	if err == nil {
+		roleIdx, err = editorRole(scenario, sideIdx, rest)
+	}
+	if err == nil {
+		scenario, err = editor.RemoveRoleHandler().Handle(context.Background(), scenarioCommand.RemoveRoleCommand{
+			Requester:  *requester,
+			ScenarioID: scenario.ID,
+			Side:       scenario.Sides[sideIdx].Name,
+			RoleIndex:  roleIdx,
+			RoleName:   scenario.Sides[sideIdx].Roles[roleIdx].Name,
+		})
+	}
+	return showEditResult(c, scenario, sideIdx, err, msgs)
+}
+
+// HandleEditScenarioAddedAtSelectCallback shows the added_at choices of a role. Payload: scenarioID|sideIndex|roleIndex|roleTag
+func HandleEditScenarioAddedAtSelectCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	if _, ok := editorRequester(c, msgs); !ok {
+		return nil
+	}
+	scenario, sideIdx, rest, err := loadEditorSide(editor, data)
+	var roleIdx int
+	if err == nil {
+		roleIdx, err = editorRole(scenario, sideIdx, rest)
+	}
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+
+	role := scenario.Sides[sideIdx].Roles[roleIdx]
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	var row []telebot.Btn
+	for n := 0; n <= maxAddedAtChoice; n++ {
+		row = append(row, markup.Data(fmt.Sprintf(msgs.Scenario.EditorAddedAtOption, n), tgutil.UniqueScenarioSetAddedAt, fmt.Sprintf("%s|%d|%d|%s|%d", scenario.ID, sideIdx, roleIdx, roleTag(role.Name), n)))
+		if len(row) == addedAtPerRow {
+			rows = append(rows, markup.Row(row...))
+			row = nil
+		}
+	}
+	if len(row) > 0 {
+		rows = append(rows, markup.Row(row...))
+	}
+	rows = append(rows, markup.Row(sideBackButton(markup, scenario.ID, sideIdx, msgs)))
+	markup.Inline(rows...)
+	return c.Edit(fmt.Sprintf(msgs.Scenario.EditorAddedAtPrompt, role.Name), markup)
+}
+
+// HandleEditScenarioSetAddedAtCallback sets added_at of a role. Payload: scenarioID|sideIndex|roleIndex|roleTag|players
+func HandleEditScenarioSetAddedAtCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester, ok := editorRequester(c, msgs)
+	if !ok {
+		return nil
+	}
+	scenario, sideIdx, rest, err := loadEditorSide(editor, data)
+	var roleIdx, addedAt int
+	if err == nil {
+		roleIdx, err = editorRole(scenario, sideIdx, rest)
+	}
+	if err == nil {
+		addedAt, err = editorIndex(rest, 2)
+	}
+	if err == nil {
+		scenario, err = editor.SetRoleAddedAtHandler().Handle(context.Background(), scenarioCommand.SetRoleAddedAtCommand{
+			Requester:  *requester,
+			ScenarioID: scenario.ID,
+			Side:       scenario.Sides[sideIdx].Name,
+			RoleIndex:  roleIdx,
+			RoleName:   scenario.Sides[sideIdx].Roles[roleIdx].Name,
+			AddedAt:    addedAt,
+		})
+	}
+	return showEditResult(c, scenario, sideIdx, err, msgs)
+}
+
+// HandleEditScenarioRateSelectCallback shows the population rate choices of a side. Payload: scenarioID|sideIndex
+func HandleEditScenarioRateSelectCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	if _, ok := editorRequester(c, msgs); !ok {
+		return nil
+	}
+	scenario, sideIdx, _, err := loadEditorSide(editor, data)
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+
+	markup := &telebot.ReplyMarkup{}
+	prefix := fmt.Sprintf("%s|%d|", scenario.ID, sideIdx)
+	rows := []telebot.Row{markup.Row(markup.Data(msgs.Scenario.EditorRateAutoButton, tgutil.UniqueScenarioSetRate, prefix+strconv.Itoa(noChoice)))}
+	var row []telebot.Btn
+	for i, rate := range populationRateChoices {
+		row = append(row, markup.Data(rateLabel(&rate, msgs), tgutil.UniqueScenarioSetRate, prefix+strconv.Itoa(i)))
+	}
+	rows = append(rows, markup.Row(row[:len(row)/2]...), markup.Row(row[len(row)/2:]...))
+	rows = append(rows, markup.Row(sideBackButton(markup, scenario.ID, sideIdx, msgs)))
+	markup.Inline(rows...)
+	return c.Edit(fmt.Sprintf(msgs.Scenario.EditorRatePrompt, scenario.Sides[sideIdx].Name), markup)
+}
+
+// HandleEditScenarioSetRateCallback sets the population rate of a side. Payload: scenarioID|sideIndex|choice
+func HandleEditScenarioSetRateCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester, ok := editorRequester(c, msgs)
+	if !ok {
+		return nil
+	}
+	scenario, sideIdx, rest, err := loadEditorSide(editor, data)
+	var choice int
+	if err == nil {
+		choice, err = editorIndex(rest, 0)
+	}
+	if err == nil && choice >= len(populationRateChoices) {
+		err = errInvalidEditorData
+	}
+	if err == nil {
+		var rate *float32
+		if choice != noChoice {
+			rate = &populationRateChoices[choice]
+		}
+		scenario, err = editor.SetPopulationRateHandler().Handle(context.Background(), scenarioCommand.SetPopulationRateCommand{
+			Requester:  *requester,
+			ScenarioID: scenario.ID,
+			Side:       scenario.Sides[sideIdx].Name,
+			Rate:       rate,
+		})
+	}
+	return showEditResult(c, scenario, sideIdx, err, msgs)
+}
+
+// HandleEditScenarioDefaultSelectCallback shows the default role choices of a side: its roles, a typed name
+// or none. Payload: scenarioID|sideIndex
+func HandleEditScenarioDefaultSelectCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	if _, ok := editorRequester(c, msgs); !ok {
+		return nil
+	}
+	scenario, sideIdx, _, err := loadEditorSide(editor, data)
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+
+	side := scenario.Sides[sideIdx]
+	markup := &telebot.ReplyMarkup{}
+	prefix := fmt.Sprintf("%s|%d|", scenario.ID, sideIdx)
+	var rows []telebot.Row
+	for i, role := range side.Roles {
+		rows = append(rows, markup.Row(markup.Data(role.Name, tgutil.UniqueScenarioSetDefaultRole, roleData(prefix, i, role))))
+	}
+	rows = append(rows,
+		markup.Row(markup.Data(msgs.Scenario.EditorDefaultOtherButton, tgutil.UniqueScenarioEditAsk, prefix+string(ScenarioInputDefaultRole))),
+		markup.Row(markup.Data(msgs.Scenario.EditorDefaultNoneButton, tgutil.UniqueScenarioSetDefaultRole, prefix+strconv.Itoa(noChoice))),
+		markup.Row(sideBackButton(markup, scenario.ID, sideIdx, msgs)),
+	)
+	markup.Inline(rows...)
+	return c.Edit(fmt.Sprintf(msgs.Scenario.EditorDefaultPrompt, side.Name), markup)
+}
+
+// HandleEditScenarioSetDefaultRoleCallback makes a role of the side its default role, or removes the default
+// role. Payload: scenarioID|sideIndex|roleIndex|roleTag, or scenarioID|sideIndex|-1 for none
+func HandleEditScenarioSetDefaultRoleCallback(editor ScenarioEditor, c telebot.Context, data string, msgs *messages.Messages) error {
+	requester, ok := editorRequester(c, msgs)
+	if !ok {
+		return nil
+	}
+	scenario, sideIdx, rest, err := loadEditorSide(editor, data)
+	roleName := ""
+	if err == nil && (len(rest) != 1 || rest[0] != strconv.Itoa(noChoice)) {
+		var roleIdx int
+		if roleIdx, err = editorRole(scenario, sideIdx, rest); err == nil {
+			roleName = scenario.Sides[sideIdx].Roles[roleIdx].Name
+		}
+	}
+	if err == nil {
+		scenario, err = editor.SetDefaultRoleHandler().Handle(context.Background(), scenarioCommand.SetDefaultRoleCommand{
+			Requester:  *requester,
+			ScenarioID: scenario.ID,
+			Side:       scenario.Sides[sideIdx].Name,
+			RoleName:   roleName,
+		})
+	}
+	return showEditResult(c, scenario, sideIdx, err, msgs)
+}
+
+// PrepareScenarioEditor renders the sides of a scenario with a button per side
+func PrepareScenarioEditor(scenario *scenarioEntity.Scenario, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	var entries []string
+	for i, side := range scenario.Sides {
+		entries = append(entries, fmt.Sprintf(msgs.Scenario.EditorSideEntry, i+1, side.Name, rateLabel(side.PopulationRate, msgs), defaultRoleLabel(side, msgs), len(side.Roles)))
+		rows = append(rows, markup.Row(markup.Data(side.Name, tgutil.UniqueScenarioEditSide, fmt.Sprintf("%s|%d", scenario.ID, i))))
+	}
+	sides := msgs.Scenario.EditorNoSides
+	if len(entries) > 0 {
+		sides = strings.Join(entries, "\n")
+	}
+	rows = append(rows,
+		markup.Row(markup.Data(msgs.Scenario.EditorAddSideButton, tgutil.UniqueScenarioEditAsk, fmt.Sprintf("%s|%d|%s", scenario.ID, noChoice, ScenarioInputSideName))),
+		markup.Row(markup.Data(msgs.Scenario.EditorDoneButton, tgutil.UniqueCancel)),
+	)
+	markup.Inline(rows...)
+	return fmt.Sprintf(msgs.Scenario.EditorTitle, scenario.Name, scenario.ID, sides), markup
+}
+
+// PrepareSideEditor renders the settings and roles of a side; each role can be removed or get its added_at changed
+func PrepareSideEditor(scenario *scenarioEntity.Scenario, sideIdx int, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	side := scenario.Sides[sideIdx]
+	markup := &telebot.ReplyMarkup{}
+	prefix := fmt.Sprintf("%s|%d|", scenario.ID, sideIdx)
+	var rows []telebot.Row
+	var entries []string
+	for i, role := range side.Roles {
+		entries = append(entries, fmt.Sprintf(msgs.Scenario.EditorRoleEntry, i+1, role.Name, role.AddedAt))
+		rows = append(rows, markup.Row(
+			markup.Data(fmt.Sprintf(msgs.Scenario.EditorRemoveRoleButton, role.Name), tgutil.UniqueScenarioRemoveRole, roleData(prefix, i, role)),
+			markup.Data(fmt.Sprintf(msgs.Scenario.EditorAddedAtButton, role.AddedAt), tgutil.UniqueScenarioAddedAtSelect, roleData(prefix, i, role)),
+		))
+	}
+	roles := msgs.Scenario.EditorNoRoles
+	if len(entries) > 0 {
+		roles = strings.Join(entries, "\n")
+	}
+	sideData := strings.TrimSuffix(prefix, "|")
+	rows = append(rows,
+		markup.Row(
+			markup.Data(msgs.Scenario.EditorAddRoleButton, tgutil.UniqueScenarioEditAsk, prefix+string(ScenarioInputRoleName)),
+			markup.Data(msgs.Scenario.EditorRenameSideButton, tgutil.UniqueScenarioEditAsk, prefix+string(ScenarioInputRenameSide)),
+		),
+		markup.Row(
+			markup.Data(msgs.Scenario.EditorDefaultRoleButton, tgutil.UniqueScenarioDefaultSelect, sideData),
+			markup.Data(msgs.Scenario.EditorPopulationButton, tgutil.UniqueScenarioRateSelect, sideData),
+		),
+		markup.Row(
+			markup.Data(msgs.Scenario.EditorRemoveSideButton, tgutil.UniqueScenarioRemoveSide, sideData),
+			markup.Data(msgs.Scenario.EditorBackButton, tgutil.UniqueScenarioEditOpen, scenario.ID),
+		),
+	)
+	markup.Inline(rows...)
+	text := fmt.Sprintf(msgs.Scenario.EditorSideTitle, scenario.Name, side.Name, rateLabel(side.PopulationRate, msgs), defaultRoleLabel(side, msgs), roles)
+	return text, markup
+}
+
+// prepareEditorView renders the editor of a side, or of the whole scenario when the side is gone
+func prepareEditorView(scenario *scenarioEntity.Scenario, sideIdx int, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	if sideIdx >= 0 && sideIdx < len(scenario.Sides) {
+		return PrepareSideEditor(scenario, sideIdx, msgs)
+	}
+	return PrepareScenarioEditor(scenario, msgs)
+}
+
+// showEditResult answers an editor button after an edit and redraws the editor
+func showEditResult(c telebot.Context, scenario *scenarioEntity.Scenario, sideIdx int, err error, msgs *messages.Messages) error {
+	if err != nil {
+		log.Printf("ScenarioEditor: edit failed: %v", err)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.EditorError, err), ShowAlert: true})
+	}
+	_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Scenario.EditorSaved})
+	text, markup := prepareEditorView(scenario, sideIdx, msgs)
+	return c.Edit(text, markup)
+}
+
+// editorRequester answers non-admins with an alert; ok is false when the callback is done
+func editorRequester(c telebot.Context, msgs *messages.Messages) (*sharedEntity.User, bool) {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil || !requester.Admin {
+		_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorPermissionDenied, ShowAlert: true})
+		return nil, false
+	}
+	return requester, true
+}
+
+// loadEditorSide parses scenarioID|sideIndex|... and loads the scenario; rest holds the remaining fields
+func loadEditorSide(editor ScenarioEditor, data string) (scenario *scenarioEntity.Scenario, sideIdx int, rest []string, err error) {
+	parts := strings.Split(data, "|")
+	if len(parts) < 2 {
+		return nil, 0, nil, errInvalidEditorData
+	}
+	if sideIdx, err = strconv.Atoi(parts[1]); err != nil {
+		return nil, 0, nil, errInvalidEditorData
+	}
+	scenario, err = editor.GetScenarioByIDHandler().Handle(context.Background(), scenarioQuery.GetScenarioByIDQuery{ID: parts[0]})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if sideIdx < 0 || sideIdx >= len(scenario.Sides) {
+		return nil, 0, nil, scenarioEntity.ErrSideNotFound
+	}
+	return scenario, sideIdx, parts[2:], nil
+}
+
+// editorIndex parses the i-th remaining payload field; noChoice is allowed
+func editorIndex(rest []string, i int) (int, error) {
+	if i >= len(rest) {
+		return 0, errInvalidEditorData
+	}
+	n, err := strconv.Atoi(rest[i])
+	if err != nil || n < noChoice {
+		return 0, errInvalidEditorData
+	}
+	return n, nil
+}
+
+// editorRole parses the roleIndex|roleTag fields at the start of rest and makes sure the role there is still
+// the one the button was drawn for
+func editorRole(scenario *scenarioEntity.Scenario, sideIdx int, rest []string) (int, error) {
+	roleIdx, err := editorIndex(rest, 0)
+	if err != nil || len(rest) < 2 {
+		return 0, errInvalidEditorData
+	}
+	roles := scenario.Sides[sideIdx].Roles
+	if roleIdx < 0 || roleIdx >= len(roles) || roleTag(roles[roleIdx].Name) != rest[1] {
+		return 0, errEditorRoleChanged
+	}
+	return roleIdx, nil
+}
+
+// roleData builds the payload of a button acting on the role at index i of a side
+func roleData(sidePrefix string, i int, role scenarioEntity.Role) string {
+	return sidePrefix + strconv.Itoa(i) + "|" + roleTag(role.Name)
+}
+
+// roleTag is a short hash of a role name; role names can be too long for the 64 bytes of callback data
+func roleTag(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
+
+// sideIndex returns the position of the named side, or -1
+func sideIndex(scenario *scenarioEntity.Scenario, name string) int {
+	for i, side := range scenario.Sides {
+		if side.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// sideBackButton returns to the editor of a side
+func sideBackButton(markup *telebot.ReplyMarkup, scenarioID string, sideIdx int, msgs *messages.Messages) telebot.Btn {
+	return markup.Data(msgs.Scenario.EditorBackButton, tgutil.UniqueScenarioEditSide, fmt.Sprintf("%s|%d", scenarioID, sideIdx))
+}
+
+// rateLabel renders a population rate as a share of the players
+func rateLabel(rate *float32, msgs *messages.Messages) string {
+	if rate == nil {
+		return msgs.Scenario.EditorRateAuto
+	}
+	return fmt.Sprintf(msgs.Scenario.EditorRate, int(*rate*100+0.5))
+}
+
+// defaultRoleLabel renders the default role of a side
+func defaultRoleLabel(side scenarioEntity.Side, msgs *messages.Messages) string {
+	if side.DefaultRole == nil {
+		return msgs.Scenario.EditorNoDefault
+	}
+	return side.DefaultRole.Name
+}
@@ -0,0 +1,131 @@
+package telegram
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	tgutil "telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// HandleExportScenario handles the /export_scenario command, sending a scenario as a JSON document that
+// can be uploaded again unchanged
+func HandleExportScenario(
+	exportScenarioHandler *scenarioQuery.ExportScenarioHandler,
+	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil || !requester.Admin {
+		return c.Send(msgs.Common.ErrorPermissionDenied)
+	}
+
+	scenarioID := strings.TrimSpace(c.Message().Payload)
+	if scenarioID == "" {
+		scenarios, err := getAllScenariosHandler.Handle(context.Background(), scenarioQuery.GetAllScenariosQuery{})
+		if err != nil {
+			return c.Send(fmt.Sprintf(msgs.Scenario.ExportError, err))
+		}
+		ids := make([]string, 0, len(scenarios))
+		for _, s := range scenarios {
+			ids = append(ids, s.ID)
+		}
+		return c.Send(fmt.Sprintf(msgs.Scenario.ExportPrompt, strings.Join(ids, ", ")))
+	}
+
+	exported, err := exportScenarioHandler.Handle(context.Background(), scenarioQuery.ExportScenarioQuery{Requester: *requester, ID: scenarioID})
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Scenario.ExportError, err))
+	}
+	return c.Send(ScenarioDocument(exported, scenarioID, msgs))
+}
+
+// HandleScenarioExportCallback sends the scenario of a detail page as a JSON document. Payload: scenarioID
+func HandleScenarioExportCallback(
+	exportScenarioHandler *scenarioQuery.ExportScenarioHandler,
+	c telebot.Context,
+	scenarioID string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil || !requester.Admin {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorPermissionDenied, ShowAlert: true})
+	}
+
+	exported, err := exportScenarioHandler.Handle(context.Background(), scenarioQuery.ExportScenarioQuery{Requester: *requester, ID: scenarioID})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Scenario.ExportError, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	return c.Send(ScenarioDocument(exported, scenarioID, msgs))
+}
+
+// HandleExportScenarios handles the /export_scenarios command, sending every scenario in one zip archive
+func HandleExportScenarios(
+	exportAllScenariosHandler *scenarioQuery.ExportAllScenariosHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil || !requester.Admin {
+		return c.Send(msgs.Common.ErrorPermissionDenied)
+	}
+
+	exported, err := exportAllScenariosHandler.Handle(context.Background(), scenarioQuery.ExportAllScenariosQuery{Requester: *requester})
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Scenario.ExportError, err))
+	}
+	if len(exported) == 0 {
+		return c.Send(msgs.Scenario.ExportEmpty)
+	}
+	archive, err := ZipScenarios(exported)
+	if err != nil {
+		log.Printf("ExportScenarios: Failed to build archive: %v", err)
+		return c.Send(fmt.Sprintf(msgs.Scenario.ExportError, err))
+	}
+
+	return c.Send(&telebot.Document{
+		File:     telebot.FromReader(bytes.NewReader(archive)),
+		FileName: fmt.Sprintf("scenarios-%s.zip", time.Now().Format("2006-01-02")),
+		MIME:     "application/zip",
+		Caption:  fmt.Sprintf(msgs.Scenario.ExportAllCaption, len(exported)),
+	})
+}
+
+// ScenarioDocument wraps an exported scenario in a document that HandleDocument accepts as an upload
+func ScenarioDocument(exported *scenarioQuery.ExportedScenario, scenarioID string, msgs *messages.Messages) *telebot.Document {
+	return &telebot.Document{
+		File:     telebot.FromReader(bytes.NewReader(exported.Data)),
+		FileName: exported.FileName,
+		MIME:     "application/json",
+		Caption:  fmt.Sprintf(msgs.Scenario.ExportCaption, exported.Name, scenarioID),
+	}
+}
+
+// ZipScenarios packs exported scenarios into a zip archive with one JSON file each
+func ZipScenarios(exported []scenarioQuery.ExportedScenario) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range exported {
+		f, err := w.Create(file.FileName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to archive: %w", file.FileName, err)
+		}
+		if _, err := f.Write(file.Data); err != nil {
+			return nil, fmt.Errorf("failed to write %s to archive: %w", file.FileName, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
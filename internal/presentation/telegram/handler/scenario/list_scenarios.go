@@ -0,0 +1,233 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+	tgutil "telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// Player counts offered by the role preview of the scenario detail
+const (
+	minPreviewPlayers = scenarioEntity.DefaultValidationMinPlayers
+	maxPreviewPlayers = scenarioEntity.DefaultValidationMaxPlayers
+	previewPerRow     = 5
+)
+
+// HandleListScenarios handles the /list_scenarios command
+func HandleListScenarios(
+	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	text, markup, err := PrepareScenarioList(getAllScenariosHandler, msgs)
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Common.ErrorGeneric, err))
+	}
+	return c.Send(text, markup)
+}
+
+// HandleScenarioListCallback returns from a scenario detail to the list
+func HandleScenarioListCallback(
+	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	text, markup, err := PrepareScenarioList(getAllScenariosHandler, msgs)
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	return c.Edit(text, markup)
+}
+
+// HandleScenarioDetailCallback shows the sides and roles of a scenario. Payload: scenarioID
+func HandleScenarioDetailCallback(
+	getScenarioByIDHandler *scenarioQuery.GetScenarioByIDHandler,
+	c telebot.Context,
+	scenarioID string,
+	msgs *messages.Messages,
+) error {
+	return showScenarioDetail(getScenarioByIDHandler, c, scenarioID, 0, msgs)
+}
+
+// HandleScenarioPreviewCallback shows a scenario detail with the roles handed out for a player count.
+// Payload: scenarioID|players
+func HandleScenarioPreviewCallback(
+	getScenarioByIDHandler *scenarioQuery.GetScenarioByIDHandler,
+	c telebot.Context,
+	data string,
+	msgs *messages.Messages,
+) error {
+	scenarioID, countStr, _ := strings.Cut(data, "|")
+	players, err := strconv.Atoi(countStr)
+	if err != nil || players <= 0 {
+		log.Printf("ScenarioPreview: Invalid payload format: %s", data)
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, "invalid data"), ShowAlert: true})
+	}
+	return showScenarioDetail(getScenarioByIDHandler, c, scenarioID, players, msgs)
+}
+
+// showScenarioDetail replaces the message with the detail of a scenario, previewing players if non-zero
+func showScenarioDetail(
+	getScenarioByIDHandler *scenarioQuery.GetScenarioByIDHandler,
+	c telebot.Context,
+	scenarioID string,
+	players int,
+	msgs *messages.Messages,
+) error {
+	scenario, err := getScenarioByIDHandler.Handle(context.Background(), scenarioQuery.GetScenarioByIDQuery{ID: scenarioID})
+	if err != nil {
+		return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf(msgs.Common.CallbackErrorGeneric, err), ShowAlert: true})
+	}
+	_ = c.Respond()
+	requester := tgutil.ToUser(c.Sender())
+	isAdmin := requester != nil && requester.Admin
+	text, markup := PrepareScenarioDetail(scenario, players, isAdmin, msgs)
+	return c.Edit(text, markup)
+}
+
+// PrepareScenarioList renders a button per scenario, sorted by name
+func PrepareScenarioList(
+	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	msgs *messages.Messages,
+) (string, *telebot.ReplyMarkup, error) {
+	scenarios, err := getAllScenariosHandler.Handle(context.Background(), scenarioQuery.GetAllScenariosQuery{})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(scenarios) == 0 {
+		return msgs.Scenario.ListEmpty, &telebot.ReplyMarkup{}, nil
+	}
+	sort.Slice(scenarios, func(i, j int) bool { return scenarios[i].Name < scenarios[j].Name })
+
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	for _, scenario := range scenarios {
+		label := fmt.Sprintf(msgs.Scenario.ListEntryButton, scenario.Name, len(scenario.Sides))
+		rows = append(rows, markup.Row(markup.Data(label, tgutil.UniqueScenarioDetail, scenario.ID)))
+	}
+	markup.Inline(rows...)
+	return msgs.Scenario.ListTitle, markup, nil
+}
+
+// PrepareScenarioDetail renders the sides of a scenario with their roles and added_at thresholds. A non-zero
+// players count appends the roles GetRoles hands out for that many players. Admins also get edit and export buttons.
+func PrepareScenarioDetail(scenario *scenarioEntity.Scenario, players int, isAdmin bool, msgs *messages.Messages) (string, *telebot.ReplyMarkup) {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(msgs.Scenario.DetailTitle, scenario.Name, scenario.ID))
+	b.WriteString(fmt.Sprintf(msgs.Scenario.DetailPlayers, playerBoundsText(scenario, msgs)))
+	for _, side := range scenario.Sides {
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf(msgs.Scenario.DetailSide, side.Name, rateLabel(side.PopulationRate, msgs), defaultRoleLabel(side, msgs)))
+		if len(side.Roles) == 0 {
+			b.WriteString("\n")
+			b.WriteString(msgs.Scenario.DetailNoRoles)
+		}
+		for _, role := range side.Roles {
+			b.WriteString("\n")
+			b.WriteString(fmt.Sprintf(msgs.Scenario.DetailRole, role.Name, role.AddedAt))
+		}
+	}
+	if players > 0 {
+		b.WriteString(fmt.Sprintf(msgs.Scenario.DetailPreviewTitle, players))
+		b.WriteString(RolePreview(scenario, players, msgs))
+	} else {
+		b.WriteString(msgs.Scenario.DetailPickCount)
+	}
+
+	markup := &telebot.ReplyMarkup{}
+	var rows []telebot.Row
+	var row []telebot.Btn
+	for n := minPreviewPlayers; n <= maxPreviewPlayers; n++ {
+		label := fmt.Sprintf(msgs.Scenario.DetailCountButton, n)
+		if n == players {
+			label = "• " + label + " •"
+		}
+		row = append(row, markup.Data(label, tgutil.UniqueScenarioPreview, fmt.Sprintf("%s|%d", scenario.ID, n)))
+		if len(row) == previewPerRow {
+			rows = append(rows, markup.Row(row...))
+			row = nil
+		}
+	}
+	if len(row) > 0 {
+		rows = append(rows, markup.Row(row...))
+	}
+	lastRow := markup.Row(markup.Data(msgs.Scenario.DetailBackButton, tgutil.UniqueScenarioList))
+	if isAdmin {
+		lastRow = append(lastRow,
+			markup.Data(msgs.Scenario.DetailEditButton, tgutil.UniqueScenarioEditOpen, scenario.ID),
+			markup.Data(msgs.Scenario.DetailExportButton, tgutil.UniqueScenarioExport, scenario.ID),
+		)
+	}
+	rows = append(rows, lastRow)
+	markup.Inline(rows...)
+	return b.String(), markup
+}
+
+// playerBoundsText renders the player counts a scenario supports
+func playerBoundsText(scenario *scenarioEntity.Scenario, msgs *messages.Messages) string {
+	min, max := scenario.PlayerBounds()
+	switch {
+	case min == 0:
+		return msgs.Scenario.DetailPlayersUnknown
+	case max == 0:
+		return fmt.Sprintf(msgs.Scenario.DetailPlayersOpen, min)
+	default:
+		return fmt.Sprintf(msgs.Scenario.DetailPlayersRange, min, max)
+	}
+}
+
+// RolePreview lists the roles GetRoles hands out for a player count, counted per side in scenario order
+func RolePreview(scenario *scenarioEntity.Scenario, players int, msgs *messages.Messages) string {
+	roles := scenario.GetRoles(players)
+
+	type roleCount struct {
+		name  string
+		count int
+	}
+	bySide := make(map[string][]roleCount)
+	sideTotals := make(map[string]int)
+	for _, role := range roles {
+		counts := bySide[role.Side]
+		found := false
+		for i := range counts {
+			if counts[i].name == role.Name {
+				counts[i].count++
+				found = true
+				break
+			}
+		}
+		if !found {
+			counts = append(counts, roleCount{name: role.Name, count: 1})
+		}
+		bySide[role.Side] = counts
+		sideTotals[role.Side]++
+	}
+
+	var lines []string
+	for _, side := range scenario.Sides {
+		counts := bySide[side.Name]
+		if len(counts) == 0 {
+			continue
+		}
+		entries := make([]string, 0, len(counts))
+		for _, rc := range counts {
+			entries = append(entries, fmt.Sprintf(msgs.Scenario.DetailPreviewRole, rc.count, rc.name))
+		}
+		lines = append(lines, fmt.Sprintf(msgs.Scenario.DetailPreviewSide, side.Name, sideTotals[side.Name], strings.Join(entries, "، ")))
+	}
+	if len(roles) != players {
+		lines = append(lines, fmt.Sprintf(msgs.Scenario.DetailPreviewMismatch, len(roles), players))
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
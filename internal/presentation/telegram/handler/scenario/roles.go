@@ -0,0 +1,164 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
+	messages "telemafia/internal/presentation/telegram/messages"
+
+	"gopkg.in/telebot.v4"
+)
+
+// maxRolesMessageLength keeps each /roles message under Telegram's 4096 character limit
+const maxRolesMessageLength = 4000
+
+// HandleRoles handles the /roles command, listing every role of a scenario with its explanation
+func HandleRoles(
+	getScenarioByIDHandler *scenarioQuery.GetScenarioByIDHandler,
+	getAllScenariosHandler *scenarioQuery.GetAllScenariosHandler,
+	c telebot.Context,
+	msgs *messages.Messages,
+) error {
+	scenarioID := strings.TrimSpace(c.Message().Payload)
+	if scenarioID == "" {
+		scenarios, err := getAllScenariosHandler.Handle(context.Background(), scenarioQuery.GetAllScenariosQuery{})
+		if err != nil {
+			return c.Send(fmt.Sprintf(msgs.Scenario.RolesError, scenarioID, err))
+		}
+		ids := make([]string, 0, len(scenarios))
+		for _, s := range scenarios {
+			ids = append(ids, s.ID)
+		}
+		return c.Send(fmt.Sprintf(msgs.Scenario.RolesPrompt, strings.Join(ids, ", ")))
+	}
+
+	scenario, err := getScenarioByIDHandler.Handle(context.Background(), scenarioQuery.GetScenarioByIDQuery{ID: scenarioID})
+	if err != nil {
+		return c.Send(fmt.Sprintf(msgs.Scenario.RolesError, scenarioID, err))
+	}
+
+	for _, part := range SplitMessage(ScenarioRolesText(msgs, scenario), maxRolesMessageLength) {
+		if err := c.Send(part, telebot.NoPreview); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScenarioRolesText lists the sides of a scenario with their descriptions, followed by the explanation of
+// each of their roles. A default role that is also listed among the roles is shown once.
+func ScenarioRolesText(msgs *messages.Messages, scenario *scenarioEntity.Scenario) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(msgs.Scenario.RolesTitle, scenario.Name))
+	for _, side := range scenario.Sides {
+		b.WriteString("\n\n")
+		b.WriteString(fmt.Sprintf(msgs.Scenario.RolesSideTitle, side.Name))
+		if side.Description != "" {
+			b.WriteString("\n")
+			b.WriteString(side.Description)
+		}
+		roles := side.Roles
+		if side.DefaultRole != nil && !hasRole(roles, side.DefaultRole.Name) {
+			roles = append([]scenarioEntity.Role{*side.DefaultRole}, roles...)
+		}
+		seen := make(map[string]bool, len(roles))
+		for _, role := range roles {
+			if seen[role.Name] {
+				continue
+			}
+			seen[role.Name] = true
+			b.WriteString("\n\n")
+			b.WriteString(fmt.Sprintf(msgs.Scenario.RoleHelpName, role.Name))
+			writeRoleDetails(&b, msgs, role)
+		}
+	}
+	return b.String()
+}
+
+// RoleHelp explains an assigned role: its side and the side's goal, its description, abilities and tips
+func RoleHelp(msgs *messages.Messages, scenario *scenarioEntity.Scenario, role scenarioEntity.Role) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(msgs.Scenario.RoleHelpTitle, role.Name, role.Side))
+	if scenario != nil {
+		if side, ok := scenario.FindSide(role.Side); ok && side.Description != "" {
+			b.WriteString("\n")
+			b.WriteString(fmt.Sprintf(msgs.Scenario.RoleHelpSide, side.Name, side.Description))
+		}
+	}
+	writeRoleDetails(&b, msgs, role)
+	return b.String()
+}
+
+// writeRoleDetails appends the description, abilities and tips of a role, one per line
+func writeRoleDetails(b *strings.Builder, msgs *messages.Messages, role scenarioEntity.Role) {
+	if role.Description != "" {
+		b.WriteString("\n")
+		b.WriteString(role.Description)
+	}
+	if len(role.Abilities) > 0 {
+		b.WriteString("\n")
+		b.WriteString(msgs.Scenario.RoleHelpAbilities)
+		for _, a := range role.Abilities {
+			line := a.Name
+			if a.Description != "" {
+				line = fmt.Sprintf(msgs.Scenario.RoleHelpAbilityDescribed, a.Name, a.Description)
+			}
+			if a.Uses > 0 {
+				line += fmt.Sprintf(msgs.Scenario.RoleHelpAbilityUses, a.Uses)
+			}
+			b.WriteString("\n• ")
+			b.WriteString(line)
+		}
+	}
+	if len(role.Tips) > 0 {
+		b.WriteString("\n")
+		b.WriteString(msgs.Scenario.RoleHelpTips)
+		for _, tip := range role.Tips {
+			b.WriteString("\n• ")
+			b.WriteString(tip)
+		}
+	}
+}
+
+func hasRole(roles []scenarioEntity.Role, name string) bool {
+	for _, r := range roles {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitMessage cuts text into parts of at most limit characters, preferring paragraph and line breaks
+func SplitMessage(text string, limit int) []string {
+	var parts []string
+	runes := []rune(text)
+	for len(runes) > limit {
+		cut := lastBreak(runes[:limit], "\n\n")
+		if cut <= 0 {
+			cut = lastBreak(runes[:limit], "\n")
+		}
+		if cut <= 0 {
+			cut = limit
+		}
+		parts = append(parts, strings.TrimRight(string(runes[:cut]), "\n"))
+		runes = []rune(strings.TrimLeft(string(runes[cut:]), "\n"))
+	}
+	if len(runes) > 0 {
+		parts = append(parts, string(runes))
+	}
+	return parts
+}
+
+// lastBreak returns the rune index of the last occurrence of sep in runes, or -1
+func lastBreak(runes []rune, sep string) int {
+	s := string(runes)
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return -1
+	}
+	return len([]rune(s[:i]))
+}
@@ -0,0 +1,175 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+	tgutil "telemafia/internal/shared/tgutil"
+
+	"gopkg.in/telebot.v4"
+)
+
+// PendingUploadStore keeps scenario uploads that wait for a replace-or-create decision.
+// Only the latest upload per user is kept.
+type PendingUploadStore interface {
+	SetPendingScenarioUpload(userID int64, jsonData string)
+	TakePendingScenarioUpload(userID int64) (string, bool)
+}
+
+// SubmitScenarioJSON adds an uploaded scenario. If it collides with a stored scenario,
+// the upload is parked in store and the admin is asked whether to replace or create a new one.
+func SubmitScenarioJSON(
+	addScenarioJSONHandler *scenarioCommand.AddScenarioJSONHandler,
+	store PendingUploadStore,
+	c telebot.Context,
+	requester *sharedEntity.User,
+	jsonData string,
+	msgs *messages.Messages,
+) error {
+	cmd := scenarioCommand.AddScenarioJSONCommand{
+		Requester:  *requester,
+		JSONData:   jsonData,
+		OnConflict: scenarioCommand.ConflictFail,
+	}
+
+	createdScenario, err := addScenarioJSONHandler.Handle(context.Background(), cmd)
+	var conflict *scenarioEntity.ScenarioConflictError
+	if errors.As(err, &conflict) {
+		store.SetPendingScenarioUpload(c.Sender().ID, jsonData)
+		markup := &telebot.ReplyMarkup{}
+		markup.Inline(
+			markup.Row(
+				markup.Data(msgs.Scenario.AddScenarioJSONReplaceButton, tgutil.UniqueScenarioUploadReplace),
+				markup.Data(msgs.Scenario.AddScenarioJSONCreateNewButton, tgutil.UniqueScenarioUploadCreateNew),
+			),
+			markup.Row(markup.Data(msgs.Scenario.AddScenarioJSONCancelButton, tgutil.UniqueScenarioUploadCancel)),
+		)
+		return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONConflictPrompt, conflict.Field, conflict.Existing.Name, conflict.Existing.ID), markup)
+	}
+	if err != nil {
+		return c.Send(ScenarioUploadErrorMessage(err, msgs))
+	}
+
+	return c.Send(fmt.Sprintf(msgs.Scenario.AddScenarioJSONSuccess, createdScenario.Name, createdScenario.ID) + ValidationReportText(createdScenario, msgs))
+}
+
+// HandleScenarioUploadDecision applies the admin's answer to a conflict prompt.
+func HandleScenarioUploadDecision(
+	addScenarioJSONHandler *scenarioCommand.AddScenarioJSONHandler,
+	store PendingUploadStore,
+	c telebot.Context,
+	unique string,
+	msgs *messages.Messages,
+) error {
+	requester := tgutil.ToUser(c.Sender())
+	if requester == nil || !requester.Admin {
+		return c.Respond(&telebot.CallbackResponse{Text: msgs.Common.ErrorPermissionDenied, ShowAlert: true})
+	}
+
+	jsonData, ok := store.TakePendingScenarioUpload(c.Sender().ID)
+	if !ok {
+		_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Scenario.AddScenarioJSONPendingExpired, ShowAlert: true})
+		return c.Delete()
+	}
+
+	var policy scenarioCommand.ScenarioConflictPolicy
+	switch unique {
+	case tgutil.UniqueScenarioUploadReplace:
+		policy = scenarioCommand.ConflictReplace
+	case tgutil.UniqueScenarioUploadCreateNew:
+		policy = scenarioCommand.ConflictCreateNew
+	default:
+		_ = c.Respond(&telebot.CallbackResponse{Text: msgs.Common.CallbackCancelled})
+		return c.Edit(msgs.Common.CallbackCancelled)
+	}
+
+	cmd := scenarioCommand.AddScenarioJSONCommand{
+		Requester:  *requester,
+		JSONData:   jsonData,
+		OnConflict: policy,
+	}
+	scenario, err := addScenarioJSONHandler.Handle(context.Background(), cmd)
+	if err != nil {
+		log.Printf("Scenario upload decision failed for user %d: %v", requester.ID, err)
+		_ = c.Respond()
+		return c.Edit(ScenarioUploadErrorMessage(err, msgs))
+	}
+
+	_ = c.Respond()
+	if policy == scenarioCommand.ConflictReplace {
+		return c.Edit(fmt.Sprintf(msgs.Scenario.AddScenarioJSONReplaced, scenario.Name, scenario.ID) + ValidationReportText(scenario, msgs))
+	}
+	return c.Edit(fmt.Sprintf(msgs.Scenario.AddScenarioJSONSuccess, scenario.Name, scenario.ID) + ValidationReportText(scenario, msgs))
+}
+
+// ValidationReportText runs the default ScenarioValidator on an uploaded scenario and lists its findings
+func ValidationReportText(scenario *scenarioEntity.Scenario, msgs *messages.Messages) string {
+	report := scenarioEntity.DefaultScenarioValidator().Validate(scenario)
+	if len(report.Issues) == 0 {
+		return fmt.Sprintf(msgs.Scenario.ValidationOK, report.MinPlayers, report.MaxPlayers)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(msgs.Scenario.ValidationTitle, report.MinPlayers, report.MaxPlayers, len(report.Errors()), len(report.Warnings())))
+	for _, issue := range report.Issues {
+		b.WriteString("\n")
+		text := ValidationIssueText(issue, report, msgs)
+		if issue.Severity == scenarioEntity.SeverityError {
+			b.WriteString(fmt.Sprintf(msgs.Scenario.ValidationError, text))
+		} else {
+			b.WriteString(fmt.Sprintf(msgs.Scenario.ValidationWarning, text))
+		}
+		if len(issue.Players) > 0 {
+			b.WriteString(fmt.Sprintf(msgs.Scenario.ValidationPlayers, issue.PlayerRanges()))
+		}
+	}
+	return b.String()
+}
+
+// ValidationIssueText renders one validator finding from messages
+func ValidationIssueText(issue scenarioEntity.ValidationIssue, report *scenarioEntity.ValidationReport, msgs *messages.Messages) string {
+	switch issue.Code {
+	case scenarioEntity.IssueInvalidScenario:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueInvalidScenario, issue.Detail)
+	case scenarioEntity.IssueBoundsOutsideRange:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueBoundsOutside, report.MinPlayers, report.MaxPlayers)
+	case scenarioEntity.IssueRateOutOfRange:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueRateOutOfRange, issue.Side, issue.Rate, scenarioEntity.MinPopulationRate, scenarioEntity.MaxPopulationRate)
+	case scenarioEntity.IssueRateWithoutDefaultRole:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueRateNoDefault, issue.Side)
+	case scenarioEntity.IssueRatesExceedPlayers:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueRatesExceed, issue.Rate)
+	case scenarioEntity.IssueDuplicateRole:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueDuplicateRole, issue.Side, issue.Role)
+	case scenarioEntity.IssueSharedRole:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueSharedRole, issue.Role, issue.Side, issue.OtherSide)
+	case scenarioEntity.IssueTooFewRoles:
+		return msgs.Scenario.ValidationIssueTooFewRoles
+	case scenarioEntity.IssueTooManyRoles:
+		return msgs.Scenario.ValidationIssueTooManyRoles
+	case scenarioEntity.IssueSideWithoutPlayers:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueSideNoPlayers, issue.Side)
+	case scenarioEntity.IssueParityAtStart:
+		return fmt.Sprintf(msgs.Scenario.ValidationIssueParityAtStart, issue.Side)
+	default:
+		return string(issue.Code)
+	}
+}
+
+// ScenarioUploadErrorMessage picks the user-facing text for an AddScenarioJSON error.
+func ScenarioUploadErrorMessage(err error, msgs *messages.Messages) string {
+	// Provide specific feedback for JSON errors vs other errors
+	if strings.Contains(err.Error(), "invalid JSON format") {
+		return fmt.Sprintf(msgs.Scenario.AddScenarioJSONInvalidJSON, err)
+	} else if strings.Contains(err.Error(), "cannot be empty") || errors.Is(err, scenarioEntity.ErrInvalidScenarioID) {
+		return fmt.Sprintf(msgs.Scenario.AddScenarioJSONValidationError, err)
+	}
+	return fmt.Sprintf(msgs.Scenario.AddScenarioJSONErrorGeneric, err)
+}
@@ -19,6 +19,7 @@ type CommonMessages struct {
 	ErrorPermissionDenied  string `json:"error_permission_denied"`
 	CallbackErrorGeneric   string `json:"callback_error_generic"`
 	CallbackCancelled      string `json:"callback_cancelled"`
+	CallbackInvalidData    string `json:"callback_invalid_data"`
 	CallbackFailedEdit     string `json:"callback_failed_edit"`
 	CallbackFailedRespond  string `json:"callback_failed_respond"`
 }
@@ -82,6 +83,54 @@ type RoomMessages struct {
 	ChangeModeratorCallbackSuccess string `json:"ChangeModeratorCallbackSuccess"`
 	ChangeModeratorCallbackError   string `json:"ChangeModeratorCallbackError"`
 	ChangeModeratorNoCandidates    string `json:"ChangeModeratorNoCandidates"`
+	SeatEntry                      string `json:"seat_entry"`
+	SeatsButton                    string `json:"seats_button"`
+	SeatsPrompt                    string `json:"seats_prompt"`
+	SeatsPromptEntry               string `json:"seats_prompt_entry"`
+	SeatUpButton                   string `json:"seat_up_button"`
+	SeatDownButton                 string `json:"seat_down_button"`
+	ShuffleSeatsButton             string `json:"shuffle_seats_button"`
+	SeatsNoPlayers                 string `json:"seats_no_players"`
+	SeatsError                     string `json:"seats_error"`
+	CreateInvalidCapacity          string `json:"create_invalid_capacity"`
+	JoinButtonTextCapacity         string `json:"join_button_text_capacity"`
+	JoinWaitlisted                 string `json:"join_waitlisted"`
+	WaitlistPromoted               string `json:"waitlist_promoted"`
+	RoomDetailCapacity             string `json:"room_detail_capacity"`
+	RoomDetailWaitlist             string `json:"room_detail_waitlist"`
+	WaitlistEntry                  string `json:"waitlist_entry"`
+	CapacityButton                 string `json:"capacity_button"`
+	CapacityPrompt                 string `json:"capacity_prompt"`
+	CapacityUnlimited              string `json:"capacity_unlimited"`
+	CapacityOption                 string `json:"capacity_option"`
+	CapacitySet                    string `json:"capacity_set"`
+	CapacityError                  string `json:"capacity_error"`
+	WatchButton                    string `json:"watch_button"`
+	WatchSuccess                   string `json:"watch_success"`
+	RoomDetailSpectators           string `json:"room_detail_spectators"`
+	TakeSeatButton                 string `json:"take_seat_button"`
+	ListPasswordMarker             string `json:"list_password_marker"`
+	VisibilityButton               string `json:"visibility_button"`
+	VisibilityPrompt               string `json:"visibility_prompt"`
+	VisibilityPublic               string `json:"visibility_public"`
+	VisibilityUnlisted             string `json:"visibility_unlisted"`
+	VisibilityPassword             string `json:"visibility_password"`
+	VisibilitySet                  string `json:"visibility_set"`
+	VisibilityError                string `json:"visibility_error"`
+	VisibilityPasswordPrompt       string `json:"visibility_password_prompt"`
+	RoomDetailVisibility           string `json:"room_detail_visibility"`
+	PasswordPrompt                 string `json:"password_prompt"`
+	PasswordWrong                  string `json:"password_wrong"`
+	InviteLinkRevoked              string `json:"invite_link_revoked"`
+	InviteRegenerateButton         string `json:"invite_regenerate_button"`
+	InviteRevokeButton             string `json:"invite_revoke_button"`
+	InviteRegenerated              string `json:"invite_regenerated"`
+	InviteRevoked                  string `json:"invite_revoked"`
+	InviteError                    string `json:"invite_error"`
+	InviteWatchLink                string `json:"invite_watch_link"`
+	InviteNotMember                string `json:"invite_not_member"`
+	KickWaitlistedButton           string `json:"kick_waitlisted_button"`
+	KickSpectatorButton            string `json:"kick_spectator_button"`
 }
 
 type ScenarioMessages struct {
@@ -96,6 +145,99 @@ type ScenarioMessages struct {
 	AddScenarioJSONInvalidJSON     string `json:"add_scenario_json_invalid_json"`
 	AddScenarioJSONValidationError string `json:"add_scenario_json_validation_error"`
 	AddScenarioJSONErrorGeneric    string `json:"add_scenario_json_error_generic"`
+	AddScenarioJSONConflictPrompt  string `json:"add_scenario_json_conflict_prompt"`
+	AddScenarioJSONReplaceButton   string `json:"add_scenario_json_replace_button"`
+	AddScenarioJSONCreateNewButton string `json:"add_scenario_json_create_new_button"`
+	AddScenarioJSONCancelButton    string `json:"add_scenario_json_cancel_button"`
+	AddScenarioJSONReplaced        string `json:"add_scenario_json_replaced"`
+	AddScenarioJSONPendingExpired  string `json:"add_scenario_json_pending_expired"`
+	RolesPrompt                    string `json:"roles_prompt"`
+	RolesError                     string `json:"roles_error"`
+	RolesTitle                     string `json:"roles_title"`
+	RolesSideTitle                 string `json:"roles_side_title"`
+	RoleHelpTitle                  string `json:"role_help_title"`
+	RoleHelpSide                   string `json:"role_help_side"`
+	RoleHelpName                   string `json:"role_help_name"`
+	RoleHelpAbilities              string `json:"role_help_abilities"`
+	RoleHelpAbilityDescribed       string `json:"role_help_ability_described"`
+	RoleHelpAbilityUses            string `json:"role_help_ability_uses"`
+	RoleHelpTips                   string `json:"role_help_tips"`
+	EditSelectPrompt               string `json:"edit_select_prompt"`
+	EditNoScenarios                string `json:"edit_no_scenarios"`
+	EditorTitle                    string `json:"editor_title"`
+	EditorSideEntry                string `json:"editor_side_entry"`
+	EditorNoSides                  string `json:"editor_no_sides"`
+	EditorRate                     string `json:"editor_rate"`
+	EditorRateAuto                 string `json:"editor_rate_auto"`
+	EditorNoDefault                string `json:"editor_no_default"`
+	EditorAddSideButton            string `json:"editor_add_side_button"`
+	EditorDoneButton               string `json:"editor_done_button"`
+	EditorSideTitle                string `json:"editor_side_title"`
+	EditorRoleEntry                string `json:"editor_role_entry"`
+	EditorNoRoles                  string `json:"editor_no_roles"`
+	EditorRemoveRoleButton         string `json:"editor_remove_role_button"`
+	EditorAddedAtButton            string `json:"editor_added_at_button"`
+	EditorAddRoleButton            string `json:"editor_add_role_button"`
+	EditorRenameSideButton         string `json:"editor_rename_side_button"`
+	EditorDefaultRoleButton        string `json:"editor_default_role_button"`
+	EditorPopulationButton         string `json:"editor_population_button"`
+	EditorRemoveSideButton         string `json:"editor_remove_side_button"`
+	EditorBackButton               string `json:"editor_back_button"`
+	EditorAddedAtPrompt            string `json:"editor_added_at_prompt"`
+	EditorAddedAtOption            string `json:"editor_added_at_option"`
+	EditorRatePrompt               string `json:"editor_rate_prompt"`
+	EditorRateAutoButton           string `json:"editor_rate_auto_button"`
+	EditorDefaultPrompt            string `json:"editor_default_prompt"`
+	EditorDefaultOtherButton       string `json:"editor_default_other_button"`
+	EditorDefaultNoneButton        string `json:"editor_default_none_button"`
+	EditorAskSideName              string `json:"editor_ask_side_name"`
+	EditorAskSideDefault           string `json:"editor_ask_side_default"`
+	EditorAskRenameSide            string `json:"editor_ask_rename_side"`
+	EditorAskRoleName              string `json:"editor_ask_role_name"`
+	EditorAskDefaultRole           string `json:"editor_ask_default_role"`
+	EditorSaved                    string `json:"editor_saved"`
+	EditorError                    string `json:"editor_error"`
+	ListTitle                      string `json:"list_title"`
+	ListEmpty                      string `json:"list_empty"`
+	ListEntryButton                string `json:"list_entry_button"`
+	DetailTitle                    string `json:"detail_title"`
+	DetailSide                     string `json:"detail_side"`
+	DetailRole                     string `json:"detail_role"`
+	DetailNoRoles                  string `json:"detail_no_roles"`
+	DetailPreviewTitle             string `json:"detail_preview_title"`
+	DetailPreviewSide              string `json:"detail_preview_side"`
+	DetailPreviewRole              string `json:"detail_preview_role"`
+	DetailPreviewMismatch          string `json:"detail_preview_mismatch"`
+	DetailPickCount                string `json:"detail_pick_count"`
+	DetailCountButton              string `json:"detail_count_button"`
+	DetailEditButton               string `json:"detail_edit_button"`
+	DetailBackButton               string `json:"detail_back_button"`
+	ValidationTitle                string `json:"validation_title"`
+	ValidationOK                   string `json:"validation_ok"`
+	ValidationError                string `json:"validation_error"`
+	ValidationWarning              string `json:"validation_warning"`
+	ValidationPlayers              string `json:"validation_players"`
+	ValidationIssueInvalidScenario string `json:"validation_issue_invalid_scenario"`
+	ValidationIssueBoundsOutside   string `json:"validation_issue_bounds_outside_range"`
+	ValidationIssueRateOutOfRange  string `json:"validation_issue_rate_out_of_range"`
+	ValidationIssueRateNoDefault   string `json:"validation_issue_rate_without_default_role"`
+	ValidationIssueRatesExceed     string `json:"validation_issue_rates_exceed_players"`
+	ValidationIssueDuplicateRole   string `json:"validation_issue_duplicate_role"`
+	ValidationIssueSharedRole      string `json:"validation_issue_shared_role"`
+	ValidationIssueTooFewRoles     string `json:"validation_issue_too_few_roles"`
+	ValidationIssueTooManyRoles    string `json:"validation_issue_too_many_roles"`
+	ValidationIssueSideNoPlayers   string `json:"validation_issue_side_without_players"`
+	ValidationIssueParityAtStart   string `json:"validation_issue_parity_at_start"`
+	DetailPlayers                  string `json:"detail_players"`
+	DetailPlayersRange             string `json:"detail_players_range"`
+	DetailPlayersOpen              string `json:"detail_players_open"`
+	DetailPlayersUnknown           string `json:"detail_players_unknown"`
+	ExportPrompt                   string `json:"export_prompt"`
+	ExportCaption                  string `json:"export_caption"`
+	ExportAllCaption               string `json:"export_all_caption"`
+	ExportEmpty                    string `json:"export_empty"`
+	ExportError                    string `json:"export_error"`
+	DetailExportButton             string `json:"detail_export_button"`
 }
 
 type GameMessages struct {
@@ -158,6 +300,165 @@ type GameMessages struct {
 	RoleSelectedConfirmPlayer           string `json:"RoleSelectedConfirmPlayer"`
 	AllRolesSelectedAdmin               string `json:"AllRolesSelectedAdmin"`
 	RoleTakenMarker                     string `json:"RoleTakenMarker"`
+	PhasePanelMessage                   string `json:"phase_panel_message"`
+	PhasePanelNoGames                   string `json:"phase_panel_no_games"`
+	PhasePanelSelectGame                string `json:"phase_panel_select_game"`
+	PhasePanelGameButton                string `json:"phase_panel_game_button"`
+	PhasePanelBackButton                string `json:"phase_panel_back_button"`
+	OpenPhasePanelButton                string `json:"open_phase_panel_button"`
+	PhaseNotStarted                     string `json:"phase_not_started"`
+	PhaseNight                          string `json:"phase_night"`
+	PhaseDay                            string `json:"phase_day"`
+	PhaseVoting                         string `json:"phase_voting"`
+	PhaseDefense                        string `json:"phase_defense"`
+	PhaseFinished                       string `json:"phase_finished"`
+	StartNightButton                    string `json:"start_night_button"`
+	StartDayButton                      string `json:"start_day_button"`
+	StartVotingButton                   string `json:"start_voting_button"`
+	StartDefenseButton                  string `json:"start_defense_button"`
+	EndGameButton                       string `json:"end_game_button"`
+	EndGameConfirmPrompt                string `json:"end_game_confirm_prompt"`
+	EndGameConfirmButton                string `json:"end_game_confirm_button"`
+	PhaseTransitionError                string `json:"phase_transition_error"`
+	PhaseUnknownAction                  string `json:"phase_unknown_action"`
+	PhaseAnnouncement                   string `json:"phase_announcement"`
+	GameEndedAnnouncement               string `json:"game_ended_announcement"`
+	DeadPlayerEntry                     string `json:"dead_player_entry"`
+	DeadPlayerRevealedEntry             string `json:"dead_player_revealed_entry"`
+	PhasePanelAliveEntry                string `json:"phase_panel_alive_entry"`
+	PhasePanelDeadEntry                 string `json:"phase_panel_dead_entry"`
+	DeathCauseKilled                    string `json:"death_cause_killed"`
+	DeathCauseLynched                   string `json:"death_cause_lynched"`
+	DeathCauseRemoved                   string `json:"death_cause_removed"`
+	EliminateButton                     string `json:"eliminate_button"`
+	ReviveButton                        string `json:"revive_button"`
+	EliminateSelectPrompt               string `json:"eliminate_select_prompt"`
+	EliminateCausePrompt                string `json:"eliminate_cause_prompt"`
+	ReviveSelectPrompt                  string `json:"revive_select_prompt"`
+	NoAlivePlayers                      string `json:"no_alive_players"`
+	NoDeadPlayers                       string `json:"no_dead_players"`
+	EliminationAnnouncement             string `json:"elimination_announcement"`
+	EliminationRevealedAnnouncement     string `json:"elimination_revealed_announcement"`
+	ReviveAnnouncement                  string `json:"revive_announcement"`
+	PlayerStatusError                   string `json:"player_status_error"`
+	OpenVoteButton                      string `json:"open_vote_button"`
+	CloseVoteButton                     string `json:"close_vote_button"`
+	VoteDurationPrompt                  string `json:"vote_duration_prompt"`
+	VoteDurationButton                  string `json:"vote_duration_button"`
+	VoteMessage                         string `json:"vote_message"`
+	VoteStatusOpen                      string `json:"vote_status_open"`
+	VoteStatusClosed                    string `json:"vote_status_closed"`
+	VoteTallyEntry                      string `json:"vote_tally_entry"`
+	VoteTallyVoters                     string `json:"vote_tally_voters"`
+	VoteNotVoted                        string `json:"vote_not_voted"`
+	VoteButton                          string `json:"vote_button"`
+	VoteButtonSelected                  string `json:"vote_button_selected"`
+	VoteCastConfirmation                string `json:"vote_cast_confirmation"`
+	VoteError                           string `json:"vote_error"`
+	VoteResultLynch                     string `json:"vote_result_lynch"`
+	VoteResultLynchRevealed             string `json:"vote_result_lynch_revealed"`
+	VoteResultRevote                    string `json:"vote_result_revote"`
+	VoteResultDefense                   string `json:"vote_result_defense"`
+	VoteResultNoLynch                   string `json:"vote_result_no_lynch"`
+	PhasePanelVoteOpen                  string `json:"phase_panel_vote_open"`
+	NightActionPrompt                   string `json:"night_action_prompt"`
+	NightActionChoose                   string `json:"night_action_choose"`
+	NightActionUsesLeft                 string `json:"night_action_uses_left"`
+	NightActionChosen                   string `json:"night_action_chosen"`
+	NightActionButtonSelected           string `json:"night_action_button_selected"`
+	NightActionSubmitted                string `json:"night_action_submitted"`
+	NightActionPartial                  string `json:"night_action_partial"`
+	NightActionError                    string `json:"night_action_error"`
+	NightSummaryMessage                 string `json:"night_summary_message"`
+	NightSummaryEntry                   string `json:"night_summary_entry"`
+	NightSummaryPendingEntry            string `json:"night_summary_pending_entry"`
+	NightSummaryEmpty                   string `json:"night_summary_empty"`
+	NightSummaryButton                  string `json:"night_summary_button"`
+	ResolveNightButton                  string `json:"resolve_night_button"`
+	NightResultDeath                    string `json:"night_result_death"`
+	NightResultDeathRevealed            string `json:"night_result_death_revealed"`
+	NightResultNoDeaths                 string `json:"night_result_no_deaths"`
+	NightResultInvestigation            string `json:"night_result_investigation"`
+	NightResultInnocent                 string `json:"night_result_innocent"`
+	NightResultGuilty                   string `json:"night_result_guilty"`
+	NightResultBlocked                  string `json:"night_result_blocked"`
+	NightResolutionMessage              string `json:"night_resolution_message"`
+	NightResolutionNone                 string `json:"night_resolution_none"`
+	NightResolveError                   string `json:"night_resolve_error"`
+	GameWonAnnouncement                 string `json:"game_won_announcement"`
+	FinalRevealTitle                    string `json:"final_reveal_title"`
+	FinalRevealEntry                    string `json:"final_reveal_entry"`
+	FinalRevealDeadEntry                string `json:"final_reveal_dead_entry"`
+	EndGameWinnerButton                 string `json:"end_game_winner_button"`
+	PhasePanelWinners                   string `json:"phase_panel_winners"`
+	TimerButton                         string `json:"timer_button"`
+	StopTimerButton                     string `json:"stop_timer_button"`
+	TimerDurationPrompt                 string `json:"timer_duration_prompt"`
+	TimerDurationButton                 string `json:"timer_duration_button"`
+	TimerAutoDurationButton             string `json:"timer_auto_duration_button"`
+	TimerMessage                        string `json:"timer_message"`
+	TimerMessageAuto                    string `json:"timer_message_auto"`
+	TimerMessageExpired                 string `json:"timer_message_expired"`
+	TimerWarningAnnouncement            string `json:"timer_warning_announcement"`
+	TimerExpiredAnnouncement            string `json:"timer_expired_announcement"`
+	TimerStoppedAnnouncement            string `json:"timer_stopped_announcement"`
+	TimerError                          string `json:"timer_error"`
+	TimerMessageStopped                 string `json:"timer_message_stopped"`
+	StartTurnsButton                    string `json:"start_turns_button"`
+	NextSpeakerButton                   string `json:"next_speaker_button"`
+	SkipSpeakerButton                   string `json:"skip_speaker_button"`
+	GrantChallengeButton                string `json:"grant_challenge_button"`
+	PhasePanelSpeaker                   string `json:"phase_panel_speaker"`
+	PhasePanelChallenger                string `json:"phase_panel_challenger"`
+	PhasePanelChallengeRequests         string `json:"phase_panel_challenge_requests"`
+	PhasePanelTurnsOver                 string `json:"phase_panel_turns_over"`
+	TurnsMessage                        string `json:"turns_message"`
+	TurnsEntryCurrent                   string `json:"turns_entry_current"`
+	TurnsEntrySpoken                    string `json:"turns_entry_spoken"`
+	TurnsEntrySkipped                   string `json:"turns_entry_skipped"`
+	TurnsEntryWaiting                   string `json:"turns_entry_waiting"`
+	TurnsChallengerLine                 string `json:"turns_challenger_line"`
+	TurnsMessageOver                    string `json:"turns_message_over"`
+	TurnsMessageClosed                  string `json:"turns_message_closed"`
+	RequestChallengeButton              string `json:"request_challenge_button"`
+	TurnsYourTurn                       string `json:"turns_your_turn"`
+	TurnsChallengeGranted               string `json:"turns_challenge_granted"`
+	TurnsChallengeRequested             string `json:"turns_challenge_requested"`
+	TurnsFinishedAnnouncement           string `json:"turns_finished_announcement"`
+	TurnsError                          string `json:"turns_error"`
+	ReadyCheckButton                    string `json:"ready_check_button"`
+	ReadyCheckTimeoutPrompt             string `json:"ready_check_timeout_prompt"`
+	ReadyCheckNoTimeoutButton           string `json:"ready_check_no_timeout_button"`
+	ReadyCheckTimeoutButton             string `json:"ready_check_timeout_button"`
+	ReadyCheckPlayerPrompt              string `json:"ready_check_player_prompt"`
+	ReadyCheckPlayerReady               string `json:"ready_check_player_ready"`
+	ReadyCheckCountdownLine             string `json:"ready_check_countdown_line"`
+	ReadyButton                         string `json:"ready_button"`
+	ReadyCheckRoster                    string `json:"ready_check_roster"`
+	ReadyCheckEntryReady                string `json:"ready_check_entry_ready"`
+	ReadyCheckEntryWaiting              string `json:"ready_check_entry_waiting"`
+	ReadyCheckAllReadyLine              string `json:"ready_check_all_ready_line"`
+	ReadyCheckTimedOutLine              string `json:"ready_check_timed_out_line"`
+	ReadyCheckKickButton                string `json:"ready_check_kick_button"`
+	ReadyCheckRestartButton             string `json:"ready_check_restart_button"`
+	ReadyCheckMarked                    string `json:"ready_check_marked"`
+	ReadyCheckKickedNotice              string `json:"ready_check_kicked_notice"`
+	ReadyCheckNotReady                  string `json:"ready_check_not_ready"`
+	ReadyCheckClosed                    string `json:"ready_check_closed"`
+	ReadyCheckError                     string `json:"ready_check_error"`
+	FinalRevealCardCaption              string `json:"final_reveal_card_caption"`
+	MyRoleButton                        string `json:"my_role_button"`
+	MyRoleNone                          string `json:"my_role_none"`
+	MyRoleSent                          string `json:"my_role_sent"`
+	CreateGameScenarioUnavailableButton string `json:"create_game_scenario_unavailable_button"`
+	CreateGamePlayersRange              string `json:"create_game_players_range"`
+	CreateGamePlayersOpen               string `json:"create_game_players_open"`
+	CreateGameTooFewPlayers             string `json:"create_game_too_few_players"`
+	CreateGameTooManyPlayers            string `json:"create_game_too_many_players"`
+	CreateGameRoleMismatch              string `json:"create_game_role_mismatch"`
+	CreateGamePlayersUnknown            string `json:"create_game_players_unknown"`
+	ReadyCheckLeftSeat                  string `json:"ready_check_left_seat"`
+	NightActionResolved                 string `json:"night_action_resolved"`
 }
 
 type RefreshMessages struct {
@@ -0,0 +1,16 @@
+package common
+
+import "time"
+
+// Clock tells the time; use cases take one so deadlines can be tested without waiting
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the wall clock
+type SystemClock struct{}
+
+// Now returns the current time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
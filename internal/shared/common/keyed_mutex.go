@@ -0,0 +1,41 @@
+package common
+
+import "sync"
+
+// KeyedMutex hands out one mutex per key, so callers working on the same key run one at a time
+// while callers on different keys do not wait for each other. The zero value is ready to use.
+type KeyedMutex[K comparable] struct {
+	mutex sync.Mutex
+	locks map[K]*keyedLock
+}
+
+type keyedLock struct {
+	mutex sync.Mutex
+	users int // Callers holding or waiting for the lock; the entry is dropped when it reaches 0
+}
+
+// Lock blocks until no other caller holds the key and returns the function that releases it
+func (m *KeyedMutex[K]) Lock(key K) (unlock func()) {
+	m.mutex.Lock()
+	if m.locks == nil {
+		m.locks = make(map[K]*keyedLock)
+	}
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &keyedLock{}
+		m.locks[key] = lock
+	}
+	lock.users++
+	m.mutex.Unlock()
+
+	lock.mutex.Lock()
+	return func() {
+		lock.mutex.Unlock()
+		m.mutex.Lock()
+		lock.users--
+		if lock.users == 0 {
+			delete(m.locks, key)
+		}
+		m.mutex.Unlock()
+	}
+}
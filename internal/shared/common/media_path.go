@@ -0,0 +1,19 @@
+package common
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+// ErrInvalidMediaPath is returned for media paths that point outside the media directory
+var ErrInvalidMediaPath = errors.New("media paths must be relative to the media directory")
+
+// CleanMediaPath normalises a media path and rejects paths that leave the media directory
+func CleanMediaPath(path string) (string, error) {
+	path = filepath.ToSlash(filepath.Clean(strings.TrimSpace(path)))
+	if path == "." || !filepath.IsLocal(path) {
+		return "", ErrInvalidMediaPath
+	}
+	return path, nil
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+// HashPassword returns a salted SHA-256 hash of the password as "salt$hash", so the password itself need not be stored
+func HashPassword(password string) string {
+	salt := RandomToken(16)
+	return salt + "$" + saltedHash(salt, password)
+}
+
+// CheckPassword reports whether the password matches a hash made by HashPassword, comparing in constant time
+func CheckPassword(hash, password string) bool {
+	salt, want, ok := strings.Cut(hash, "$")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(saltedHash(salt, password)), []byte(want)) == 1
+}
+
+func saltedHash(salt, password string) string {
+	sum := sha256.Sum256([]byte(salt + password))
+	return hex.EncodeToString(sum[:])
+}
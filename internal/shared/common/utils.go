@@ -1,9 +1,11 @@
 package common
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
-	"math/rand"
+	mathrand "math/rand"
 	"strconv"
 	"time"
 )
@@ -34,18 +36,27 @@ func Hash(s string) string {
 	return string(h.Sum(nil))
 }
 
-var r *rand.Rand
+var r *mathrand.Rand
 
 func InitSeed() {
 	// Seed random number generator
-	source := rand.NewSource(time.Now().UnixNano())
-	r = rand.New(source)
+	source := mathrand.NewSource(time.Now().UnixNano())
+	r = mathrand.New(source)
 }
 
 func Shuffle(n int, swap func(i, j int)) {
 	r.Shuffle(n, swap)
 }
 
+// RandomToken returns n random bytes as hex, for codes that must not be guessable
+func RandomToken(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic("common: crypto/rand failed: " + err.Error())
+	}
+	return hex.EncodeToString(b)
+}
+
 var escapeChars = "_*[]()~`>#+-=|{}.!"
 
 func EscapeMarkdownV2(text string) string {
@@ -4,6 +4,7 @@ package tgutil
 const (
 	// Join/Leave related
 	UniqueJoinRoom            = "join_room"
+	UniqueWatchRoom           = "watch_room" // Follows a room as a spectator
 	UniqueLeaveRoomSelectRoom = "leave_room_select"
 	UniqueLeaveRoomConfirm    = "leave_room_confirm"
 
@@ -22,6 +23,12 @@ const (
 	UniquePlayerSelectsCard        = "cg_sel_card" // Player clicks a numbered card
 	UniqueCancelGame               = "cancel_cg"
 
+	// Ready-check (between scenario selection and role assignment)
+	UniqueReadyCheckSelect = "rc_sel"   // Asks the moderator for the ready-check timeout
+	UniqueStartReadyCheck  = "rc_start" // Sends every player the ready button (gameID|seconds, 0 for no timeout)
+	UniqueMarkReady        = "rc_ready" // A player confirms they are ready
+	UniqueKickUnready      = "rc_kick"  // Drops the players who did not confirm from the room
+
 	// Kick User Flow
 	UniqueKickUserSelect  = "kick_user_select"  // Shows the list of users to kick
 	UniqueKickUserConfirm = "kick_user_confirm" // Confirms kicking the selected user
@@ -30,6 +37,88 @@ const (
 	UniqueChangeModeratorSelect  = "mod_user_select"  // Shows the list of users to make moderator
 	UniqueChangeModeratorConfirm = "mod_user_confirm" // Confirms setting the selected user as moderator
 
+	// Seat order
+	UniqueSeatsSelect  = "seats_select"  // Shows the seat editor of a room
+	UniqueMoveSeat     = "seats_move"    // Moves a player one seat up or down
+	UniqueShuffleSeats = "seats_shuffle" // Seats the players in a random order
+	UniqueSeatsBack    = "seats_back"    // Returns to the room detail
+
+	// Room capacity
+	UniqueCapacitySelect = "cap_select" // Shows the capacity choices of a room
+	UniqueSetCapacity    = "cap_set"    // Sets the capacity of a room (roomID|max, 0 for no limit)
+
+	// Room visibility and invite links
+	UniqueVisibilitySelect = "vis_select" // Shows the visibility choices of a room
+	UniqueSetVisibility    = "vis_set"    // Sets the visibility of a room (roomID|visibility)
+	UniqueResetInvite      = "inv_reset"  // Regenerates or revokes the invite link (roomID|new or roomID|revoke)
+
+	// Scenario upload conflict (same ID or name as a stored scenario)
+	UniqueScenarioUploadReplace   = "scen_up_replace" // Overwrite the existing scenario
+	UniqueScenarioUploadCreateNew = "scen_up_new"     // Keep both
+	UniqueScenarioUploadCancel    = "scen_up_cancel"  // Drop the upload
+
+	// Scenario list and detail
+	UniqueScenarioList    = "scen_list"    // Shows the list of scenarios
+	UniqueScenarioDetail  = "scen_detail"  // Shows the sides and roles of a scenario
+	UniqueScenarioPreview = "scen_preview" // Shows the roles handed out for a player count (scenarioID|players)
+	UniqueScenarioExport  = "scen_export"  // Sends the scenario as a JSON document (admins only)
+
+	// Scenario editor (payloads start with scenarioID|sideIndex)
+	UniqueScenarioEditOpen       = "sce_open"     // Shows the sides of a scenario
+	UniqueScenarioEditSide       = "sce_side"     // Shows the roles and settings of a side
+	UniqueScenarioEditAsk        = "sce_ask"      // Asks for a name in private chat (scenarioID|sideIndex|action)
+	UniqueScenarioRemoveSide     = "sce_rm_side"  // Removes a side
+	UniqueScenarioRemoveRole     = "sce_rm_role"  // Removes a role (scenarioID|sideIndex|roleIndex|roleTag)
+	UniqueScenarioAddedAtSelect  = "sce_at_sel"   // Shows the added_at choices of a role
+	UniqueScenarioSetAddedAt     = "sce_at"       // Sets added_at of a role (scenarioID|sideIndex|roleIndex|roleTag|players)
+	UniqueScenarioRateSelect     = "sce_rate_sel" // Shows the population rate choices of a side
+	UniqueScenarioSetRate        = "sce_rate"     // Sets the population rate (scenarioID|sideIndex|choice, -1 for none)
+	UniqueScenarioDefaultSelect  = "sce_def_sel"  // Shows the default role choices of a side
+	UniqueScenarioSetDefaultRole = "sce_def"      // Copies a role as default (scenarioID|sideIndex|roleIndex|roleTag, -1 for none)
+
+	// Game phase panel
+	UniquePhasePanelOpen    = "ph_panel"   // Sends the moderator panel of a game
+	UniquePhasePanelBack    = "ph_back"    // Redraws the panel after a declined confirmation
+	UniquePhaseStartNight   = "ph_night"   // -> Night N+1
+	UniquePhaseStartDay     = "ph_day"     // -> Day N
+	UniquePhaseStartVoting  = "ph_vote"    // -> Day vote
+	UniquePhaseStartDefense = "ph_defense" // -> Defense speeches
+	UniqueEndGame           = "ph_end"     // Asks to confirm ending the game
+	UniqueEndGameConfirm    = "ph_end_ok"  // Finishes the game
+	UniqueEndGameWinner     = "ph_end_win" // Finishes the game with the chosen side as the winner
+
+	// Player life status (from the phase panel)
+	UniqueEliminateSelect  = "el_sel"   // Lists the alive players
+	UniqueEliminateCause   = "el_cause" // Asks how the selected player died
+	UniqueEliminateConfirm = "el_ok"    // Records the death
+	UniqueReviveSelect     = "rv_sel"   // Lists the dead players
+	UniqueReviveConfirm    = "rv_ok"    // Brings the selected player back
+
+	// Day vote
+	UniqueOpenVoteSelect = "vote_sel"   // Asks the moderator for the vote duration
+	UniqueOpenVote       = "vote_open"  // Opens the vote with the chosen duration
+	UniqueCastVote       = "vote_cast"  // A player votes for a candidate
+	UniqueCloseVote      = "vote_close" // The moderator closes the vote early
+
+	// Phase timers
+	UniqueTimerSelect = "tm_sel"   // Asks the moderator for the timer duration
+	UniqueStartTimer  = "tm_start" // Starts the timer with the chosen duration
+	UniqueStopTimer   = "tm_stop"  // Cancels the running timer
+
+	// Speaking turns
+	UniqueStartTurns       = "st_start" // Starts the talk order of the day or defense
+	UniqueNextSpeaker      = "st_next"  // Ends the current turn, as spoken or skipped
+	UniqueRequestChallenge = "st_req"   // A player asks the current speaker for a challenge
+	UniqueGrantChallenge   = "st_grant" // The moderator grants a challenge request
+
+	// Night actions
+	UniqueNightActionPick = "na_pick" // A role holder toggles a target of an ability
+	UniqueNightSummary    = "ns_show" // Sends the moderator the night summary
+	UniqueResolveNight    = "ns_res"  // Applies tonight's actions
+
+	// Role help
+	UniqueMyRole = "my_role" // Sends a player their role with its description again
+
 	// Common
 	UniqueCancel = "cancel"
 )
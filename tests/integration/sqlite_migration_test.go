@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	sqliterepo "telemafia/internal/adapters/repository/sqlite"
+)
+
+func TestSQLiteMigrationsUpDownAndTooNew(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "telemafia.db")
+	db, err := sqliterepo.Connect(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer db.Close()
+
+	migrator, err := sqliterepo.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("Failed to load migrations: %v", err)
+	}
+	if migrator.Latest() < 1 {
+		t.Fatalf("Expected at least one embedded migration, got latest %d", migrator.Latest())
+	}
+
+	applied, err := migrator.Up()
+	if err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	if len(applied) != migrator.Latest() {
+		t.Errorf("Expected %d migrations applied, got %v", migrator.Latest(), applied)
+	}
+	if again, err := migrator.Up(); err != nil || len(again) != 0 {
+		t.Errorf("Second Up should be a no-op, got %v (err %v)", again, err)
+	}
+
+	reverted, err := migrator.Down(migrator.Latest())
+	if err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+	if len(reverted) != migrator.Latest() {
+		t.Errorf("Expected all migrations reverted, got %v", reverted)
+	}
+	if _, err := db.Exec(`SELECT 1 FROM rooms`); err == nil {
+		t.Errorf("Expected rooms table to be dropped after reverting all migrations")
+	}
+
+	// A database touched by a newer binary must be rejected
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("Re-applying migrations failed: %v", err)
+	}
+	if _, err := db.Exec(`INSERT INTO schema_migrations (version, name, applied_at) VALUES (9999, 'future', CURRENT_TIMESTAMP)`); err != nil {
+		t.Fatalf("Failed to fake a future migration: %v", err)
+	}
+	db.Close()
+
+	if _, err := sqliterepo.Open(dbPath); !errors.Is(err, sqliterepo.ErrSchemaTooNew) {
+		t.Errorf("Expected ErrSchemaTooNew when opening a newer schema, got %v", err)
+	}
+}
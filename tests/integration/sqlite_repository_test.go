@@ -0,0 +1,792 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	fsAdapter "telemafia/internal/adapters/filesystem"
+	sqliterepo "telemafia/internal/adapters/repository/sqlite"
+	gameEntity "telemafia/internal/domain/game/entity"
+	mediaEntity "telemafia/internal/domain/media/entity"
+	roomEntity "telemafia/internal/domain/room/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestSQLiteRepositoriesSurviveReopen(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "telemafia.db")
+	db, err := sqliterepo.Open(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+
+	roomRepo := sqliterepo.NewSQLiteRoomRepository(db)
+	scenarioRepo := sqliterepo.NewSQLiteScenarioRepository(db)
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+
+	// Scenario: load a bundled file through the regular use case
+	data, err := os.ReadFile("../../resources/scenario/royabin.json") // Declares max_players
+	if err != nil {
+		t.Fatalf("Failed to read scenario file: %v", err)
+	}
+	scenario, err := scenarioCommand.NewAddScenarioJSONHandler(scenarioRepo).Handle(context.Background(), scenarioCommand.AddScenarioJSONCommand{
+		Requester: sharedEntity.User{Admin: true},
+		JSONData:  strings.TrimSpace(string(data)),
+	})
+	if err != nil {
+		t.Fatalf("Failed to add scenario: %v", err)
+	}
+	// Snapshot now: GetRoles below fills in Side on the default roles of the in-memory copy
+	wantScenarioJSON, _ := json.Marshal(scenario)
+
+	// Room with moderator, players and a description
+	moderator := &sharedEntity.User{ID: 1, FirstName: "Mod", Username: "mod"}
+	room, err := roomEntity.NewRoom("room_1", "Friday night", moderator)
+	if err != nil {
+		t.Fatalf("Failed to build room: %v", err)
+	}
+	room.ScenarioName = scenario.Name
+	room.SetDescription("rules", "no talking at night")
+	if err := roomRepo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	for i := 2; i <= 4; i++ {
+		player := &sharedEntity.User{ID: sharedEntity.UserID(i), FirstName: "Player"}
+		if err := roomRepo.AddPlayerToRoom(room.ID, player); err != nil {
+			t.Fatalf("Failed to add player %d: %v", i, err)
+		}
+	}
+	if err := roomRepo.RemovePlayerFromRoom(room.ID, 3); err != nil {
+		t.Fatalf("Failed to remove player: %v", err)
+	}
+
+	// Game with assignments
+	storedRoom, err := roomRepo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to get room: %v", err)
+	}
+	game := &gameEntity.Game{
+		ID:          "game_1",
+		State:       gameEntity.GameStateWaitingForPlayers,
+		Room:        storedRoom,
+		Scenario:    scenario,
+		Assignments: make(map[sharedEntity.UserID]scenarioEntity.Role),
+	}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	roles := scenario.GetRoles(2)
+	game.AssignRole(2, roles[0])
+	game.AssignRole(4, roles[1])
+	game.SetRolesAssigned()
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+	db.Close()
+
+	// Reopen and verify everything came back
+	db, err = sqliterepo.Open(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	defer db.Close()
+	roomRepo = sqliterepo.NewSQLiteRoomRepository(db)
+	scenarioRepo = sqliterepo.NewSQLiteScenarioRepository(db)
+	gameRepo = sqliterepo.NewSQLiteGameRepository(db)
+
+	loadedScenario, err := scenarioRepo.GetScenarioByID(scenario.ID)
+	if err != nil {
+		t.Fatalf("Failed to load scenario: %v", err)
+	}
+	gotScenarioJSON, _ := json.Marshal(loadedScenario)
+	if loadedScenario.ID != scenario.ID || string(gotScenarioJSON) != string(wantScenarioJSON) {
+		t.Errorf("Scenario mismatch after reopen:\n got  %s\n want %s", gotScenarioJSON, wantScenarioJSON)
+	}
+
+	loadedRoom, err := roomRepo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load room: %v", err)
+	}
+	if loadedRoom.Moderator == nil || loadedRoom.Moderator.Username != "mod" {
+		t.Errorf("Expected moderator 'mod', got %+v", loadedRoom.Moderator)
+	}
+	if len(loadedRoom.Players) != 2 || loadedRoom.Players[0].ID != 2 || loadedRoom.Players[1].ID != 4 {
+		t.Errorf("Expected players [2 4] in join order, got %+v", loadedRoom.Players)
+	}
+	if loadedRoom.Description["rules"] != "no talking at night" {
+		t.Errorf("Description not persisted: %+v", loadedRoom.Description)
+	}
+	if !loadedRoom.CreatedAt.Equal(room.CreatedAt) {
+		t.Errorf("CreatedAt mismatch: got %v want %v", loadedRoom.CreatedAt, room.CreatedAt)
+	}
+
+	loadedGame, err := gameRepo.GetGameByRoomID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if loadedGame.State != gameEntity.GameStateRolesAssigned {
+		t.Errorf("Expected state %s, got %s", gameEntity.GameStateRolesAssigned, loadedGame.State)
+	}
+	if !reflect.DeepEqual(loadedGame.Assignments, game.Assignments) {
+		t.Errorf("Assignments mismatch: got %+v want %+v", loadedGame.Assignments, game.Assignments)
+	}
+	if loadedGame.Scenario == nil || loadedGame.Scenario.Name != scenario.Name {
+		t.Errorf("Game scenario not restored: %+v", loadedGame.Scenario)
+	}
+
+	// The winner of a finished game is kept
+	if err := loadedGame.DeclareWinner(scenario.Sides[1].Name); err != nil {
+		t.Fatalf("DeclareWinner failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(loadedGame); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+	finished, err := gameRepo.GetGameByID(loadedGame.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if finished.State != gameEntity.GameStateFinished || !reflect.DeepEqual(finished.Winners, loadedGame.Winners) {
+		t.Errorf("Winners not persisted: got %s %v want %v", finished.State, finished.Winners, loadedGame.Winners)
+	}
+
+	// Deleting the room cascades to players and descriptions
+	if err := roomRepo.DeleteRoom(room.ID); err != nil {
+		t.Fatalf("Failed to delete room: %v", err)
+	}
+	if _, err := roomRepo.GetRoomByID(room.ID); !errors.Is(err, roomEntity.ErrRoomNotFound) {
+		t.Errorf("Expected ErrRoomNotFound after delete, got %v", err)
+	}
+}
+
+func TestSQLiteRoleSelectionRoundTrip(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	moderator := &sharedEntity.User{ID: 1, FirstName: "Mod"}
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", moderator)
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	game := &gameEntity.Game{ID: "game_1", State: gameEntity.GameStateRoleSelection, Room: room}
+	if err := sqliterepo.NewSQLiteGameRepository(db).CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	repo := sqliterepo.NewSQLiteRoleSelectionRepository(db)
+	selection := &gameEntity.RoleSelection{
+		GameID:        game.ID,
+		ShuffledRoles: []scenarioEntity.Role{{Name: "Doctor", Side: "Town"}, {Name: "Godfather", Side: "Mafia"}},
+		Choices: map[sharedEntity.UserID]gameEntity.RoleChoice{
+			7: {ChosenIndex: 2, Player: sharedEntity.User{ID: 7, FirstName: "Sara", Username: "sara"}},
+		},
+		Messages: []gameEntity.RoleSelectionMessage{
+			{Audience: gameEntity.RoleSelectionAudienceAdmin, ChatID: 1, MessageID: 100},
+			{Audience: gameEntity.RoleSelectionAudiencePlayer, ChatID: 8, MessageID: 101},
+		},
+	}
+	if err := repo.SaveRoleSelection(selection); err != nil {
+		t.Fatalf("Failed to save role selection: %v", err)
+	}
+
+	all, err := repo.GetAllRoleSelections()
+	if err != nil || len(all) != 1 {
+		t.Fatalf("Expected one saved selection, got %d (err %v)", len(all), err)
+	}
+	got := all[0]
+	if !reflect.DeepEqual(got.ShuffledRoles, selection.ShuffledRoles) {
+		t.Errorf("Roles mismatch: got %+v", got.ShuffledRoles)
+	}
+	if !reflect.DeepEqual(got.Choices, selection.Choices) {
+		t.Errorf("Choices mismatch: got %+v", got.Choices)
+	}
+	if len(got.Messages) != 2 {
+		t.Errorf("Expected 2 tracked messages, got %+v", got.Messages)
+	}
+	if !got.TakenIndices()[2] {
+		t.Errorf("Expected card 2 to be taken")
+	}
+
+	if err := repo.DeleteRoleSelection(game.ID); err != nil {
+		t.Fatalf("Failed to delete role selection: %v", err)
+	}
+	if _, err := repo.GetRoleSelection(game.ID); err == nil {
+		t.Errorf("Expected error after deleting role selection")
+	}
+}
+
+func TestSQLiteGamePhaseAndStatusPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{
+		ID:          "game_1",
+		State:       gameEntity.GameStateRolesAssigned,
+		Room:        room,
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{2: {Name: "Doctor", Side: "Town"}, 3: {Name: "Godfather", Side: "Mafia"}},
+	}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	startedAt := time.Date(2025, 3, 1, 21, 30, 0, 0, time.UTC)
+	if err := game.AdvancePhase(gameEntity.PhaseNight, startedAt); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.Eliminate(3, gameEntity.DeathCauseKilled); err != nil {
+		t.Fatalf("Eliminate failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if loaded.State != gameEntity.GameStateInProgress {
+		t.Errorf("Expected state %s, got %s", gameEntity.GameStateInProgress, loaded.State)
+	}
+	if loaded.Phase.Type != gameEntity.PhaseNight || loaded.Phase.Number != 1 || !loaded.Phase.StartedAt.Equal(startedAt) {
+		t.Errorf("Phase not persisted: %+v", loaded.Phase)
+	}
+	if !reflect.DeepEqual(loaded.Statuses, game.Statuses) || !loaded.IsAlive(2) {
+		t.Errorf("Statuses not persisted: got %+v want %+v", loaded.Statuses, game.Statuses)
+	}
+}
+
+func TestSQLiteGameVotePersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{
+		ID:    "game_1",
+		State: gameEntity.GameStateRolesAssigned,
+		Room:  room,
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{
+			2: {Name: "Doctor", Side: "Town"}, 3: {Name: "Godfather", Side: "Mafia"}, 4: {Name: "Citizen", Side: "Town"},
+		},
+	}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	openedAt := time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC)
+	for _, next := range []gameEntity.PhaseType{gameEntity.PhaseDay, gameEntity.PhaseVoting} {
+		if err := game.AdvancePhase(next, openedAt); err != nil {
+			t.Fatalf("AdvancePhase(%s) failed: %v", next, err)
+		}
+	}
+	if err := game.OpenVote(openedAt, 90*time.Second); err != nil {
+		t.Fatalf("OpenVote failed: %v", err)
+	}
+	if err := game.CastVote(2, 3, openedAt); err != nil {
+		t.Fatalf("CastVote failed: %v", err)
+	}
+	if err := game.CastVote(4, 3, openedAt); err != nil {
+		t.Fatalf("CastVote failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	vote := loaded.Vote
+	if !vote.IsOpen() || vote.Duration != 90*time.Second || !vote.ClosesAt.Equal(openedAt.Add(90*time.Second)) {
+		t.Fatalf("Open vote not persisted: %+v", vote)
+	}
+	if !reflect.DeepEqual(vote.Ballots, game.Vote.Ballots) || !reflect.DeepEqual(vote.Candidates, game.Vote.Candidates) {
+		t.Errorf("Ballots not persisted: got %+v want %+v", vote, game.Vote)
+	}
+
+	if _, err := loaded.CloseVote(openedAt); err != nil {
+		t.Fatalf("CloseVote failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(loaded); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+	closed, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if closed.Vote.IsOpen() || closed.Vote.Outcome != gameEntity.VoteOutcomeLynch || closed.IsAlive(3) {
+		t.Errorf("Closed vote not persisted: %+v", closed.Vote)
+	}
+}
+
+func TestSQLiteNightActionsPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{
+		ID:    "game_1",
+		State: gameEntity.GameStateRolesAssigned,
+		Room:  room,
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{
+			2: {Name: "Doctor", Side: "Town", Abilities: []scenarioEntity.Ability{{Name: "save", Uses: 3}}},
+			3: {Name: "Godfather", Side: "Mafia"},
+		},
+	}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseNight, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.SubmitNightAction(2, "save", []sharedEntity.UserID{3}); err != nil {
+		t.Fatalf("SubmitNightAction failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.NightActions, game.NightActions) {
+		t.Errorf("Night actions not persisted: got %+v want %+v", loaded.NightActions, game.NightActions)
+	}
+	if ability, ok := loaded.Assignments[2].Ability("save"); !ok || ability.Uses != 3 {
+		t.Errorf("Role abilities not persisted: %+v", loaded.Assignments[2])
+	}
+
+	if _, err := loaded.ResolveNight(gameEntity.NewNightResolver()); err != nil {
+		t.Fatalf("ResolveNight failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(loaded); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+	resolved, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if resolved.ResolvedNight != 1 {
+		t.Errorf("Expected resolved night 1 to be persisted, got %d", resolved.ResolvedNight)
+	}
+}
+
+func TestSQLiteGameTimerPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{ID: "game_1", State: gameEntity.GameStateRolesAssigned, Room: room}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	startedAt := time.Date(2025, 3, 2, 21, 0, 0, 0, time.UTC)
+	if err := game.AdvancePhase(gameEntity.PhaseDay, startedAt); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.StartTimer(startedAt, 2*time.Minute, true); err != nil {
+		t.Fatalf("StartTimer failed: %v", err)
+	}
+	if _, err := game.TickTimer(startedAt.Add(100 * time.Second)); err != nil {
+		t.Fatalf("TickTimer failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	timer := loaded.Timer
+	if !timer.IsRunning() || timer.Phase != game.Timer.Phase || timer.Duration != 2*time.Minute ||
+		!timer.Deadline.Equal(startedAt.Add(2*time.Minute)) || !timer.AutoAdvance || timer.Warned != 30*time.Second {
+		t.Fatalf("Timer not persisted: got %+v want %+v", timer, game.Timer)
+	}
+
+	// A reload after the deadline still expires the timer and moves the day on
+	tick, err := loaded.TickTimer(startedAt.Add(3 * time.Minute))
+	if err != nil || !tick.Advanced || loaded.Phase.Type != gameEntity.PhaseVoting {
+		t.Errorf("Expected the reloaded timer to advance to voting, got %+v in %s (%v)", tick, loaded.Phase.Type, err)
+	}
+}
+
+func TestSQLiteSpeakingTurnsPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{
+		ID:    "game_1",
+		State: gameEntity.GameStateRolesAssigned,
+		Room:  room,
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{
+			2: {Name: "Doctor", Side: "Town"}, 3: {Name: "Godfather", Side: "Mafia"}, 4: {Name: "Citizen", Side: "Town"},
+		},
+	}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseDay, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.StartSpeakingTurns(); err != nil {
+		t.Fatalf("StartSpeakingTurns failed: %v", err)
+	}
+	if _, _, err := game.NextSpeaker(true); err != nil {
+		t.Fatalf("NextSpeaker failed: %v", err)
+	}
+	if err := game.RequestChallenge(2); err != nil {
+		t.Fatalf("RequestChallenge failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Turns, game.Turns) {
+		t.Errorf("Speaking turns not persisted: got %+v want %+v", loaded.Turns, game.Turns)
+	}
+}
+
+func TestSQLiteSeatsPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	roomRepo := sqliterepo.NewSQLiteRoomRepository(db)
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := roomRepo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	for _, id := range []sharedEntity.UserID{2, 3, 4} {
+		if err := roomRepo.AddPlayerToRoom(room.ID, &sharedEntity.User{ID: id, FirstName: "P"}); err != nil {
+			t.Fatalf("Failed to add player %d: %v", id, err)
+		}
+	}
+	stored, err := roomRepo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load room: %v", err)
+	}
+	if err := stored.MoveSeat(4, -2); err != nil {
+		t.Fatalf("MoveSeat failed: %v", err)
+	}
+	if err := roomRepo.UpdateRoom(stored); err != nil {
+		t.Fatalf("Failed to update room: %v", err)
+	}
+	players, err := roomRepo.GetPlayersInRoom(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load players: %v", err)
+	}
+	var seats []sharedEntity.UserID
+	for _, p := range players {
+		seats = append(seats, p.ID)
+	}
+	if want := []sharedEntity.UserID{4, 2, 3}; !reflect.DeepEqual(seats, want) {
+		t.Fatalf("Room seats not persisted: got %v want %v", seats, want)
+	}
+
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{
+		ID:   "game_1",
+		Room: stored,
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{
+			2: {Name: "Doctor", Side: "Town"}, 3: {Name: "Godfather", Side: "Mafia"}, 4: {Name: "Citizen", Side: "Town"},
+		},
+	}
+	game.SetRolesAssigned()
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Seats, []sharedEntity.UserID{4, 2, 3}) {
+		t.Errorf("Game seats not persisted: got %v", loaded.Seats)
+	}
+}
+
+func TestSQLiteGameKeepsItsScenario(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	scenarioRepo := sqliterepo.NewSQLiteScenarioRepository(db)
+	scenario := &scenarioEntity.Scenario{ID: "classic", Name: "Classic", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", Roles: []scenarioEntity.Role{{Name: "Godfather"}}},
+		{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Doctor"}, {Name: "Detective"}}},
+	}}
+	if err := scenarioRepo.CreateScenario(scenario); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{ID: "game_1", State: gameEntity.GameStateWaitingForPlayers, Room: room, Scenario: scenario}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	want, _ := json.Marshal(scenario)
+
+	// Editing the scenario must not reach the running game
+	edited := &scenarioEntity.Scenario{ID: "classic", Name: "Classic v2", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", Roles: []scenarioEntity.Role{{Name: "Godfather"}, {Name: "Silencer"}}},
+		{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Doctor"}}},
+	}}
+	if err := scenarioRepo.UpdateScenario(edited); err != nil {
+		t.Fatalf("Failed to update scenario: %v", err)
+	}
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if got, _ := json.Marshal(loaded.Scenario); string(got) != string(want) {
+		t.Errorf("Game picked up the edited scenario:\n got  %s\n want %s", got, want)
+	}
+
+	// Nor must deleting it
+	if err := scenarioRepo.DeleteScenario(scenario.ID); err != nil {
+		t.Fatalf("Failed to delete scenario: %v", err)
+	}
+	loaded, err = gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if got, _ := json.Marshal(loaded.Scenario); string(got) != string(want) {
+		t.Errorf("Game lost its scenario after the delete:\n got  %s\n want %s", got, want)
+	}
+	if roles := loaded.Scenario.GetRoles(3); len(roles) != 3 {
+		t.Errorf("Expected the stored scenario to still deal 3 roles, got %v", roles)
+	}
+}
+
+func TestSQLiteSeededScenariosStayUnchanged(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	// The checksum of every bundled file must survive the trip through the scenario tables
+	repo := sqliterepo.NewSQLiteScenarioRepository(db)
+	seed := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource("../../resources/scenario"), repo)
+	if _, err := seed.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{}); err != nil {
+		t.Fatalf("Seeding failed: %v", err)
+	}
+	results, err := seed.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{})
+	if err != nil {
+		t.Fatalf("Reseeding failed: %v", err)
+	}
+	for _, r := range results {
+		if r.Err != nil || !r.Unchanged {
+			t.Errorf("Expected %s to be up to date after seeding, got %+v", r.File, r)
+		}
+	}
+}
+
+func TestSQLiteRoomCapacitySpectatorsAndVisibilityPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	roomRepo := sqliterepo.NewSQLiteRoomRepository(db)
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	room.MaxPlayers = 1
+	room.AddPlayer(&sharedEntity.User{ID: 2, FirstName: "P"})
+	room.AddToWaitlist(&sharedEntity.User{ID: 4, FirstName: "W"})
+	room.AddToWaitlist(&sharedEntity.User{ID: 3, FirstName: "W"})
+	if err := roomRepo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+
+	loaded, err := roomRepo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load room: %v", err)
+	}
+	if loaded.MaxPlayers != 1 {
+		t.Errorf("Capacity not persisted: got %d", loaded.MaxPlayers)
+	}
+	var waiting []sharedEntity.UserID
+	for _, p := range loaded.Waitlist {
+		waiting = append(waiting, p.ID)
+	}
+	if want := []sharedEntity.UserID{4, 3}; !reflect.DeepEqual(waiting, want) {
+		t.Errorf("Waitlist not persisted: got %v want %v", waiting, want)
+	}
+
+	// Spectators are stored apart from the players
+	if err := loaded.AddSpectator(&sharedEntity.User{ID: 5, FirstName: "S"}); err != nil {
+		t.Fatalf("AddSpectator failed: %v", err)
+	}
+	if err := roomRepo.UpdateRoom(loaded); err != nil {
+		t.Fatalf("Failed to update room: %v", err)
+	}
+	loaded, err = roomRepo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load room: %v", err)
+	}
+	if len(loaded.Spectators) != 1 || loaded.Spectators[0].ID != 5 || len(loaded.Players) != 1 {
+		t.Errorf("Spectators not persisted: got %v with %d players", loaded.Spectators, len(loaded.Players))
+	}
+	if loaded.EffectiveVisibility() != roomEntity.VisibilityPublic {
+		t.Errorf("Expected rooms to default to public, got %q", loaded.Visibility)
+	}
+
+	// Visibility, password and invite code survive a reload
+	if err := loaded.SetVisibility(roomEntity.VisibilityPassword, "1234"); err != nil {
+		t.Fatalf("SetVisibility failed: %v", err)
+	}
+	loaded.RegenerateInvite("abc123")
+	if err := roomRepo.UpdateRoom(loaded); err != nil {
+		t.Fatalf("Failed to update room: %v", err)
+	}
+	loaded, err = roomRepo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load room: %v", err)
+	}
+	if loaded.Visibility != roomEntity.VisibilityPassword || loaded.InviteCode != "abc123" {
+		t.Errorf("Visibility not persisted: got %q %q", loaded.Visibility, loaded.InviteCode)
+	}
+	if strings.Contains(loaded.PasswordHash, "1234") || loaded.Admit("", "1234") != nil {
+		t.Errorf("Expected only a hash of the password to be stored, got %q", loaded.PasswordHash)
+	}
+}
+
+func TestSQLiteReadyCheckPersisted(t *testing.T) {
+	db, err := sqliterepo.Open(filepath.Join(t.TempDir(), "telemafia.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	room, _ := roomEntity.NewRoom("room_1", "Friday night", &sharedEntity.User{ID: 1, FirstName: "Mod"})
+	if err := sqliterepo.NewSQLiteRoomRepository(db).CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	gameRepo := sqliterepo.NewSQLiteGameRepository(db)
+	game := &gameEntity.Game{ID: "game_1", State: gameEntity.GameStateWaitingForPlayers, Room: room}
+	if err := game.StartReadyCheck([]sharedEntity.UserID{2, 3}, time.Time{}, 0); err != nil {
+		t.Fatalf("StartReadyCheck failed: %v", err)
+	}
+	_ = game.MarkReady(3)
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	loaded, err := gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.ReadyCheck, game.ReadyCheck) {
+		t.Fatalf("Untimed ready-check not persisted: got %+v want %+v", loaded.ReadyCheck, game.ReadyCheck)
+	}
+
+	startedAt := time.Date(2025, 3, 2, 21, 0, 0, 0, time.UTC)
+	if err := game.StartReadyCheck([]sharedEntity.UserID{2, 3}, startedAt, 2*time.Minute); err != nil {
+		t.Fatalf("StartReadyCheck failed: %v", err)
+	}
+	if err := gameRepo.UpdateGame(game); err != nil {
+		t.Fatalf("Failed to update game: %v", err)
+	}
+	loaded, err = gameRepo.GetGameByID(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to load game: %v", err)
+	}
+	check := loaded.ReadyCheck
+	if !check.IsTimed() || !check.Deadline.Equal(startedAt.Add(2*time.Minute)) || len(check.Ready) != 0 {
+		t.Errorf("Timed ready-check not persisted: got %+v", check)
+	}
+}
+
+func TestSQLiteMediaCachePersisted(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "telemafia.db")
+	db, err := sqliterepo.Open(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	cache := sqliterepo.NewSQLiteMediaCacheRepository(db)
+	if _, err := cache.GetCachedMedia(1, "images/a.jpg"); !errors.Is(err, mediaEntity.ErrMediaNotCached) {
+		t.Fatalf("Expected ErrMediaNotCached, got %v", err)
+	}
+	for _, fileID := range []string{"old", "new"} {
+		if err := cache.SaveCachedMedia(&mediaEntity.CachedMedia{BotID: 1, Path: "images/a.jpg", Checksum: "sum-" + fileID, FileID: fileID}); err != nil {
+			t.Fatalf("Failed to save cached media: %v", err)
+		}
+	}
+	db.Close()
+
+	db, err = sqliterepo.Open(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reopen database: %v", err)
+	}
+	defer db.Close()
+	cached, err := sqliterepo.NewSQLiteMediaCacheRepository(db).GetCachedMedia(1, "images/a.jpg")
+	if err != nil {
+		t.Fatalf("Failed to load cached media: %v", err)
+	}
+	if cached.FileID != "new" || cached.Checksum != "sum-new" {
+		t.Errorf("Expected the latest upload, got %+v", cached)
+	}
+	if _, err := sqliterepo.NewSQLiteMediaCacheRepository(db).GetCachedMedia(2, "images/a.jpg"); !errors.Is(err, mediaEntity.ErrMediaNotCached) {
+		t.Errorf("Expected uploads to be kept per bot, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// newNightGame returns a game in night 1 with a doctor (2), a godfather (3), a mafia (5), a sniper (6) and a citizen (4)
+func newNightGame(t *testing.T) *gameEntity.Game {
+	t.Helper()
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	game.Assignments = map[sharedEntity.UserID]scenarioEntity.Role{
+		2: {Name: "Doctor", Side: "Town", Abilities: []scenarioEntity.Ability{{Name: "save"}}},
+		3: {Name: "Godfather", Side: "Mafia", Abilities: []scenarioEntity.Ability{{Name: "shoot", Targets: []scenarioEntity.TargetFilter{scenarioEntity.TargetNonTeammate}}}},
+		4: {Name: "Citizen", Side: "Town"},
+		5: {Name: "Mafia", Side: "Mafia"},
+		6: {Name: "Sniper", Side: "Town", Abilities: []scenarioEntity.Ability{{Name: "snipe", Uses: 1, Targets: []scenarioEntity.TargetFilter{scenarioEntity.TargetNonSelf}}}},
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseNight, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	return game
+}
+
+func TestNightTargetsFollowFilters(t *testing.T) {
+	game := newNightGame(t)
+	if got := game.NightActors(); !reflect.DeepEqual(got, []sharedEntity.UserID{2, 3, 6}) {
+		t.Errorf("Expected actors [2 3 6], got %v", got)
+	}
+
+	shoot, _ := game.Assignments[3].Ability("shoot")
+	if got := game.ValidNightTargets(3, shoot); !reflect.DeepEqual(got, []sharedEntity.UserID{2, 3, 4, 6}) {
+		t.Errorf("Expected the godfather to target anyone but his teammate, got %v", got)
+	}
+	snipe, _ := game.Assignments[6].Ability("snipe")
+	if err := game.Eliminate(4, gameEntity.DeathCauseKilled); err != nil {
+		t.Fatalf("Eliminate failed: %v", err)
+	}
+	if got := game.ValidNightTargets(6, snipe); !reflect.DeepEqual(got, []sharedEntity.UserID{2, 3, 5}) {
+		t.Errorf("Expected the sniper to target alive players but himself, got %v", got)
+	}
+}
+
+func TestNightActorsTargetsAndAlivePlayersFollowSeats(t *testing.T) {
+	game := newNightGame(t)
+	game.Seats = []sharedEntity.UserID{6, 4, 2, 5, 3}
+
+	if got := game.AlivePlayers(); !reflect.DeepEqual(got, []sharedEntity.UserID{6, 4, 2, 5, 3}) {
+		t.Errorf("Expected alive players in seat order, got %v", got)
+	}
+	if got := game.NightActors(); !reflect.DeepEqual(got, []sharedEntity.UserID{6, 2, 3}) {
+		t.Errorf("Expected actors in seat order, got %v", got)
+	}
+	shoot, _ := game.Assignments[3].Ability("shoot")
+	if got := game.ValidNightTargets(3, shoot); !reflect.DeepEqual(got, []sharedEntity.UserID{6, 4, 2, 3}) {
+		t.Errorf("Expected targets in seat order, got %v", got)
+	}
+}
+
+func TestSubmitNightAction(t *testing.T) {
+	game := newNightGame(t)
+
+	if err := game.SubmitNightAction(3, "shoot", []sharedEntity.UserID{5}); !errors.Is(err, gameEntity.ErrInvalidNightTarget) {
+		t.Errorf("Expected shooting a teammate to fail, got %v", err)
+	}
+	if err := game.SubmitNightAction(4, "save", []sharedEntity.UserID{2}); !errors.Is(err, gameEntity.ErrUnknownAbility) {
+		t.Errorf("Expected ErrUnknownAbility for a citizen, got %v", err)
+	}
+	if err := game.SubmitNightAction(2, "save", []sharedEntity.UserID{2, 4}); !errors.Is(err, gameEntity.ErrInvalidNightTarget) {
+		t.Errorf("Expected a wrong target count to fail, got %v", err)
+	}
+
+	for _, target := range []sharedEntity.UserID{4, 2} { // the doctor changes their mind
+		if err := game.SubmitNightAction(2, "save", []sharedEntity.UserID{target}); err != nil {
+			t.Fatalf("SubmitNightAction failed: %v", err)
+		}
+	}
+	if err := game.SubmitNightAction(6, "snipe", []sharedEntity.UserID{3}); err != nil {
+		t.Fatalf("SubmitNightAction failed: %v", err)
+	}
+	actions := game.ActionsOfNight(1)
+	if len(actions) != 2 || actions[0].Actor != 2 || actions[0].Targets[0] != 2 {
+		t.Errorf("Expected the last choice of the doctor and the sniper's shot, got %+v", actions)
+	}
+
+	// The sniper's single use is spent once the night is over
+	for _, next := range []gameEntity.PhaseType{gameEntity.PhaseDay, gameEntity.PhaseNight} {
+		if err := game.AdvancePhase(next, time.Now()); err != nil {
+			t.Fatalf("AdvancePhase(%s) failed: %v", next, err)
+		}
+	}
+	if err := game.SubmitNightAction(6, "snipe", []sharedEntity.UserID{3}); !errors.Is(err, gameEntity.ErrAbilityUsedUp) {
+		t.Errorf("Expected ErrAbilityUsedUp, got %v", err)
+	}
+	if len(game.UsableAbilities(6)) != 0 {
+		t.Errorf("Expected the sniper to have no usable ability left")
+	}
+
+	if err := game.AdvancePhase(gameEntity.PhaseDay, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.SubmitNightAction(2, "save", []sharedEntity.UserID{2}); !errors.Is(err, gameEntity.ErrNotNightPhase) {
+		t.Errorf("Expected ErrNotNightPhase, got %v", err)
+	}
+}
+
+func TestScenarioValidatesAbilities(t *testing.T) {
+	scenario := &scenarioEntity.Scenario{Name: "s", Sides: []scenarioEntity.Side{{
+		Name:  "Town",
+		Roles: []scenarioEntity.Role{{Name: "Doctor", Abilities: []scenarioEntity.Ability{{Name: "save", Targets: []scenarioEntity.TargetFilter{"friends"}}}}},
+	}}}
+	if err := scenario.Validate(); err == nil {
+		t.Error("Expected an unknown target filter to be rejected")
+	}
+	scenario.Sides[0].Roles[0].Abilities = []scenarioEntity.Ability{{Name: "save"}, {Name: "save"}}
+	if err := scenario.Validate(); err == nil {
+		t.Error("Expected duplicate abilities to be rejected")
+	}
+	scenario.Sides[0].Roles[0].Abilities = []scenarioEntity.Ability{{Name: "save", Uses: 1}}
+	if err := scenario.Validate(); err != nil {
+		t.Errorf("Expected a valid ability, got %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// newResolverGame returns a game in night 1 with a doctor (2), a godfather (3), a detective (4),
+// a mafia (5), a citizen (6) and a mafia blocker (7)
+func newResolverGame(t *testing.T, priority ...scenarioEntity.AbilityEffect) *gameEntity.Game {
+	t.Helper()
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	game.Scenario.ActionPriority = priority
+	game.Scenario.Sides = []scenarioEntity.Side{
+		{Name: "Mafia", InvestigatedAs: scenarioEntity.InvestigationGuilty},
+		{Name: "Town"},
+	}
+	ability := func(name string, effect scenarioEntity.AbilityEffect) []scenarioEntity.Ability {
+		return []scenarioEntity.Ability{{Name: name, Effect: effect}}
+	}
+	game.Assignments = map[sharedEntity.UserID]scenarioEntity.Role{
+		2: {Name: "Doctor", Side: "Town", Abilities: ability("save", scenarioEntity.EffectSave)},
+		3: {Name: "Godfather", Side: "Mafia", InvestigatedAs: scenarioEntity.InvestigationInnocent, Abilities: ability("shoot", scenarioEntity.EffectKill)},
+		4: {Name: "Detective", Side: "Town", Abilities: ability("inquire", scenarioEntity.EffectInvestigate)},
+		5: {Name: "Mafia", Side: "Mafia"},
+		6: {Name: "Citizen", Side: "Town"},
+		7: {Name: "Blocker", Side: "Mafia", Abilities: ability("block", scenarioEntity.EffectBlock)},
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseNight, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	return game
+}
+
+func submitAction(t *testing.T, game *gameEntity.Game, actor sharedEntity.UserID, ability string, target sharedEntity.UserID) {
+	t.Helper()
+	if err := game.SubmitNightAction(actor, ability, []sharedEntity.UserID{target}); err != nil {
+		t.Fatalf("SubmitNightAction(%d, %s) failed: %v", actor, ability, err)
+	}
+}
+
+func TestResolveNightKillSaveAndInvestigate(t *testing.T) {
+	game := newResolverGame(t)
+	submitAction(t, game, 3, "shoot", 6)
+	submitAction(t, game, 2, "save", 4)
+	submitAction(t, game, 4, "inquire", 3)
+
+	res, err := game.ResolveNight(gameEntity.NewNightResolver())
+	if err != nil {
+		t.Fatalf("ResolveNight failed: %v", err)
+	}
+	if !reflect.DeepEqual(res.Deaths, []sharedEntity.UserID{6}) || len(res.Saved) != 0 {
+		t.Errorf("Expected only the citizen to die, got %+v", res)
+	}
+	if game.IsAlive(6) || game.Status(6).Cause != gameEntity.DeathCauseKilled {
+		t.Errorf("Expected the citizen to be killed, got %+v", game.Status(6))
+	}
+	want := []gameEntity.PrivateResult{{Recipient: 4, Ability: "inquire", Target: 3, Investigation: scenarioEntity.InvestigationInnocent}}
+	if !reflect.DeepEqual(res.Results, want) {
+		t.Errorf("Expected the godfather to look innocent, got %+v", res.Results)
+	}
+	if _, err := game.ResolveNight(gameEntity.NewNightResolver()); !errors.Is(err, gameEntity.ErrNightAlreadyResolved) {
+		t.Errorf("Expected ErrNightAlreadyResolved, got %v", err)
+	}
+}
+
+func TestSubmitNightActionAfterResolveIsRefused(t *testing.T) {
+	game := newResolverGame(t)
+	submitAction(t, game, 3, "shoot", 6)
+	if _, err := game.ResolveNight(gameEntity.NewNightResolver()); err != nil {
+		t.Fatalf("ResolveNight failed: %v", err)
+	}
+	err := game.SubmitNightAction(2, "save", []sharedEntity.UserID{4})
+	if !errors.Is(err, gameEntity.ErrNightAlreadyResolved) {
+		t.Errorf("Expected ErrNightAlreadyResolved, got %v", err)
+	}
+	if len(game.NightActions) != 1 {
+		t.Errorf("Expected the late action to be dropped, got %+v", game.NightActions)
+	}
+}
+
+func TestResolveNightSaveCancelsKill(t *testing.T) {
+	game := newResolverGame(t)
+	submitAction(t, game, 3, "shoot", 4)
+	submitAction(t, game, 2, "save", 4)
+	submitAction(t, game, 4, "inquire", 7)
+
+	res, err := game.ResolveNight(gameEntity.NewNightResolver())
+	if err != nil {
+		t.Fatalf("ResolveNight failed: %v", err)
+	}
+	if len(res.Deaths) != 0 || !reflect.DeepEqual(res.Saved, []sharedEntity.UserID{4}) || !game.IsAlive(4) {
+		t.Errorf("Expected the save to cancel the kill, got %+v", res)
+	}
+	if len(res.Results) != 1 || res.Results[0].Investigation != scenarioEntity.InvestigationGuilty {
+		t.Errorf("Expected the blocker to inherit the guilty result of his side, got %+v", res.Results)
+	}
+}
+
+func TestResolveNightBlockerBeforeKiller(t *testing.T) {
+	game := newResolverGame(t)
+	submitAction(t, game, 7, "block", 2)
+	submitAction(t, game, 3, "shoot", 4)
+	submitAction(t, game, 2, "save", 4)
+
+	res, err := game.ResolveNight(gameEntity.NewNightResolver())
+	if err != nil {
+		t.Fatalf("ResolveNight failed: %v", err)
+	}
+	if !reflect.DeepEqual(res.Deaths, []sharedEntity.UserID{4}) || !reflect.DeepEqual(res.Blocked, []sharedEntity.UserID{2}) {
+		t.Errorf("Expected the blocked doctor to fail to save, got %+v", res)
+	}
+	want := []gameEntity.PrivateResult{{Recipient: 2, Ability: "save", Blocked: true}}
+	if !reflect.DeepEqual(res.Results, want) {
+		t.Errorf("Expected the doctor to learn about the block, got %+v", res.Results)
+	}
+}
+
+func TestResolveNightFollowsScenarioPriority(t *testing.T) {
+	// Kills before saves: the doctor is too late
+	game := newResolverGame(t, scenarioEntity.EffectKill)
+	submitAction(t, game, 3, "shoot", 4)
+	submitAction(t, game, 2, "save", 4)
+
+	res := gameEntity.NewNightResolver().Resolve(game, 1)
+	if !reflect.DeepEqual(res.Deaths, []sharedEntity.UserID{4}) {
+		t.Errorf("Expected the kill to land before the save, got %+v", res)
+	}
+	if !game.IsAlive(4) {
+		t.Errorf("Resolve must not change the game")
+	}
+}
+
+func TestNightResolverCustomRule(t *testing.T) {
+	game := newResolverGame(t)
+	submitAction(t, game, 4, "inquire", 5)
+
+	// A scenario where investigators learn nothing about mafia
+	resolver := gameEntity.NewNightResolver().WithRule(scenarioEntity.EffectInvestigate, func(s *gameEntity.NightState, a gameEntity.NightAction) {
+		s.Report(gameEntity.PrivateResult{Recipient: a.Actor, Ability: a.Ability, Target: a.Targets[0], Investigation: scenarioEntity.InvestigationInnocent})
+	})
+	res := resolver.Resolve(game, 1)
+	if len(res.Results) != 1 || res.Results[0].Investigation != scenarioEntity.InvestigationInnocent {
+		t.Errorf("Expected the custom rule to be used, got %+v", res.Results)
+	}
+}
+
+func TestScenarioActionPriorityValidation(t *testing.T) {
+	scenario := &scenarioEntity.Scenario{
+		Name:           "Test",
+		ActionPriority: []scenarioEntity.AbilityEffect{scenarioEntity.EffectKill, scenarioEntity.EffectKill},
+		Sides:          []scenarioEntity.Side{{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Citizen"}}}},
+	}
+	if err := scenario.Validate(); err == nil {
+		t.Errorf("Expected a duplicated priority to be rejected")
+	}
+	scenario.ActionPriority = []scenarioEntity.AbilityEffect{scenarioEntity.EffectSave}
+	if err := scenario.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	want := []scenarioEntity.AbilityEffect{scenarioEntity.EffectSave, scenarioEntity.EffectBlock, scenarioEntity.EffectKill, scenarioEntity.EffectInvestigate}
+	if got := scenario.EffectOrder(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	scenario.Sides[0].InvestigatedAs = "suspicious"
+	if err := scenario.Validate(); err == nil {
+		t.Errorf("Expected an unknown investigated_as to be rejected")
+	}
+}
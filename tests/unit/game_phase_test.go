@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	roomEntity "telemafia/internal/domain/room/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestGamePhaseCycle(t *testing.T) {
+	game := &gameEntity.Game{ID: "g1", State: gameEntity.GameStateWaitingForPlayers}
+	now := time.Now()
+
+	if err := game.AdvancePhase(gameEntity.PhaseNight, now); !errors.Is(err, gameEntity.ErrGameNotStarted) {
+		t.Fatalf("Expected ErrGameNotStarted before roles are assigned, got %v", err)
+	}
+
+	game.SetRolesAssigned()
+	steps := []struct {
+		next   gameEntity.PhaseType
+		number int
+	}{
+		{gameEntity.PhaseDay, 0},
+		{gameEntity.PhaseNight, 1},
+		{gameEntity.PhaseDay, 1},
+		{gameEntity.PhaseVoting, 1},
+		{gameEntity.PhaseDefense, 1},
+		{gameEntity.PhaseVoting, 1},
+		{gameEntity.PhaseNight, 2},
+	}
+	for _, step := range steps {
+		if err := game.AdvancePhase(step.next, now); err != nil {
+			t.Fatalf("Transition to %s failed: %v", step.next, err)
+		}
+		if game.Phase.Type != step.next || game.Phase.Number != step.number {
+			t.Fatalf("Expected %s %d, got %s", step.next, step.number, game.Phase.Label())
+		}
+		if game.State != gameEntity.GameStateInProgress {
+			t.Fatalf("Expected game in progress, got %s", game.State)
+		}
+	}
+
+	// Night can only be followed by day
+	for _, next := range []gameEntity.PhaseType{gameEntity.PhaseVoting, gameEntity.PhaseDefense, gameEntity.PhaseNight} {
+		if err := game.AdvancePhase(next, now); !errors.Is(err, gameEntity.ErrInvalidPhaseTransition) {
+			t.Errorf("Expected night -> %s to be rejected, got %v", next, err)
+		}
+	}
+
+	if err := game.EndGame(); err != nil {
+		t.Fatalf("EndGame failed: %v", err)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseDay, now); !errors.Is(err, gameEntity.ErrGameFinished) {
+		t.Errorf("Expected ErrGameFinished after the game ended, got %v", err)
+	}
+	if len(game.AllowedTransitions()) != 0 {
+		t.Errorf("Expected no transitions after the game ended, got %v", game.AllowedTransitions())
+	}
+}
+
+func TestPhaseCommandsRequireModerator(t *testing.T) {
+	repo := memrepo.NewInMemoryGameRepository()
+	moderator := &sharedEntity.User{ID: 1}
+	game := &gameEntity.Game{
+		ID:          "g1",
+		State:       gameEntity.GameStateRolesAssigned,
+		Room:        &roomEntity.Room{ID: "r1", Moderator: moderator},
+		Assignments: make(map[sharedEntity.UserID]scenarioEntity.Role),
+	}
+	if err := repo.CreateGame(game); err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+
+	startNight := gameCommand.NewStartNightHandler(repo)
+	if _, err := startNight.Handle(context.Background(), gameCommand.StartNightCommand{Requester: sharedEntity.User{ID: 2}, GameID: "g1"}); err == nil {
+		t.Fatal("Expected a player to be refused")
+	}
+
+	updated, err := startNight.Handle(context.Background(), gameCommand.StartNightCommand{Requester: *moderator, GameID: "g1"})
+	if err != nil {
+		t.Fatalf("StartNight failed: %v", err)
+	}
+	if updated.Phase.Type != gameEntity.PhaseNight || updated.Phase.Number != 1 {
+		t.Errorf("Expected night 1, got %s", updated.Phase.Label())
+	}
+
+	if _, err := gameCommand.NewStartVotingHandler(repo).Handle(context.Background(), gameCommand.StartVotingCommand{Requester: *moderator, GameID: "g1"}); !errors.Is(err, gameEntity.ErrInvalidPhaseTransition) {
+		t.Errorf("Expected voting during the night to be rejected, got %v", err)
+	}
+
+	ended, err := gameCommand.NewEndGameHandler(repo).Handle(context.Background(), gameCommand.EndGameCommand{Requester: sharedEntity.User{ID: 9, Admin: true}, GameID: "g1"})
+	if err != nil || ended.State != gameEntity.GameStateFinished {
+		t.Errorf("Expected admin to end the game, got %+v (err %v)", ended, err)
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	roomEntity "telemafia/internal/domain/room/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func newRunningGame(reveal scenarioEntity.DeathReveal) *gameEntity.Game {
+	return &gameEntity.Game{
+		ID:       "g1",
+		State:    gameEntity.GameStateRolesAssigned,
+		Room:     &roomEntity.Room{ID: "r1", Moderator: &sharedEntity.User{ID: 1}},
+		Scenario: &scenarioEntity.Scenario{ID: "s1", DeathReveal: reveal},
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{
+			2: {Name: "Doctor", Side: "Town"},
+			3: {Name: "Godfather", Side: "Mafia"},
+			4: {Name: "Citizen", Side: "Town"},
+		},
+	}
+}
+
+func TestEliminateAndRevive(t *testing.T) {
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	if err := game.AdvancePhase(gameEntity.PhaseNight, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+
+	if err := game.Eliminate(3, gameEntity.DeathCauseKilled); err != nil {
+		t.Fatalf("Eliminate failed: %v", err)
+	}
+	status := game.Status(3)
+	if status.Alive || status.Cause != gameEntity.DeathCauseKilled || status.DiedIn.Type != gameEntity.PhaseNight || status.DiedIn.Number != 1 {
+		t.Errorf("Unexpected status after elimination: %+v", status)
+	}
+	if _, revealed := game.RevealedIdentity(3); revealed {
+		t.Errorf("Expected role to stay hidden with death_reveal none")
+	}
+	if got := game.AlivePlayers(); len(got) != 2 || got[0] != 2 || got[1] != 4 {
+		t.Errorf("Expected alive players [2 4], got %v", got)
+	}
+
+	if err := game.Eliminate(3, gameEntity.DeathCauseLynched); !errors.Is(err, gameEntity.ErrPlayerAlreadyDead) {
+		t.Errorf("Expected ErrPlayerAlreadyDead, got %v", err)
+	}
+	if err := game.Eliminate(9, gameEntity.DeathCauseKilled); !errors.Is(err, gameEntity.ErrPlayerNotInGame) {
+		t.Errorf("Expected ErrPlayerNotInGame, got %v", err)
+	}
+	if err := game.Eliminate(2, "drowned"); !errors.Is(err, gameEntity.ErrInvalidDeathCause) {
+		t.Errorf("Expected ErrInvalidDeathCause, got %v", err)
+	}
+	if err := game.Revive(2); !errors.Is(err, gameEntity.ErrPlayerNotDead) {
+		t.Errorf("Expected ErrPlayerNotDead, got %v", err)
+	}
+
+	if err := game.Revive(3); err != nil {
+		t.Fatalf("Revive failed: %v", err)
+	}
+	if !game.IsAlive(3) {
+		t.Errorf("Expected player 3 to be alive again")
+	}
+}
+
+func TestDeathRevealFollowsScenario(t *testing.T) {
+	cases := map[scenarioEntity.DeathReveal]string{
+		scenarioEntity.DeathRevealRole: "Godfather",
+		scenarioEntity.DeathRevealSide: "Mafia",
+	}
+	for reveal, want := range cases {
+		game := newRunningGame(reveal)
+		if err := game.Eliminate(3, gameEntity.DeathCauseLynched); err != nil {
+			t.Fatalf("Eliminate failed: %v", err)
+		}
+		if got, revealed := game.RevealedIdentity(3); !revealed || got != want {
+			t.Errorf("death_reveal %s: expected %q, got %q (revealed %v)", reveal, want, got, revealed)
+		}
+	}
+}
+
+func TestEliminatePlayerCommandRequiresModerator(t *testing.T) {
+	repo := memrepo.NewInMemoryGameRepository()
+	if err := repo.CreateGame(newRunningGame(scenarioEntity.DeathRevealNone)); err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	handler := gameCommand.NewEliminatePlayerHandler(repo)
+
+	if _, err := handler.Handle(context.Background(), gameCommand.EliminatePlayerCommand{Requester: sharedEntity.User{ID: 2}, GameID: "g1", PlayerID: 3, Cause: gameEntity.DeathCauseLynched}); err == nil {
+		t.Fatal("Expected a player to be refused")
+	}
+	game, err := handler.Handle(context.Background(), gameCommand.EliminatePlayerCommand{Requester: sharedEntity.User{ID: 1}, GameID: "g1", PlayerID: 3, Cause: gameEntity.DeathCauseLynched})
+	if err != nil || game.IsAlive(3) {
+		t.Fatalf("Expected player 3 to be eliminated, got err %v", err)
+	}
+	game, err = gameCommand.NewRevivePlayerHandler(repo).Handle(context.Background(), gameCommand.RevivePlayerCommand{Requester: sharedEntity.User{ID: 1}, GameID: "g1", PlayerID: 3})
+	if err != nil || !game.IsAlive(3) {
+		t.Errorf("Expected player 3 to be revived, got err %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	apiAdapter "telemafia/internal/adapters/api"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestReadyCheckConfirmations(t *testing.T) {
+	game := &gameEntity.Game{ID: "g1", State: gameEntity.GameStateWaitingForPlayers}
+	now := time.Now()
+	if err := game.StartReadyCheck(nil, now, 0); !errors.Is(err, gameEntity.ErrNobodyToCheck) {
+		t.Errorf("Expected ErrNobodyToCheck, got %v", err)
+	}
+	if err := game.StartReadyCheck([]sharedEntity.UserID{2, 3, 4}, now, 0); err != nil {
+		t.Fatalf("StartReadyCheck failed: %v", err)
+	}
+	if game.ReadyCheck.IsTimed() {
+		t.Errorf("A check without timeout should not be timed")
+	}
+	if err := game.MarkReady(9); !errors.Is(err, gameEntity.ErrNotInReadyCheck) {
+		t.Errorf("Expected ErrNotInReadyCheck, got %v", err)
+	}
+	_ = game.MarkReady(3)
+	_ = game.MarkReady(3)
+	if want := []sharedEntity.UserID{2, 4}; !reflect.DeepEqual(game.ReadyCheck.Waiting(), want) {
+		t.Errorf("Expected %v waiting, got %v", want, game.ReadyCheck.Waiting())
+	}
+	if err := game.RequireReady([]sharedEntity.UserID{2, 3, 4}); !errors.Is(err, gameEntity.ErrPlayersNotReady) {
+		t.Errorf("Expected ErrPlayersNotReady, got %v", err)
+	}
+
+	_ = game.MarkReady(2)
+	_ = game.MarkReady(4)
+	if !game.ReadyCheck.AllReady() || game.RequireReady([]sharedEntity.UserID{2, 3, 4}) != nil {
+		t.Errorf("Expected everyone to be ready, got %+v", game.ReadyCheck)
+	}
+	// Someone who joined after the check still holds the start up
+	if err := game.RequireReady([]sharedEntity.UserID{2, 3, 4, 5}); !errors.Is(err, gameEntity.ErrPlayersNotReady) {
+		t.Errorf("Expected a late joiner to block the start, got %v", err)
+	}
+
+	game.SetRolesAssigned()
+	if game.ReadyCheck != nil {
+		t.Errorf("Expected the check to end when roles are handed out")
+	}
+	if err := game.StartReadyCheck([]sharedEntity.UserID{2}, now, 0); !errors.Is(err, gameEntity.ErrReadyCheckNotAllowed) {
+		t.Errorf("Expected ErrReadyCheckNotAllowed after roles are out, got %v", err)
+	}
+}
+
+func TestReadyCheckTimeoutDropsNonResponders(t *testing.T) {
+	ctx := context.Background()
+	roomRepo := memrepo.NewInMemoryRoomRepository()
+	gameRepo := memrepo.NewInMemoryGameRepository()
+	room := newSeatedRoom(2, 3, 4)
+	room.MaxPlayers = 3
+	room.AddToWaitlist(&sharedEntity.User{ID: 5})
+	if err := roomRepo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	game := &gameEntity.Game{ID: "g1", State: gameEntity.GameStateWaitingForPlayers, Room: room}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	moderator := sharedEntity.User{ID: 1}
+	roomSeats := apiAdapter.NewLocalRoomSeatClient(roomCommand.NewDropPlayersHandler(roomRepo))
+	clock := &fakeClock{now: time.Date(2025, 3, 2, 21, 0, 0, 0, time.UTC)}
+
+	start := gameCommand.NewStartReadyCheckHandler(gameRepo, roomRepo, clock)
+	if _, err := start.Handle(ctx, gameCommand.StartReadyCheckCommand{Requester: sharedEntity.User{ID: 2}, GameID: game.ID}); err == nil {
+		t.Errorf("Expected a player to be refused starting the check")
+	}
+	if _, err := start.Handle(ctx, gameCommand.StartReadyCheckCommand{Requester: moderator, GameID: game.ID, Timeout: time.Minute}); err != nil {
+		t.Fatalf("StartReadyCheck failed: %v", err)
+	}
+	if _, err := gameCommand.NewMarkReadyHandler(gameRepo).Handle(ctx, gameCommand.MarkReadyCommand{Requester: sharedEntity.User{ID: 3}, GameID: game.ID}); err != nil {
+		t.Fatalf("MarkReady failed: %v", err)
+	}
+
+	tick := gameCommand.NewTickReadyCheckHandler(gameRepo, roomSeats, clock)
+	clock.now = clock.now.Add(30 * time.Second)
+	if _, timedOut, _, err := tick.Handle(ctx, gameCommand.TickReadyCheckCommand{GameID: game.ID}); err != nil || timedOut {
+		t.Fatalf("Expected no timeout before the deadline, got %v (%v)", timedOut, err)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	updated, timedOut, drop, err := tick.Handle(ctx, gameCommand.TickReadyCheckCommand{GameID: game.ID})
+	if err != nil || !timedOut {
+		t.Fatalf("Expected the check to time out, got %v (%v)", timedOut, err)
+	}
+	if want := []sharedEntity.UserID{2, 4}; !reflect.DeepEqual(drop.Kicked, want) {
+		t.Errorf("Expected %v to be dropped, got %v", want, drop.Kicked)
+	}
+	if len(drop.Promoted) != 1 || drop.Promoted[0].ID != 5 {
+		t.Errorf("Expected 5 to take a free seat, got %v", drop.Promoted)
+	}
+	stored, _ := roomRepo.GetRoomByID(room.ID)
+	if want := []sharedEntity.UserID{3, 5}; !reflect.DeepEqual(seatIDs(stored), want) {
+		t.Errorf("Expected seats %v, got %v", want, seatIDs(stored))
+	}
+	// The promoted player is asked too, and the start waits for them
+	if want := []sharedEntity.UserID{5}; !reflect.DeepEqual(updated.ReadyCheck.Waiting(), want) {
+		t.Errorf("Expected %v waiting, got %v", want, updated.ReadyCheck.Waiting())
+	}
+	if updated.ReadyCheck.IsTimed() {
+		t.Errorf("Expected the deadline to fire only once")
+	}
+
+	kick := gameCommand.NewKickUnreadyPlayersHandler(gameRepo, roomSeats)
+	if _, drop, err = kick.Handle(ctx, gameCommand.KickUnreadyPlayersCommand{Requester: moderator, GameID: game.ID}); err != nil {
+		t.Fatalf("KickUnreadyPlayers failed: %v", err)
+	}
+	if want := []sharedEntity.UserID{5}; !reflect.DeepEqual(drop.Kicked, want) {
+		t.Errorf("Expected %v to be kicked, got %v", want, drop.Kicked)
+	}
+}
+
+func TestReadyCheckFollowsSeatChanges(t *testing.T) {
+	ctx := context.Background()
+	roomRepo := memrepo.NewInMemoryRoomRepository()
+	gameRepo := memrepo.NewInMemoryGameRepository()
+	room := newSeatedRoom(2, 3)
+	if err := roomRepo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	game := &gameEntity.Game{ID: "g1", State: gameEntity.GameStateWaitingForPlayers, Room: room}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	follow := gameCommand.NewSyncReadyCheckHandler(gameRepo)
+
+	// Without an open check there is nothing to follow
+	if updated, err := follow.Handle(ctx, gameCommand.SyncReadyCheckCommand{RoomID: room.ID, Seated: []sharedEntity.UserID{4}}); err != nil || updated != nil {
+		t.Fatalf("Expected no update without a ready-check, got %+v (%v)", updated, err)
+	}
+
+	start := gameCommand.NewStartReadyCheckHandler(gameRepo, roomRepo, &fakeClock{now: time.Now()})
+	if _, err := start.Handle(ctx, gameCommand.StartReadyCheckCommand{Requester: sharedEntity.User{ID: 1}, GameID: game.ID}); err != nil {
+		t.Fatalf("StartReadyCheck failed: %v", err)
+	}
+	if _, err := gameCommand.NewMarkReadyHandler(gameRepo).Handle(ctx, gameCommand.MarkReadyCommand{Requester: sharedEntity.User{ID: 2}, GameID: game.ID}); err != nil {
+		t.Fatalf("MarkReady failed: %v", err)
+	}
+
+	// 3 leaves and 4 takes the seat: the start no longer waits for 3 but does wait for 4
+	updated, err := follow.Handle(ctx, gameCommand.SyncReadyCheckCommand{RoomID: room.ID, Seated: []sharedEntity.UserID{4}, Unseated: []sharedEntity.UserID{3}})
+	if err != nil || updated == nil {
+		t.Fatalf("SyncReadyCheck failed: %+v (%v)", updated, err)
+	}
+	if want := []sharedEntity.UserID{2, 4}; !reflect.DeepEqual(updated.ReadyCheck.Players, want) {
+		t.Errorf("Expected %v to be asked, got %v", want, updated.ReadyCheck.Players)
+	}
+	if err := updated.RequireReady([]sharedEntity.UserID{2, 4}); err == nil {
+		t.Errorf("Expected the newcomer to hold up the start")
+	}
+	if _, err := gameCommand.NewMarkReadyHandler(gameRepo).Handle(ctx, gameCommand.MarkReadyCommand{Requester: sharedEntity.User{ID: 4}, GameID: game.ID}); err != nil {
+		t.Errorf("Expected the newcomer to be able to confirm, got %v", err)
+	}
+	if _, err := gameCommand.NewMarkReadyHandler(gameRepo).Handle(ctx, gameCommand.MarkReadyCommand{Requester: sharedEntity.User{ID: 3}, GameID: game.ID}); !errors.Is(err, gameEntity.ErrNotInReadyCheck) {
+		t.Errorf("Expected the player who left to be refused, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// newTurnsGame returns a game in day 1 whose room seats players 4, 2 and 3 in that order
+func newTurnsGame(t *testing.T) *gameEntity.Game {
+	t.Helper()
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	game.Room.Players = []*sharedEntity.User{{ID: 4}, {ID: 2}, {ID: 3}}
+	if err := game.AdvancePhase(gameEntity.PhaseDay, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	return game
+}
+
+func TestSpeakingTurnsFollowSeatOrder(t *testing.T) {
+	game := newTurnsGame(t)
+	if err := game.StartSpeakingTurns(); err != nil {
+		t.Fatalf("StartSpeakingTurns failed: %v", err)
+	}
+	if want := []sharedEntity.UserID{4, 2, 3}; !reflect.DeepEqual(game.Turns.Order, want) {
+		t.Fatalf("Expected order %v, got %v", want, game.Turns.Order)
+	}
+
+	next, ok, err := game.NextSpeaker(false)
+	if err != nil || !ok || next != 2 {
+		t.Fatalf("Expected 2 to speak next, got %d %v %v", next, ok, err)
+	}
+	// A player who dies before their turn loses it
+	if err := game.Eliminate(3, gameEntity.DeathCauseKilled); err != nil {
+		t.Fatalf("Eliminate failed: %v", err)
+	}
+	if _, ok, err := game.NextSpeaker(true); err != nil || ok {
+		t.Fatalf("Expected the turns to be over, got %v %v", ok, err)
+	}
+	if !game.Turns.HasSpoken(4) || !game.Turns.WasSkipped(2) || game.Turns.WasSkipped(4) {
+		t.Errorf("Unexpected spoken/skipped state: %+v", game.Turns)
+	}
+	if _, _, err := game.NextSpeaker(false); !errors.Is(err, gameEntity.ErrNoSpeakingTurns) {
+		t.Errorf("Expected ErrNoSpeakingTurns, got %v", err)
+	}
+}
+
+func TestSpeakingTurnChallenges(t *testing.T) {
+	game := newTurnsGame(t)
+	if err := game.RequestChallenge(2); !errors.Is(err, gameEntity.ErrNoSpeakingTurns) {
+		t.Errorf("Expected ErrNoSpeakingTurns before the turns start, got %v", err)
+	}
+	if err := game.StartSpeakingTurns(); err != nil {
+		t.Fatalf("StartSpeakingTurns failed: %v", err)
+	}
+
+	if err := game.RequestChallenge(4); !errors.Is(err, gameEntity.ErrChallengeNotAllowed) {
+		t.Errorf("Expected the speaker to be refused, got %v", err)
+	}
+	for _, id := range []sharedEntity.UserID{3, 2} {
+		if err := game.RequestChallenge(id); err != nil {
+			t.Fatalf("RequestChallenge(%d) failed: %v", id, err)
+		}
+	}
+	if err := game.RequestChallenge(3); !errors.Is(err, gameEntity.ErrChallengeAlreadyRequested) {
+		t.Errorf("Expected ErrChallengeAlreadyRequested, got %v", err)
+	}
+	if err := game.GrantChallenge(5); !errors.Is(err, gameEntity.ErrNoChallengeRequest) {
+		t.Errorf("Expected ErrNoChallengeRequest, got %v", err)
+	}
+	if err := game.GrantChallenge(2); err != nil {
+		t.Fatalf("GrantChallenge failed: %v", err)
+	}
+	if game.Turns.Challenger != 2 || game.Turns.Requests != nil {
+		t.Errorf("Expected 2 to hold the challenge, got %+v", game.Turns)
+	}
+	if err := game.RequestChallenge(3); !errors.Is(err, gameEntity.ErrChallengeAlreadyGranted) {
+		t.Errorf("Expected ErrChallengeAlreadyGranted, got %v", err)
+	}
+
+	// The next turn starts without challenges
+	if _, _, err := game.NextSpeaker(false); err != nil {
+		t.Fatalf("NextSpeaker failed: %v", err)
+	}
+	if game.Turns.Challenger != 0 {
+		t.Errorf("Expected the challenge to end with the turn, got %+v", game.Turns)
+	}
+}
+
+func TestSpeakingTurnsOnlyDuringDayAndDefense(t *testing.T) {
+	game := newTurnsGame(t)
+	if err := game.StartSpeakingTurns(); err != nil {
+		t.Fatalf("StartSpeakingTurns failed: %v", err)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseNight, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if game.Turns != nil {
+		t.Errorf("Expected the phase change to clear the turns, got %+v", game.Turns)
+	}
+	if err := game.StartSpeakingTurns(); !errors.Is(err, gameEntity.ErrNotSpeakingPhase) {
+		t.Errorf("Expected ErrNotSpeakingPhase at night, got %v", err)
+	}
+}
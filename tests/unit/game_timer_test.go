@@ -0,0 +1,172 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// fakeClock is a clock the test moves by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestStartTimerValidation(t *testing.T) {
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	now := time.Now()
+	if err := game.StartTimer(now, time.Minute, false); !errors.Is(err, gameEntity.ErrPhaseNotTimed) {
+		t.Errorf("Expected ErrPhaseNotTimed before the first phase, got %v", err)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseNight, now); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.StartTimer(now, 0, false); !errors.Is(err, gameEntity.ErrInvalidTimerDuration) {
+		t.Errorf("Expected ErrInvalidTimerDuration, got %v", err)
+	}
+	if err := game.StopTimer(); !errors.Is(err, gameEntity.ErrNoTimer) {
+		t.Errorf("Expected ErrNoTimer, got %v", err)
+	}
+	if err := game.StartTimer(now, time.Minute, false); err != nil {
+		t.Fatalf("StartTimer failed: %v", err)
+	}
+	if !game.Timer.IsRunning() || !game.Timer.Deadline.Equal(now.Add(time.Minute)) {
+		t.Errorf("Unexpected timer: %+v", game.Timer)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseDay, now); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if game.Timer != nil {
+		t.Errorf("Expected the timer to be cleared by the phase change, got %+v", game.Timer)
+	}
+}
+
+func TestTimerWarningsAndExpiry(t *testing.T) {
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	start := time.Now()
+	if err := game.AdvancePhase(gameEntity.PhaseDay, start); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := game.StartTimer(start, time.Minute, false); err != nil {
+		t.Fatalf("StartTimer failed: %v", err)
+	}
+
+	steps := []struct {
+		after time.Duration
+		want  gameEntity.TimerTick
+	}{
+		{10 * time.Second, gameEntity.TimerTick{}},
+		{31 * time.Second, gameEntity.TimerTick{Warning: 30 * time.Second}},
+		{35 * time.Second, gameEntity.TimerTick{}},
+		{52 * time.Second, gameEntity.TimerTick{Warning: 10 * time.Second}},
+		{time.Minute, gameEntity.TimerTick{Expired: true}},
+		{2 * time.Minute, gameEntity.TimerTick{}},
+	}
+	for _, step := range steps {
+		tick, err := game.TickTimer(start.Add(step.after))
+		if err != nil {
+			t.Fatalf("TickTimer(+%s) failed: %v", step.after, err)
+		}
+		if tick != step.want {
+			t.Errorf("TickTimer(+%s) = %+v, want %+v", step.after, tick, step.want)
+		}
+	}
+	if game.Phase.Type != gameEntity.PhaseDay || game.Timer.IsRunning() {
+		t.Errorf("Expected the day to stay with an expired timer, got %s with %+v", game.Phase.Type, game.Timer)
+	}
+}
+
+func TestTimerSkipsCrossedAndLongWarnings(t *testing.T) {
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	start := time.Now()
+	if err := game.AdvancePhase(gameEntity.PhaseDay, start); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	// A 20s timer never warns at 30s; a late tick only reports the smallest warning crossed
+	if err := game.StartTimer(start, 20*time.Second, false); err != nil {
+		t.Fatalf("StartTimer failed: %v", err)
+	}
+	tick, _ := game.TickTimer(start.Add(15 * time.Second))
+	if tick.Warning != 10*time.Second {
+		t.Errorf("Expected the 10s warning, got %+v", tick)
+	}
+}
+
+func TestTickTimerHandlerAutoAdvances(t *testing.T) {
+	repo := memrepo.NewInMemoryGameRepository()
+	clock := &fakeClock{now: time.Date(2025, 3, 2, 21, 0, 0, 0, time.UTC)}
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	if err := game.AdvancePhase(gameEntity.PhaseNight, clock.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := repo.CreateGame(game); err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+
+	start := gameCommand.NewStartTimerHandler(repo, clock)
+	if _, err := start.Handle(context.Background(), gameCommand.StartTimerCommand{
+		Requester: *game.Room.Moderator, GameID: game.ID, Duration: time.Minute, AutoAdvance: true,
+	}); err != nil {
+		t.Fatalf("StartTimer failed: %v", err)
+	}
+
+	tickHandler := gameCommand.NewTickTimerHandler(repo, clock)
+	clock.now = clock.now.Add(time.Minute)
+	ticked, tick, err := tickHandler.Handle(context.Background(), gameCommand.TickTimerCommand{GameID: game.ID})
+	if err != nil {
+		t.Fatalf("TickTimer failed: %v", err)
+	}
+	if !tick.Expired || !tick.Advanced {
+		t.Errorf("Expected the timer to expire and advance, got %+v", tick)
+	}
+	if ticked.Phase.Type != gameEntity.PhaseDay || ticked.Phase.Number != 1 || ticked.Timer != nil {
+		t.Errorf("Expected day 1 without a timer, got %+v with %+v", ticked.Phase, ticked.Timer)
+	}
+}
+
+func TestConcurrentGameCommandsKeepEachOthersChanges(t *testing.T) {
+	repo := memrepo.NewInMemoryGameRepository()
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	if err := game.AdvancePhase(gameEntity.PhaseDay, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	if err := repo.CreateGame(game); err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	ctx := context.Background()
+	moderator := sharedEntity.User{ID: 1}
+
+	// The timer and the speaking turns are saved by different commands that each write the whole game
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			start := gameCommand.NewStartTimerHandler(repo, &fakeClock{now: time.Now()})
+			if _, err := start.Handle(ctx, gameCommand.StartTimerCommand{Requester: moderator, GameID: "g1", Duration: time.Minute}); err != nil {
+				t.Errorf("StartTimer failed: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := gameCommand.NewStartSpeakingTurnsHandler(repo).Handle(ctx, gameCommand.StartSpeakingTurnsCommand{Requester: moderator, GameID: "g1"}); err != nil {
+				t.Errorf("StartSpeakingTurns failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	stored, _ := repo.GetGameByID("g1")
+	if stored.Timer == nil || stored.Turns == nil {
+		t.Errorf("Expected both the timer and the speaking turns to be kept, got timer %+v and turns %+v", stored.Timer, stored.Turns)
+	}
+}
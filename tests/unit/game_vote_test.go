@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// newVotingGame returns a running game with players 2, 3 and 4 in the voting phase of day 0
+func newVotingGame(t *testing.T, tie scenarioEntity.VoteTieRule) *gameEntity.Game {
+	t.Helper()
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	game.Scenario.VoteTie = tie
+	now := time.Now()
+	if err := game.AdvancePhase(gameEntity.PhaseDay, now); err != nil {
+		t.Fatalf("AdvancePhase(day) failed: %v", err)
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseVoting, now); err != nil {
+		t.Fatalf("AdvancePhase(voting) failed: %v", err)
+	}
+	return game
+}
+
+func castVotes(t *testing.T, game *gameEntity.Game, ballots map[sharedEntity.UserID]sharedEntity.UserID) {
+	t.Helper()
+	for voter, target := range ballots {
+		if err := game.CastVote(voter, target, time.Now()); err != nil {
+			t.Fatalf("CastVote(%d -> %d) failed: %v", voter, target, err)
+		}
+	}
+}
+
+func TestVoteLynchesLeader(t *testing.T) {
+	game := newVotingGame(t, scenarioEntity.VoteTieDefense)
+	if err := game.CastVote(2, 3, time.Now()); !errors.Is(err, gameEntity.ErrNoOpenVote) {
+		t.Errorf("Expected ErrNoOpenVote before the vote is opened, got %v", err)
+	}
+	if err := game.OpenVote(time.Now(), time.Minute); err != nil {
+		t.Fatalf("OpenVote failed: %v", err)
+	}
+	if err := game.CastVote(2, 2, time.Now()); !errors.Is(err, gameEntity.ErrInvalidVoteTarget) {
+		t.Errorf("Expected ErrInvalidVoteTarget for a self vote, got %v", err)
+	}
+
+	castVotes(t, game, map[sharedEntity.UserID]sharedEntity.UserID{2: 4, 3: 2, 4: 3})
+	castVotes(t, game, map[sharedEntity.UserID]sharedEntity.UserID{2: 3}) // change of mind
+	if tally := game.Vote.Tally(); tally[0].Target != 3 || len(tally[0].Voters) != 2 {
+		t.Errorf("Expected player 3 to lead with 2 votes, got %+v", tally)
+	}
+
+	vote, err := game.CloseVote(time.Now())
+	if err != nil {
+		t.Fatalf("CloseVote failed: %v", err)
+	}
+	if vote.Outcome != gameEntity.VoteOutcomeLynch || game.IsAlive(3) || game.Status(3).Cause != gameEntity.DeathCauseLynched {
+		t.Errorf("Expected player 3 to be lynched, got outcome %s", vote.Outcome)
+	}
+	if err := game.OpenVote(time.Now(), time.Minute); !errors.Is(err, gameEntity.ErrVoteAlreadyDecided) {
+		t.Errorf("Expected ErrVoteAlreadyDecided, got %v", err)
+	}
+	if err := game.CastVote(2, 4, time.Now()); !errors.Is(err, gameEntity.ErrNoOpenVote) {
+		t.Errorf("Expected ErrNoOpenVote after closing, got %v", err)
+	}
+}
+
+func TestVoteTieRules(t *testing.T) {
+	tie := map[sharedEntity.UserID]sharedEntity.UserID{2: 3, 3: 2}
+
+	t.Run("revote", func(t *testing.T) {
+		game := newVotingGame(t, scenarioEntity.VoteTieRevote)
+		if err := game.OpenVote(time.Now(), time.Minute); err != nil {
+			t.Fatalf("OpenVote failed: %v", err)
+		}
+		castVotes(t, game, tie)
+		vote, err := game.CloseVote(time.Now())
+		if err != nil || vote.Outcome != gameEntity.VoteOutcomeRevote {
+			t.Fatalf("Expected a revote, got %v (err %v)", vote, err)
+		}
+		if !game.Vote.IsOpen() || game.Vote.Round != 2 || len(game.Vote.Candidates) != 2 {
+			t.Fatalf("Expected round 2 between the tied players, got %+v", game.Vote)
+		}
+		castVotes(t, game, tie)
+		if vote, _ := game.CloseVote(time.Now()); vote.Outcome != gameEntity.VoteOutcomeNoLynch {
+			t.Errorf("Expected a second tie to end without a lynch, got %s", vote.Outcome)
+		}
+	})
+
+	t.Run("defense", func(t *testing.T) {
+		game := newVotingGame(t, scenarioEntity.VoteTieDefense)
+		if err := game.OpenVote(time.Now(), time.Minute); err != nil {
+			t.Fatalf("OpenVote failed: %v", err)
+		}
+		castVotes(t, game, tie)
+		vote, err := game.CloseVote(time.Now())
+		if err != nil || vote.Outcome != gameEntity.VoteOutcomeDefense || game.Phase.Type != gameEntity.PhaseDefense {
+			t.Fatalf("Expected the tied players to go on trial, got %v in %s (err %v)", vote, game.Phase.Label(), err)
+		}
+		if err := game.AdvancePhase(gameEntity.PhaseVoting, time.Now()); err != nil {
+			t.Fatalf("AdvancePhase(voting) failed: %v", err)
+		}
+		if err := game.OpenVote(time.Now(), time.Minute); err != nil {
+			t.Fatalf("OpenVote after defense failed: %v", err)
+		}
+		if game.Vote.IsCandidate(4) || game.Vote.Round != 2 {
+			t.Errorf("Expected only the defendants as candidates, got %+v", game.Vote)
+		}
+	})
+
+	t.Run("no lynch", func(t *testing.T) {
+		game := newVotingGame(t, scenarioEntity.VoteTieNoLynch)
+		if err := game.OpenVote(time.Now(), time.Minute); err != nil {
+			t.Fatalf("OpenVote failed: %v", err)
+		}
+		castVotes(t, game, tie)
+		if vote, _ := game.CloseVote(time.Now()); vote.Outcome != gameEntity.VoteOutcomeNoLynch || len(game.AlivePlayers()) != 3 {
+			t.Errorf("Expected no lynch, got %s", vote.Outcome)
+		}
+	})
+}
+
+func TestCloseVoteCommandPermissions(t *testing.T) {
+	repo := memrepo.NewInMemoryGameRepository()
+	if err := repo.CreateGame(newVotingGame(t, scenarioEntity.VoteTieDefense)); err != nil {
+		t.Fatalf("CreateGame failed: %v", err)
+	}
+	ctx := context.Background()
+	moderator := sharedEntity.User{ID: 1}
+
+	if _, err := gameCommand.NewOpenVoteHandler(repo).Handle(ctx, gameCommand.OpenVoteCommand{Requester: sharedEntity.User{ID: 2}, GameID: "g1", Duration: time.Minute}); err == nil {
+		t.Fatal("Expected a player to be refused opening the vote")
+	}
+	if _, err := gameCommand.NewOpenVoteHandler(repo).Handle(ctx, gameCommand.OpenVoteCommand{Requester: moderator, GameID: "g1", Duration: time.Millisecond}); err != nil {
+		t.Fatalf("OpenVote failed: %v", err)
+	}
+	if _, err := gameCommand.NewCastVoteHandler(repo).Handle(ctx, gameCommand.CastVoteCommand{Requester: moderator, GameID: "g1", TargetID: 3}); !errors.Is(err, gameEntity.ErrVoterNotAlive) && !errors.Is(err, gameEntity.ErrVoteClosed) {
+		t.Errorf("Expected the moderator to be refused a ballot, got %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	// Once expired, the vote may be closed without a requester, as the refresh ticker does
+	game, vote, err := gameCommand.NewCloseVoteHandler(repo).Handle(ctx, gameCommand.CloseVoteCommand{GameID: "g1"})
+	if err != nil {
+		t.Fatalf("Closing an expired vote failed: %v", err)
+	}
+	if vote.Outcome != gameEntity.VoteOutcomeNoLynch || game.Vote.IsOpen() {
+		t.Errorf("Expected an empty vote to end without a lynch, got %s", vote.Outcome)
+	}
+}
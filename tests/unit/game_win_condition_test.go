@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gameEntity "telemafia/internal/domain/game/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// newWinGame returns a game in night 1 with town players 2, 4 and 6, mafia 3 and 5, and a survivor 7
+func newWinGame(t *testing.T) *gameEntity.Game {
+	t.Helper()
+	game := newRunningGame(scenarioEntity.DeathRevealNone)
+	game.Scenario.Sides = []scenarioEntity.Side{
+		{Name: "Mafia", WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinParity}}},
+		{Name: "Town", WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinEliminate, Sides: []string{"Mafia", "Killer"}}}},
+		{Name: "Survivor", WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinSurvive}}},
+		{Name: "Killer", WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinPersonal}}},
+	}
+	game.Assignments = map[sharedEntity.UserID]scenarioEntity.Role{
+		2: {Name: "Doctor", Side: "Town"},
+		3: {Name: "Godfather", Side: "Mafia"},
+		4: {Name: "Citizen", Side: "Town"},
+		5: {Name: "Mafia", Side: "Mafia"},
+		6: {Name: "Citizen", Side: "Town"},
+		7: {Name: "Survivor", Side: "Survivor"},
+	}
+	if err := game.AdvancePhase(gameEntity.PhaseNight, time.Now()); err != nil {
+		t.Fatalf("AdvancePhase failed: %v", err)
+	}
+	return game
+}
+
+func eliminateAll(t *testing.T, game *gameEntity.Game, ids ...sharedEntity.UserID) {
+	t.Helper()
+	for _, id := range ids {
+		if err := game.Eliminate(id, gameEntity.DeathCauseLynched); err != nil {
+			t.Fatalf("Eliminate(%d) failed: %v", id, err)
+		}
+	}
+}
+
+func TestTownWinsWhenMafiaIsEliminated(t *testing.T) {
+	game := newWinGame(t)
+	eliminateAll(t, game, 3)
+	if game.State == gameEntity.GameStateFinished {
+		t.Fatalf("Game finished with mafia still alive")
+	}
+	// The killer side was never dealt in, so only the mafia has to go
+	eliminateAll(t, game, 5)
+	if game.State != gameEntity.GameStateFinished {
+		t.Fatalf("Expected the game to finish, got %s", game.State)
+	}
+	if !reflect.DeepEqual(game.Winners, []string{"Town", "Survivor"}) {
+		t.Errorf("Expected town to win with the survivor, got %v", game.Winners)
+	}
+	if err := game.Eliminate(2, gameEntity.DeathCauseRemoved); !errors.Is(err, gameEntity.ErrGameFinished) {
+		t.Errorf("Expected ErrGameFinished after the win, got %v", err)
+	}
+}
+
+func TestMafiaWinsAtParity(t *testing.T) {
+	game := newWinGame(t)
+	eliminateAll(t, game, 2)
+	if game.State == gameEntity.GameStateFinished {
+		t.Fatalf("Game finished before parity: %v", game.Winners)
+	}
+	eliminateAll(t, game, 4)
+	if game.State != gameEntity.GameStateFinished || !reflect.DeepEqual(game.Winners, []string{"Mafia", "Survivor"}) {
+		t.Errorf("Expected mafia to win at parity with the survivor, got %s %v", game.State, game.Winners)
+	}
+}
+
+func TestNightResolutionCanEndTheGame(t *testing.T) {
+	game := newWinGame(t)
+	game.Assignments[3] = scenarioEntity.Role{Name: "Godfather", Side: "Mafia", Abilities: []scenarioEntity.Ability{{Name: "shoot", Effect: scenarioEntity.EffectKill}}}
+	eliminateAll(t, game, 2)
+	submitAction(t, game, 3, "shoot", 4)
+
+	if _, err := game.ResolveNight(gameEntity.NewNightResolver()); err != nil {
+		t.Fatalf("ResolveNight failed: %v", err)
+	}
+	if game.State != gameEntity.GameStateFinished || !game.SideWon("Mafia") {
+		t.Errorf("Expected the night kill to hand mafia the win, got %s %v", game.State, game.Winners)
+	}
+}
+
+func TestDeclareWinner(t *testing.T) {
+	game := newWinGame(t)
+	if err := game.DeclareWinner("Nobody"); !errors.Is(err, gameEntity.ErrUnknownSide) {
+		t.Errorf("Expected ErrUnknownSide, got %v", err)
+	}
+	if err := game.DeclareWinner("Killer"); err != nil {
+		t.Fatalf("DeclareWinner failed: %v", err)
+	}
+	if game.State != gameEntity.GameStateFinished || !reflect.DeepEqual(game.Winners, []string{"Killer", "Survivor"}) {
+		t.Errorf("Expected the killer to win with the survivor, got %s %v", game.State, game.Winners)
+	}
+}
+
+func TestWinConditionValidation(t *testing.T) {
+	scenario := &scenarioEntity.Scenario{
+		Name: "Test",
+		Sides: []scenarioEntity.Side{
+			{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Citizen"}}, WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinEliminate, Sides: []string{"Mafia"}}}},
+		},
+	}
+	if err := scenario.Validate(); err == nil {
+		t.Errorf("Expected an unknown side in a win condition to be rejected")
+	}
+	scenario.Sides = append(scenario.Sides, scenarioEntity.Side{Name: "Mafia", Roles: []scenarioEntity.Role{{Name: "Mafia"}}})
+	if err := scenario.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	scenario.Sides[1].WinConditions = []scenarioEntity.WinCondition{{Type: "domination"}}
+	if err := scenario.Validate(); err == nil {
+		t.Errorf("Expected an unknown win condition type to be rejected")
+	}
+}
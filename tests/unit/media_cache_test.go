@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	fsAdapter "telemafia/internal/adapters/filesystem"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	mediaCommand "telemafia/internal/domain/media/usecase/command"
+	mediaQuery "telemafia/internal/domain/media/usecase/query"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	"telemafia/internal/shared/common"
+)
+
+func TestMediaIsUploadedOncePerBotAndContent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "images"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	imagePath := filepath.Join(dir, "images", "godfather.jpg")
+	if err := os.WriteFile(imagePath, []byte("first"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := memrepo.NewInMemoryMediaCacheRepository()
+	resolve := mediaQuery.NewResolveMediaHandler(cache, fsAdapter.NewMediaDirectorySource(dir))
+	remember := mediaCommand.NewRememberMediaHandler(cache)
+
+	// The first send uploads the file
+	resolved, err := resolve.Handle(ctx, mediaQuery.ResolveMediaQuery{BotID: 1, Path: "./images/godfather.jpg"})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if resolved.FileID != "" || string(resolved.Data) != "first" || resolved.Path != "images/godfather.jpg" {
+		t.Fatalf("Expected an upload of images/godfather.jpg, got %+v", resolved)
+	}
+	if err := remember.Handle(ctx, mediaCommand.RememberMediaCommand{BotID: 1, Path: resolved.Path, Checksum: resolved.Checksum, FileID: "file-1"}); err != nil {
+		t.Fatalf("Remember failed: %v", err)
+	}
+
+	// Later sends by the same bot reuse the file ID
+	resolved, err = resolve.Handle(ctx, mediaQuery.ResolveMediaQuery{BotID: 1, Path: "images/godfather.jpg"})
+	if err != nil || resolved.FileID != "file-1" || resolved.Data != nil {
+		t.Fatalf("Expected cached file-1, got %+v (%v)", resolved, err)
+	}
+
+	// File IDs belong to one bot token
+	resolved, _ = resolve.Handle(ctx, mediaQuery.ResolveMediaQuery{BotID: 2, Path: "images/godfather.jpg"})
+	if resolved.FileID != "" {
+		t.Errorf("Expected another bot to upload again, got %q", resolved.FileID)
+	}
+
+	// A changed file is uploaded again
+	if err := os.WriteFile(imagePath, []byte("second"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	resolved, _ = resolve.Handle(ctx, mediaQuery.ResolveMediaQuery{BotID: 1, Path: "images/godfather.jpg"})
+	if resolved.FileID != "" || string(resolved.Data) != "second" {
+		t.Errorf("Expected the changed file to be uploaded, got %+v", resolved)
+	}
+
+	// Paths cannot leave the media directory
+	if _, err := resolve.Handle(ctx, mediaQuery.ResolveMediaQuery{BotID: 1, Path: "../secret.jpg"}); !errors.Is(err, common.ErrInvalidMediaPath) {
+		t.Errorf("Expected ErrInvalidMediaPath, got %v", err)
+	}
+}
+
+func TestScenarioRejectsImagesOutsideTheMediaDirectory(t *testing.T) {
+	scenario := &scenarioEntity.Scenario{
+		ID:   "images",
+		Name: "Images",
+		Sides: []scenarioEntity.Side{{
+			Name:  "Town",
+			Roles: []scenarioEntity.Role{{Name: "Citizen", Image: "images/citizen.jpg"}},
+		}},
+	}
+	if err := scenario.Validate(); err != nil {
+		t.Fatalf("Expected a local image to be valid, got %v", err)
+	}
+	scenario.Sides[0].Roles[0].Image = "/etc/passwd"
+	if err := scenario.Validate(); !errors.Is(err, common.ErrInvalidMediaPath) {
+		t.Errorf("Expected ErrInvalidMediaPath, got %v", err)
+	}
+}
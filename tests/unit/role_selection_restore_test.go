@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	telebot "gopkg.in/telebot.v4"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gamePort "telemafia/internal/domain/game/port"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	gameQuery "telemafia/internal/domain/game/usecase/query"
+	roomEntity "telemafia/internal/domain/room/entity"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	telegram "telemafia/internal/presentation/telegram/handler"
+	messages "telemafia/internal/presentation/telegram/messages"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// newRestoreBotHandler builds a bot handler wired only for restoring role selections, on an offline bot
+func newRestoreBotHandler(t *testing.T, gameRepo gamePort.GameReader, selectionRepo gamePort.RoleSelectionRepository) *telegram.BotHandler {
+	t.Helper()
+	bot, err := telebot.NewBot(telebot.Settings{Offline: true})
+	if err != nil {
+		t.Fatalf("Failed to create offline bot: %v", err)
+	}
+	msgs, err := messages.LoadMessages("../../messages.json")
+	if err != nil {
+		t.Fatalf("Failed to load messages: %v", err)
+	}
+	return telegram.NewBotHandler(bot, nil, msgs, nil,
+		gameCommand.NewSaveRoleSelectionHandler(selectionRepo),
+		gameCommand.NewDeleteRoleSelectionHandler(selectionRepo),
+		gameQuery.NewGetRoleSelectionsHandler(selectionRepo),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, // rooms
+		nil, nil, nil, nil, nil, // scenarios
+		nil, nil, nil, nil, // assign, create, update and list games
+		gameQuery.NewGetGameByIDHandler(gameRepo),
+		nil, nil, nil, nil, nil, nil, nil, nil, // phases, eliminations, game by room
+		nil, nil, nil, nil, nil, // votes and night actions
+		nil, nil, nil, nil, nil, nil, nil, // timers and speaking turns
+		nil, nil, nil, // seats and capacity
+		nil, nil, nil, nil, nil, // ready checks
+		nil, nil, nil, // spectators, visibility and invites
+		nil, nil, // media
+		nil, nil, nil, nil, nil, nil, nil, nil, // scenario editing
+		nil, nil, // scenario export
+	)
+}
+
+func TestRestoreInteractiveSelectionsRebuildsRunningRounds(t *testing.T) {
+	gameRepo := memrepo.NewInMemoryGameRepository()
+	selectionRepo := memrepo.NewInMemoryRoleSelectionRepository()
+	room := &roomEntity.Room{ID: "r1", Moderator: &sharedEntity.User{ID: 1}}
+	for _, game := range []*gameEntity.Game{
+		{ID: "selecting", Room: room, State: gameEntity.GameStateRoleSelection},
+		{ID: "started", Room: room, State: gameEntity.GameStateInProgress},
+	} {
+		if err := gameRepo.CreateGame(game); err != nil {
+			t.Fatalf("Failed to create game %s: %v", game.ID, err)
+		}
+	}
+
+	roles := []scenarioEntity.Role{{Name: "Doctor", Side: "Town"}, {Name: "Godfather", Side: "Mafia"}, {Name: "Citizen", Side: "Town"}}
+	running := &gameEntity.RoleSelection{
+		GameID:        "selecting",
+		ShuffledRoles: roles,
+		Choices: map[sharedEntity.UserID]gameEntity.RoleChoice{
+			2: {ChosenIndex: 3, Player: sharedEntity.User{ID: 2, FirstName: "Ali"}},
+			4: {ChosenIndex: 1, Player: sharedEntity.User{ID: 4, FirstName: "Sara"}},
+		},
+		Messages: []gameEntity.RoleSelectionMessage{
+			{Audience: gameEntity.RoleSelectionAudiencePlayer, ChatID: 2, MessageID: 20},
+			{Audience: gameEntity.RoleSelectionAudiencePlayer, ChatID: 3, MessageID: 30},
+			{Audience: gameEntity.RoleSelectionAudienceAdmin, ChatID: 1, MessageID: 10},
+		},
+	}
+	stale := &gameEntity.RoleSelection{GameID: "started", ShuffledRoles: roles, Choices: map[sharedEntity.UserID]gameEntity.RoleChoice{}}
+	orphan := &gameEntity.RoleSelection{GameID: "deleted", ShuffledRoles: roles, Choices: map[sharedEntity.UserID]gameEntity.RoleChoice{}}
+	for _, selection := range []*gameEntity.RoleSelection{running, stale, orphan} {
+		if err := selectionRepo.SaveRoleSelection(selection); err != nil {
+			t.Fatalf("Failed to save role selection of %s: %v", selection.GameID, err)
+		}
+	}
+
+	h := newRestoreBotHandler(t, gameRepo, selectionRepo)
+	h.RestoreInteractiveSelections()
+
+	state, ok := h.GetInteractiveSelectionState("selecting")
+	if !ok {
+		t.Fatal("Expected the running round to be restored")
+	}
+	if !reflect.DeepEqual(state.ShuffledRoles, roles) {
+		t.Errorf("Expected the shuffled roles to be restored, got %+v", state.ShuffledRoles)
+	}
+	if !reflect.DeepEqual(state.TakenIndices, map[int]bool{1: true, 3: true}) {
+		t.Errorf("Expected cards 1 and 3 taken, got %v", state.TakenIndices)
+	}
+	if len(state.Selections) != 2 || state.Selections[2].ChosenIndex != 3 || state.Selections[4].Player.FirstName != "Sara" {
+		t.Errorf("Expected the choices of players 2 and 4, got %+v", state.Selections)
+	}
+
+	players, ok := h.GetPlayerRoleRefresher("selecting")
+	if !ok {
+		t.Fatal("Expected the player refresh book to be restored")
+	}
+	for chatID, messageID := range map[int64]int{2: 20, 3: 30} {
+		if msg, ok := players.GetActiveMessage(chatID); !ok || msg.MessageID != messageID || msg.Data != "selecting" {
+			t.Errorf("Expected player message %d in chat %d, got %+v", messageID, chatID, msg)
+		}
+	}
+	admins, ok := h.GetAdminAssignmentTracker("selecting")
+	if !ok {
+		t.Fatal("Expected the admin refresh book to be restored")
+	}
+	if msg, ok := admins.GetActiveMessage(1); !ok || msg.MessageID != 10 || len(admins.GetAllActiveMessages()) != 1 {
+		t.Errorf("Expected only admin message 10 in chat 1, got %+v", admins.GetAllActiveMessages())
+	}
+	if !players.CheckRefreshNeeded() || !admins.CheckRefreshNeeded() {
+		t.Error("Expected the restored messages to be redrawn")
+	}
+
+	// Rounds of games that moved on or no longer exist are dropped from memory and storage
+	for _, gameID := range []gameEntity.GameID{"started", "deleted"} {
+		if _, ok := h.GetInteractiveSelectionState(gameID); ok {
+			t.Errorf("Expected the round of %s to be discarded", gameID)
+		}
+		if _, ok := h.GetPlayerRoleRefresher(gameID); ok {
+			t.Errorf("Expected no player refresh book for %s", gameID)
+		}
+		if _, err := selectionRepo.GetRoleSelection(gameID); err == nil {
+			t.Errorf("Expected the saved round of %s to be deleted", gameID)
+		}
+	}
+	if _, err := selectionRepo.GetRoleSelection("selecting"); err != nil {
+		t.Errorf("Expected the running round to stay saved, got %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
+	sharedEvent "telemafia/internal/shared/event"
+)
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(sharedEvent.Event) error { return nil }
+
+func waitlistIDs(room *roomEntity.Room) []sharedEntity.UserID {
+	var ids []sharedEntity.UserID
+	for _, p := range room.Waitlist {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestRoomWaitlistPromotion(t *testing.T) {
+	room := newSeatedRoom(2, 3)
+	if _, err := room.SetCapacity(-1); !errors.Is(err, roomEntity.ErrInvalidCapacity) {
+		t.Errorf("Expected ErrInvalidCapacity, got %v", err)
+	}
+	if promoted, _ := room.SetCapacity(2); len(promoted) != 0 || !room.IsFull() {
+		t.Fatalf("Expected a full room and nobody promoted, got %v", promoted)
+	}
+
+	if pos := room.AddToWaitlist(&sharedEntity.User{ID: 4}); pos != 1 {
+		t.Errorf("Expected place 1, got %d", pos)
+	}
+	room.AddToWaitlist(&sharedEntity.User{ID: 5})
+	if pos := room.AddToWaitlist(&sharedEntity.User{ID: 4}); pos != 1 {
+		t.Errorf("Joining twice should keep place 1, got %d", pos)
+	}
+
+	room.RemovePlayer(2)
+	promoted := room.PromoteWaitlisted()
+	if len(promoted) != 1 || promoted[0].ID != 4 {
+		t.Fatalf("Expected 4 to be promoted, got %v", promoted)
+	}
+	if want := []sharedEntity.UserID{3, 4}; !reflect.DeepEqual(seatIDs(room), want) {
+		t.Errorf("Expected seats %v, got %v", want, seatIDs(room))
+	}
+
+	// Raising the capacity seats everyone still waiting
+	promoted, _ = room.SetCapacity(0)
+	if len(promoted) != 1 || promoted[0].ID != 5 || len(room.Waitlist) != 0 {
+		t.Errorf("Expected 5 to be promoted by removing the limit, got %v (waiting %v)", promoted, waitlistIDs(room))
+	}
+}
+
+func TestJoinLeaveAndKickUseWaitlist(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	moderator := &sharedEntity.User{ID: 1}
+	room, _ := roomEntity.NewRoom("r1", "Friday night", moderator)
+	room.MaxPlayers = 2
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+
+	join := roomCommand.NewJoinRoomHandler(repo, nopPublisher{})
+	for _, id := range []sharedEntity.UserID{2, 3, 4, 5} {
+		result, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: id}, RoomID: room.ID})
+		if err != nil {
+			t.Fatalf("Join of %d failed: %v", id, err)
+		}
+		if waitlisted := id > 3; result.Waitlisted != waitlisted {
+			t.Errorf("Player %d: expected waitlisted %v, got %+v", id, waitlisted, result)
+		}
+	}
+
+	leave := roomCommand.NewLeaveRoomHandler(repo, nopPublisher{})
+	_, promoted, err := leave.Handle(ctx, roomCommand.LeaveRoomCommand{Requester: sharedEntity.User{ID: 2}, RoomID: room.ID})
+	if err != nil {
+		t.Fatalf("Leave failed: %v", err)
+	}
+	if len(promoted) != 1 || promoted[0].ID != 4 {
+		t.Fatalf("Expected 4 to take the freed seat, got %v", promoted)
+	}
+
+	// Kicking someone off the waitlist frees no seat
+	kick := roomCommand.NewKickUserHandler(repo, nopPublisher{})
+	updated, promoted, err := kick.Handle(ctx, roomCommand.KickUserCommand{Requester: *moderator, RoomID: room.ID, PlayerID: 5})
+	if err != nil {
+		t.Fatalf("Kick failed: %v", err)
+	}
+	if len(promoted) != 0 || len(updated.Waitlist) != 0 {
+		t.Errorf("Expected an empty waitlist and no promotion, got %v (waiting %v)", promoted, waitlistIDs(updated))
+	}
+	if want := []sharedEntity.UserID{3, 4}; !reflect.DeepEqual(seatIDs(updated), want) {
+		t.Errorf("Expected seats %v, got %v", want, seatIDs(updated))
+	}
+}
+
+func TestRoomsReadWhileChangedAreCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	room := newSeatedRoom(2, 3)
+	room.MaxPlayers = 2
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+
+	// Changing a room read from the repository leaves the stored room alone until it is saved
+	copied := storedRoom(t, repo, room.ID)
+	copied.AddToWaitlist(&sharedEntity.User{ID: 9})
+	if stored := storedRoom(t, repo, room.ID); len(stored.Waitlist) != 0 {
+		t.Errorf("Expected the stored waitlist to stay empty, got %v", waitlistIDs(stored))
+	}
+
+	join := roomCommand.NewJoinRoomHandler(repo, nopPublisher{})
+	leave := roomCommand.NewLeaveRoomHandler(repo, nopPublisher{})
+	var wg sync.WaitGroup
+	for id := sharedEntity.UserID(4); id < 14; id++ {
+		wg.Add(2)
+		go func(id sharedEntity.UserID) {
+			defer wg.Done()
+			if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: id}, RoomID: room.ID}); err != nil {
+				t.Errorf("Join of %d failed: %v", id, err)
+			}
+			if _, _, err := leave.Handle(ctx, roomCommand.LeaveRoomCommand{Requester: sharedEntity.User{ID: id}, RoomID: room.ID}); err != nil {
+				t.Errorf("Leave of %d failed: %v", id, err)
+			}
+		}(id)
+		go func() {
+			defer wg.Done()
+			rooms, _ := repo.GetRooms()
+			for _, r := range rooms {
+				_ = seatIDs(r)
+				_ = waitlistIDs(r)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestConcurrentJoinsDoNotOverfillRoom(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	room, _ := roomEntity.NewRoom("r1", "Friday night", &sharedEntity.User{ID: 1})
+	room.MaxPlayers = 3
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+
+	join := roomCommand.NewJoinRoomHandler(repo, nopPublisher{})
+	watch := roomCommand.NewWatchRoomHandler(repo)
+	var wg sync.WaitGroup
+	for id := sharedEntity.UserID(2); id < 22; id++ {
+		wg.Add(2)
+		go func(id sharedEntity.UserID) {
+			defer wg.Done()
+			if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: id}, RoomID: room.ID}); err != nil {
+				t.Errorf("Join of %d failed: %v", id, err)
+			}
+		}(id)
+		go func(id sharedEntity.UserID) {
+			defer wg.Done()
+			if _, err := watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: id + 100}, RoomID: room.ID}); err != nil {
+				t.Errorf("Watch of %d failed: %v", id+100, err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	updated, err := repo.GetRoomByID(room.ID)
+	if err != nil {
+		t.Fatalf("Failed to load room: %v", err)
+	}
+	if len(updated.Players) != 3 || len(updated.Waitlist) != 17 {
+		t.Errorf("Expected 3 seated and 17 waiting, got %v seated and %v waiting", seatIDs(updated), waitlistIDs(updated))
+	}
+	if len(updated.Spectators) != 20 {
+		t.Errorf("Expected 20 spectators, got %d", len(updated.Spectators))
+	}
+}
+
+func TestChangeModeratorRespectsCapacity(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	room := newSeatedRoom(2, 3)
+	room.MaxPlayers = 2
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	change := roomCommand.NewChangeModeratorHandler(repo)
+
+	// Handing over to a seated player frees the seat the previous moderator takes
+	result, err := change.Handle(ctx, roomCommand.ChangeModeratorCommand{Requester: &sharedEntity.User{ID: 1}, RoomID: room.ID, NewModerator: &sharedEntity.User{ID: 2}})
+	if err != nil {
+		t.Fatalf("Change moderator failed: %v", err)
+	}
+	room = storedRoom(t, repo, room.ID)
+	if got := seatIDs(room); !reflect.DeepEqual(got, []sharedEntity.UserID{3, 1}) {
+		t.Errorf("Expected seats [3 1], got %v", got)
+	}
+	if !reflect.DeepEqual(result.Seated, []sharedEntity.UserID{1}) || !reflect.DeepEqual(result.Unseated, []sharedEntity.UserID{2}) || result.Position != 0 {
+		t.Errorf("Expected 1 seated and 2 unseated, got %+v", result)
+	}
+
+	// Handing over to a spectator of a full room sends the previous moderator to the waitlist
+	if _, err := roomCommand.NewWatchRoomHandler(repo).Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID}); err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+	result, err = change.Handle(ctx, roomCommand.ChangeModeratorCommand{Requester: &sharedEntity.User{ID: 2}, RoomID: room.ID, NewModerator: &sharedEntity.User{ID: 7}})
+	if err != nil {
+		t.Fatalf("Change moderator failed: %v", err)
+	}
+	room = storedRoom(t, repo, room.ID)
+	if len(room.Players) != 2 || room.IsSpectator(7) || !reflect.DeepEqual(waitlistIDs(room), []sharedEntity.UserID{2}) {
+		t.Errorf("Expected 2 players and 2 waiting, got seats %v, waitlist %v", seatIDs(room), waitlistIDs(room))
+	}
+	if len(result.Seated) != 0 || len(result.Unseated) != 0 || result.Position != 1 || result.Previous.ID != 2 {
+		t.Errorf("Expected the previous moderator first on the waitlist, got %+v", result)
+	}
+}
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomPort "telemafia/internal/domain/room/port"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func newSeatedRoom(ids ...sharedEntity.UserID) *roomEntity.Room {
+	room := &roomEntity.Room{ID: "r1", Moderator: &sharedEntity.User{ID: 1}}
+	for _, id := range ids {
+		room.AddPlayer(&sharedEntity.User{ID: id})
+	}
+	return room
+}
+
+// storedRoom reads a room back from the repository, which hands out copies
+func storedRoom(t *testing.T, repo roomPort.RoomRepository, id roomEntity.RoomID) *roomEntity.Room {
+	t.Helper()
+	room, err := repo.GetRoomByID(id)
+	if err != nil {
+		t.Fatalf("Failed to load room %s: %v", id, err)
+	}
+	return room
+}
+
+func seatIDs(room *roomEntity.Room) []sharedEntity.UserID {
+	var ids []sharedEntity.UserID
+	for _, p := range room.Players {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func TestMoveSeat(t *testing.T) {
+	room := newSeatedRoom(2, 3, 4, 5)
+	if room.SeatOf(4) != 3 || room.SeatOf(9) != 0 {
+		t.Fatalf("Unexpected seats: 4 -> %d, 9 -> %d", room.SeatOf(4), room.SeatOf(9))
+	}
+
+	if err := room.MoveSeat(4, -1); err != nil {
+		t.Fatalf("MoveSeat up failed: %v", err)
+	}
+	if want := []sharedEntity.UserID{2, 4, 3, 5}; !reflect.DeepEqual(seatIDs(room), want) {
+		t.Errorf("Expected %v after moving up, got %v", want, seatIDs(room))
+	}
+	if err := room.MoveSeat(2, 3); err != nil {
+		t.Fatalf("MoveSeat down failed: %v", err)
+	}
+	if want := []sharedEntity.UserID{4, 3, 5, 2}; !reflect.DeepEqual(seatIDs(room), want) {
+		t.Errorf("Expected %v after moving to the last seat, got %v", want, seatIDs(room))
+	}
+
+	if err := room.MoveSeat(4, -1); !errors.Is(err, roomEntity.ErrSeatOutOfRange) {
+		t.Errorf("Expected ErrSeatOutOfRange, got %v", err)
+	}
+	if err := room.MoveSeat(9, 1); !errors.Is(err, roomEntity.ErrPlayerNotInRoom) {
+		t.Errorf("Expected ErrPlayerNotInRoom, got %v", err)
+	}
+}
+
+func TestShuffleSeats(t *testing.T) {
+	room := newSeatedRoom(2, 3, 4)
+	reverse := func(n int, swap func(i, j int)) {
+		for i := 0; i < n/2; i++ {
+			swap(i, n-1-i)
+		}
+	}
+	room.ShuffleSeats(reverse)
+	if want := []sharedEntity.UserID{4, 3, 2}; !reflect.DeepEqual(seatIDs(room), want) {
+		t.Errorf("Expected %v, got %v", want, seatIDs(room))
+	}
+}
+
+func TestGameTakesSeatsWhenRolesAreAssigned(t *testing.T) {
+	game := &gameEntity.Game{
+		ID:   "g1",
+		Room: newSeatedRoom(4, 2, 9, 3),
+		Assignments: map[sharedEntity.UserID]scenarioEntity.Role{
+			2: {Name: "Doctor"}, 3: {Name: "Godfather"}, 4: {Name: "Citizen"}, 5: {Name: "Citizen"},
+		},
+	}
+	game.SetRolesAssigned()
+	// 9 has no role; 5 left the room and sits after everyone else
+	if want := []sharedEntity.UserID{4, 2, 3, 5}; !reflect.DeepEqual(game.Seats, want) {
+		t.Fatalf("Expected seats %v, got %v", want, game.Seats)
+	}
+
+	// Reordering the room afterwards does not change the game
+	if err := game.Room.MoveSeat(3, -3); err != nil {
+		t.Fatalf("MoveSeat failed: %v", err)
+	}
+	if want := []sharedEntity.UserID{4, 2, 3, 5}; !reflect.DeepEqual(game.SeatOrder(), want) {
+		t.Errorf("Expected seat order %v, got %v", want, game.SeatOrder())
+	}
+}
+
+func TestAssignRolesDealsInSeatOrder(t *testing.T) {
+	common.InitSeed()
+	roomRepo := memrepo.NewInMemoryRoomRepository()
+	gameRepo := memrepo.NewInMemoryGameRepository()
+	scenarioRepo := memrepo.NewInMemoryScenarioRepository()
+	scenario := &scenarioEntity.Scenario{ID: "s1", Name: "Test", Sides: []scenarioEntity.Side{
+		{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Citizen"}, {Name: "Doctor"}, {Name: "Detective"}}},
+	}}
+	if err := scenarioRepo.CreateScenario(scenario); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	// The game was created before the moderator moved player 4 to seat 1
+	if err := roomRepo.CreateRoom(newSeatedRoom(4, 2, 3)); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	game := &gameEntity.Game{ID: "g1", State: gameEntity.GameStateWaitingForPlayers, Room: newSeatedRoom(2, 3, 4), Scenario: scenario, Assignments: make(map[sharedEntity.UserID]scenarioEntity.Role)}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+
+	assign := gameCommand.NewAssignRolesHandler(gameRepo, scenarioRepo, roomRepo)
+	assignments, err := assign.Handle(context.Background(), gameCommand.AssignRolesCommand{Requester: sharedEntity.User{ID: 1}, GameID: game.ID})
+	if err != nil {
+		t.Fatalf("AssignRoles failed: %v", err)
+	}
+	if len(assignments) != 3 {
+		t.Errorf("Expected 3 assignments, got %d", len(assignments))
+	}
+	stored, _ := gameRepo.GetGameByID(game.ID)
+	if want := []sharedEntity.UserID{4, 2, 3}; !reflect.DeepEqual(stored.Seats, want) {
+		t.Errorf("Expected the game to take the room's seats %v, got %v", want, stored.Seats)
+	}
+}
@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestSpectatorsAreNotPlayers(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	room := newSeatedRoom(2, 3)
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+
+	watch := roomCommand.NewWatchRoomHandler(repo)
+	for _, id := range []sharedEntity.UserID{1, 2} {
+		if _, err := watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: id}, RoomID: room.ID}); !errors.Is(err, roomEntity.ErrCannotWatch) {
+			t.Errorf("User %d: expected ErrCannotWatch, got %v", id, err)
+		}
+	}
+	for range 2 {
+		if _, err := watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID}); err != nil {
+			t.Fatalf("Watch failed: %v", err)
+		}
+	}
+	players, _ := repo.GetPlayersInRoom(room.ID)
+	room = storedRoom(t, repo, room.ID)
+	if len(players) != 2 || len(room.Spectators) != 1 || !room.IsSpectator(7) {
+		t.Fatalf("Expected 2 players and spectator 7, got %d players and %v", len(players), room.Spectators)
+	}
+
+	// Taking a seat ends watching
+	if _, err := roomCommand.NewJoinRoomHandler(repo, nopPublisher{}).Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID}); err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	room = storedRoom(t, repo, room.ID)
+	if room.IsSpectator(7) || !room.HasPlayer(7) {
+		t.Errorf("Expected 7 to play instead of watching, got spectators %v", room.Spectators)
+	}
+
+	// Leaving as a spectator frees no seat
+	_, _ = watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: 8}, RoomID: room.ID})
+	leave := roomCommand.NewLeaveRoomHandler(repo, nopPublisher{})
+	if _, promoted, err := leave.Handle(ctx, roomCommand.LeaveRoomCommand{Requester: sharedEntity.User{ID: 8}, RoomID: room.ID}); err != nil || promoted != nil {
+		t.Fatalf("Leave failed: %v (promoted %v)", err, promoted)
+	}
+	room = storedRoom(t, repo, room.ID)
+	if room.IsSpectator(8) || len(room.Players) != 3 {
+		t.Errorf("Expected spectator 8 gone and 3 players left, got %v and %d players", room.Spectators, len(room.Players))
+	}
+
+	// The moderator can send a spectator away without touching the seats
+	_, _ = watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: 9}, RoomID: room.ID})
+	kick := roomCommand.NewKickUserHandler(repo, nopPublisher{})
+	if _, promoted, err := kick.Handle(ctx, roomCommand.KickUserCommand{Requester: sharedEntity.User{ID: 1}, RoomID: room.ID, PlayerID: 9}); err != nil || promoted != nil {
+		t.Fatalf("Kick failed: %v (promoted %v)", err, promoted)
+	}
+	room = storedRoom(t, repo, room.ID)
+	if room.IsSpectator(9) || len(room.Players) != 3 {
+		t.Errorf("Expected spectator 9 gone and 3 players left, got %v and %d players", room.Spectators, len(room.Players))
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	roomEntity "telemafia/internal/domain/room/entity"
+	roomCommand "telemafia/internal/domain/room/usecase/command"
+	roomHandler "telemafia/internal/presentation/telegram/handler/room"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestRoomVisibilityControlsJoining(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	room := newSeatedRoom(2, 3)
+	room.RegenerateInvite("abc123")
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	mod := sharedEntity.User{ID: room.Moderator.ID}
+	setVisibility := roomCommand.NewSetRoomVisibilityHandler(repo)
+	join := roomCommand.NewJoinRoomHandler(repo, nopPublisher{})
+
+	if _, err := setVisibility.Handle(ctx, roomCommand.SetRoomVisibilityCommand{Requester: sharedEntity.User{ID: 2}, RoomID: room.ID, Visibility: roomEntity.VisibilityUnlisted}); err == nil {
+		t.Fatal("Expected players to be refused changing the visibility")
+	}
+	if _, err := setVisibility.Handle(ctx, roomCommand.SetRoomVisibilityCommand{Requester: mod, RoomID: room.ID, Visibility: roomEntity.VisibilityPassword}); !errors.Is(err, roomEntity.ErrPasswordMissing) {
+		t.Fatalf("Expected ErrPasswordMissing, got %v", err)
+	}
+
+	// Unlisted rooms are hidden and only open to the invite link
+	if _, err := setVisibility.Handle(ctx, roomCommand.SetRoomVisibilityCommand{Requester: mod, RoomID: room.ID, Visibility: roomEntity.VisibilityUnlisted}); err != nil {
+		t.Fatalf("Set visibility failed: %v", err)
+	}
+	if storedRoom(t, repo, room.ID).IsListed() {
+		t.Error("Expected unlisted room to be hidden from the list")
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID}); !errors.Is(err, roomEntity.ErrInviteRequired) {
+		t.Errorf("Expected ErrInviteRequired, got %v", err)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID, InviteCode: "wrong"}); !errors.Is(err, roomEntity.ErrInvalidInvite) {
+		t.Errorf("Expected ErrInvalidInvite, got %v", err)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID, InviteCode: "abc123"}); err != nil || !storedRoom(t, repo, room.ID).HasPlayer(7) {
+		t.Fatalf("Expected the invite link to seat 7, got %v", err)
+	}
+
+	// Password rooms are listed and accept the password or the invite link
+	if _, err := setVisibility.Handle(ctx, roomCommand.SetRoomVisibilityCommand{Requester: mod, RoomID: room.ID, Visibility: roomEntity.VisibilityPassword, Password: " 1234 "}); err != nil {
+		t.Fatalf("Set visibility failed: %v", err)
+	}
+	room = storedRoom(t, repo, room.ID)
+	if !room.IsListed() || room.PasswordHash == "" || strings.Contains(room.PasswordHash, "1234") {
+		t.Errorf("Expected a listed password room with a hashed password, got listed=%v hash %q", room.IsListed(), room.PasswordHash)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 8}, RoomID: room.ID}); !errors.Is(err, roomEntity.ErrPasswordRequired) {
+		t.Errorf("Expected ErrPasswordRequired, got %v", err)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 8}, RoomID: room.ID, Password: "0000"}); !errors.Is(err, roomEntity.ErrWrongPassword) {
+		t.Errorf("Expected ErrWrongPassword, got %v", err)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 8}, RoomID: room.ID, Password: "1234"}); err != nil || !storedRoom(t, repo, room.ID).HasPlayer(8) {
+		t.Fatalf("Expected the password to seat 8, got %v", err)
+	}
+	watch := roomCommand.NewWatchRoomHandler(repo)
+	if _, err := watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: 9}, RoomID: room.ID}); !errors.Is(err, roomEntity.ErrPasswordRequired) {
+		t.Errorf("Expected watching to need the password, got %v", err)
+	}
+	if _, err := watch.Handle(ctx, roomCommand.WatchRoomCommand{Requester: sharedEntity.User{ID: 9}, RoomID: room.ID, InviteCode: "abc123"}); err != nil || !storedRoom(t, repo, room.ID).IsSpectator(9) {
+		t.Errorf("Expected the invite link to let 9 watch, got %v", err)
+	}
+
+	// Players already seated are not asked again
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 2}, RoomID: room.ID}); err != nil {
+		t.Errorf("Expected seated player to rejoin freely, got %v", err)
+	}
+}
+
+func TestInviteLinksCanBeRevokedAndRegenerated(t *testing.T) {
+	ctx := context.Background()
+	repo := memrepo.NewInMemoryRoomRepository()
+	room := newSeatedRoom(2, 3)
+	room.RegenerateInvite("abc123")
+	_ = room.SetVisibility(roomEntity.VisibilityUnlisted, "")
+	if err := repo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	mod := sharedEntity.User{ID: room.Moderator.ID}
+	reset := roomCommand.NewResetInviteHandler(repo)
+	join := roomCommand.NewJoinRoomHandler(repo, nopPublisher{})
+
+	if _, err := reset.Handle(ctx, roomCommand.ResetInviteCommand{Requester: sharedEntity.User{ID: 2}, RoomID: room.ID}); err == nil {
+		t.Fatal("Expected players to be refused resetting the invite link")
+	}
+	if _, err := reset.Handle(ctx, roomCommand.ResetInviteCommand{Requester: mod, RoomID: room.ID, Revoke: true}); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID, InviteCode: "abc123"}); !errors.Is(err, roomEntity.ErrInvalidInvite) {
+		t.Errorf("Expected revoked link to be refused, got %v", err)
+	}
+
+	updated, err := reset.Handle(ctx, roomCommand.ResetInviteCommand{Requester: mod, RoomID: room.ID})
+	if err != nil {
+		t.Fatalf("Regenerate failed: %v", err)
+	}
+	if updated.InviteCode == "" || updated.InviteCode == "abc123" {
+		t.Fatalf("Expected a fresh invite code, got %q", updated.InviteCode)
+	}
+	if _, err := join.Handle(ctx, roomCommand.JoinRoomCommand{Requester: sharedEntity.User{ID: 7}, RoomID: room.ID, InviteCode: updated.InviteCode}); err != nil {
+		t.Errorf("Expected the new link to work, got %v", err)
+	}
+}
+
+func TestInviteLinksOfferJoiningAndWatching(t *testing.T) {
+	room := newSeatedRoom(2, 3)
+	room.RegenerateInvite("abc123")
+	_ = room.SetVisibility(roomEntity.VisibilityUnlisted, "")
+
+	if got, want := roomHandler.InviteLink("bot", room), "https://t.me/bot?start=join_room-"+string(room.ID)+"-abc123"; got != want {
+		t.Errorf("Expected join link %s, got %s", want, got)
+	}
+	if got, want := roomHandler.WatchLink("bot", room), "https://t.me/bot?start=watch_room-"+string(room.ID)+"-abc123"; got != want {
+		t.Errorf("Expected watch link %s, got %s", want, got)
+	}
+	room.RevokeInvite()
+	if link := roomHandler.WatchLink("bot", room); link != "" {
+		t.Errorf("Expected no watch link once the invite is revoked, got %s", link)
+	}
+}
@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+var editorAdmin = sharedEntity.User{ID: 1, Admin: true}
+
+func newEditableScenario(t *testing.T) scenarioPort.ScenarioRepository {
+	t.Helper()
+	repo := memrepo.NewInMemoryScenarioRepository()
+	if err := repo.CreateScenario(&scenarioEntity.Scenario{ID: "s1", Name: "Test"}); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	return repo
+}
+
+func TestScenarioEditorBuildsScenarioFromScratch(t *testing.T) {
+	repo := newEditableScenario(t)
+	ctx := context.Background()
+
+	if _, err := scenarioCommand.NewAddSideHandler(repo).Handle(ctx, scenarioCommand.AddSideCommand{Requester: sharedEntity.User{ID: 2}, ScenarioID: "s1", Name: "Mafia", DefaultRole: "Goon"}); err == nil {
+		t.Fatalf("Expected non-admins to be refused")
+	}
+	for _, side := range [][2]string{{"Mafia", "Goon"}, {"Town", "Villager"}} {
+		if _, err := scenarioCommand.NewAddSideHandler(repo).Handle(ctx, scenarioCommand.AddSideCommand{Requester: editorAdmin, ScenarioID: "s1", Name: side[0], DefaultRole: side[1]}); err != nil {
+			t.Fatalf("Failed to add side %s: %v", side[0], err)
+		}
+	}
+	if _, err := scenarioCommand.NewAddSideHandler(repo).Handle(ctx, scenarioCommand.AddSideCommand{Requester: editorAdmin, ScenarioID: "s1", Name: "Town", DefaultRole: "X"}); !errors.Is(err, scenarioEntity.ErrDuplicateSide) {
+		t.Errorf("Expected a duplicate side to be refused, got %v", err)
+	}
+	if _, err := scenarioCommand.NewAddRoleHandler(repo).Handle(ctx, scenarioCommand.AddRoleCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Town", RoleName: "Doctor"}); err != nil {
+		t.Fatalf("Failed to add role: %v", err)
+	}
+	if _, err := scenarioCommand.NewSetRoleAddedAtHandler(repo).Handle(ctx, scenarioCommand.SetRoleAddedAtCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Town", RoleIndex: 0, AddedAt: 5}); err != nil {
+		t.Fatalf("Failed to set added_at: %v", err)
+	}
+	rate := float32(0.25)
+	if _, err := scenarioCommand.NewSetPopulationRateHandler(repo).Handle(ctx, scenarioCommand.SetPopulationRateCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Mafia", Rate: &rate}); err != nil {
+		t.Fatalf("Failed to set population rate: %v", err)
+	}
+	tooHigh := float32(1.5)
+	if _, err := scenarioCommand.NewSetPopulationRateHandler(repo).Handle(ctx, scenarioCommand.SetPopulationRateCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Mafia", Rate: &tooHigh}); !errors.Is(err, scenarioEntity.ErrInvalidPopulationRate) {
+		t.Errorf("Expected an invalid rate to be refused, got %v", err)
+	}
+
+	scenario, _ := repo.GetScenarioByID("s1")
+	if err := scenario.Validate(); err != nil {
+		t.Fatalf("Edited scenario is invalid: %v", err)
+	}
+	town := scenario.Sides[1]
+	if len(town.Roles) != 1 || town.Roles[0].Name != "Doctor" || town.Roles[0].AddedAt != 5 {
+		t.Errorf("Unexpected town roles: %+v", town.Roles)
+	}
+	if scenario.Sides[0].PopulationRate == nil || *scenario.Sides[0].PopulationRate != 0.25 {
+		t.Errorf("Expected mafia population rate 0.25, got %v", scenario.Sides[0].PopulationRate)
+	}
+	if roles := scenario.GetRoles(8); len(roles) != 8 {
+		t.Errorf("Expected 8 roles for 8 players, got %d", len(roles))
+	}
+}
+
+func TestScenarioEditorRenamesAndRefusesBrokenEdits(t *testing.T) {
+	repo := memrepo.NewInMemoryScenarioRepository()
+	stored := &scenarioEntity.Scenario{ID: "s2", Name: "Test", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", DefaultRole: &scenarioEntity.Role{Name: "Goon"}, WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinParity}}},
+		{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Detective", Description: "Checks a player"}},
+			WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinEliminate, Sides: []string{"Mafia"}}}},
+	}}
+	if err := repo.CreateScenario(stored); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	ctx := context.Background()
+
+	renamed, err := scenarioCommand.NewRenameSideHandler(repo).Handle(ctx, scenarioCommand.RenameSideCommand{Requester: editorAdmin, ScenarioID: "s2", Side: "Mafia", NewName: "Syndicate"})
+	if err != nil {
+		t.Fatalf("Failed to rename side: %v", err)
+	}
+	if got := renamed.Sides[1].WinConditions[0].Sides[0]; got != "Syndicate" {
+		t.Errorf("Expected the win condition to follow the rename, got %s", got)
+	}
+	if stored.Sides[0].Name != "Mafia" {
+		t.Errorf("Expected the previously loaded scenario to be left untouched, got %s", stored.Sides[0].Name)
+	}
+
+	// Town would have no roles left
+	if _, err := scenarioCommand.NewRemoveRoleHandler(repo).Handle(ctx, scenarioCommand.RemoveRoleCommand{Requester: editorAdmin, ScenarioID: "s2", Side: "Town", RoleIndex: 0}); err == nil {
+		t.Errorf("Expected removing the last role of a side to be refused")
+	}
+	current, _ := repo.GetScenarioByID("s2")
+	if len(current.Sides) != 2 || len(current.Sides[1].Roles) != 1 {
+		t.Errorf("Expected refused edits to leave the scenario unchanged, got %+v", current.Sides)
+	}
+
+	withDefault, err := scenarioCommand.NewSetDefaultRoleHandler(repo).Handle(ctx, scenarioCommand.SetDefaultRoleCommand{Requester: editorAdmin, ScenarioID: "s2", Side: "Town", RoleName: "Detective"})
+	if err != nil {
+		t.Fatalf("Failed to set default role: %v", err)
+	}
+	if def := withDefault.Sides[1].DefaultRole; def == nil || def.Description != "Checks a player" {
+		t.Errorf("Expected the default role to copy the side's role, got %+v", def)
+	}
+	if _, err := scenarioCommand.NewRemoveRoleHandler(repo).Handle(ctx, scenarioCommand.RemoveRoleCommand{Requester: editorAdmin, ScenarioID: "s2", Side: "Town", RoleIndex: 0}); err != nil {
+		t.Errorf("Expected the role to be removable once the side has a default role, got %v", err)
+	}
+
+	// Syndicate is the only side Town must eliminate, so that condition goes with it
+	removed, err := scenarioCommand.NewRemoveSideHandler(repo).Handle(ctx, scenarioCommand.RemoveSideCommand{Requester: editorAdmin, ScenarioID: "s2", Side: "Syndicate"})
+	if err != nil {
+		t.Fatalf("Failed to remove side: %v", err)
+	}
+	if len(removed.Sides) != 1 || len(removed.Sides[0].WinConditions) != 0 {
+		t.Errorf("Expected Town to be left without the condition on the removed side, got %+v", removed.Sides)
+	}
+}
+
+func TestScenarioEditorRemoveSideKeepsOtherTargets(t *testing.T) {
+	repo := memrepo.NewInMemoryScenarioRepository()
+	if err := repo.CreateScenario(&scenarioEntity.Scenario{ID: "s3", Name: "Test", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", DefaultRole: &scenarioEntity.Role{Name: "Goon"}},
+		{Name: "Cult", DefaultRole: &scenarioEntity.Role{Name: "Cultist"}},
+		{Name: "Town", DefaultRole: &scenarioEntity.Role{Name: "Villager"}, WinConditions: []scenarioEntity.WinCondition{
+			{Type: scenarioEntity.WinEliminate, Sides: []string{"Mafia", "Cult"}},
+			{Type: scenarioEntity.WinEliminate, Sides: []string{"Cult"}},
+			{Type: scenarioEntity.WinSurvive},
+		}},
+	}}); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+
+	removed, err := scenarioCommand.NewRemoveSideHandler(repo).Handle(context.Background(), scenarioCommand.RemoveSideCommand{Requester: editorAdmin, ScenarioID: "s3", Side: "Cult"})
+	if err != nil {
+		t.Fatalf("Failed to remove side: %v", err)
+	}
+	want := []scenarioEntity.WinCondition{
+		{Type: scenarioEntity.WinEliminate, Sides: []string{"Mafia"}},
+		{Type: scenarioEntity.WinSurvive},
+	}
+	if got := removed.Sides[1].WinConditions; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
+
+func TestScenarioEditorRefusesRoleEditsFromAnOutdatedList(t *testing.T) {
+	repo := newEditableScenario(t)
+	ctx := context.Background()
+	if _, err := scenarioCommand.NewAddSideHandler(repo).Handle(ctx, scenarioCommand.AddSideCommand{Requester: editorAdmin, ScenarioID: "s1", Name: "Town", DefaultRole: "Villager"}); err != nil {
+		t.Fatalf("Failed to add side: %v", err)
+	}
+	for _, role := range []string{"Doctor", "Detective"} {
+		if _, err := scenarioCommand.NewAddRoleHandler(repo).Handle(ctx, scenarioCommand.AddRoleCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Town", RoleName: role}); err != nil {
+			t.Fatalf("Failed to add role %s: %v", role, err)
+		}
+	}
+	// A second admin removes the doctor, so the detective moves to #1
+	if _, err := scenarioCommand.NewRemoveRoleHandler(repo).Handle(ctx, scenarioCommand.RemoveRoleCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Town", RoleIndex: 0, RoleName: "Doctor"}); err != nil {
+		t.Fatalf("Failed to remove role: %v", err)
+	}
+	if _, err := scenarioCommand.NewRemoveRoleHandler(repo).Handle(ctx, scenarioCommand.RemoveRoleCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Town", RoleIndex: 0, RoleName: "Doctor"}); !errors.Is(err, scenarioEntity.ErrRoleNotFound) {
+		t.Errorf("Expected a removal from the outdated list to be refused, got %v", err)
+	}
+	if _, err := scenarioCommand.NewSetRoleAddedAtHandler(repo).Handle(ctx, scenarioCommand.SetRoleAddedAtCommand{Requester: editorAdmin, ScenarioID: "s1", Side: "Town", RoleIndex: 0, RoleName: "Doctor", AddedAt: 7}); !errors.Is(err, scenarioEntity.ErrRoleNotFound) {
+		t.Errorf("Expected an added_at change from the outdated list to be refused, got %v", err)
+	}
+
+	scenario, _ := repo.GetScenarioByID("s1")
+	roles := scenario.Sides[0].Roles
+	if len(roles) != 1 || roles[0].Name != "Detective" || roles[0].AddedAt != 0 {
+		t.Errorf("Expected the detective to be left untouched, got %+v", roles)
+	}
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	fsAdapter "telemafia/internal/adapters/filesystem"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	scenarioQuery "telemafia/internal/domain/scenario/usecase/query"
+	scenarioHandler "telemafia/internal/presentation/telegram/handler/scenario"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestExportedScenariosRoundTripThroughUpload(t *testing.T) {
+	source := memrepo.NewInMemoryScenarioRepository()
+	seed := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource("../../resources/scenario"), source)
+	if _, err := seed.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{}); err != nil {
+		t.Fatalf("Seeding failed: %v", err)
+	}
+	// Handing out roles stamps their side on the stored default roles; exports must not carry it
+	stored, _ := source.GetScenarioByID("classic")
+	stored.GetRoles(10)
+
+	admin := sharedEntity.User{Admin: true}
+	if _, err := scenarioQuery.NewExportAllScenariosHandler(source).Handle(context.Background(), scenarioQuery.ExportAllScenariosQuery{Requester: sharedEntity.User{ID: 7}}); err == nil {
+		t.Errorf("Expected a non-admin to be refused the export")
+	}
+	if _, err := scenarioQuery.NewExportScenarioHandler(source).Handle(context.Background(), scenarioQuery.ExportScenarioQuery{Requester: sharedEntity.User{ID: 7}, ID: "classic"}); err == nil {
+		t.Errorf("Expected a non-admin to be refused the scenario export")
+	}
+	exported, err := scenarioQuery.NewExportAllScenariosHandler(source).Handle(context.Background(), scenarioQuery.ExportAllScenariosQuery{Requester: admin})
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	all, _ := source.GetAllScenarios()
+	if len(exported) != len(all) {
+		t.Fatalf("Expected %d exported scenarios, got %d", len(all), len(exported))
+	}
+
+	target := memrepo.NewInMemoryScenarioRepository()
+	upload := scenarioCommand.NewAddScenarioJSONHandler(target)
+	for _, file := range exported {
+		imported, err := upload.Handle(context.Background(), scenarioCommand.AddScenarioJSONCommand{Requester: admin, JSONData: string(file.Data)})
+		if err != nil {
+			t.Fatalf("Failed to import %s: %v", file.FileName, err)
+		}
+		if file.FileName != imported.ID+".json" {
+			t.Errorf("Expected %s to import under its own ID, got %s", file.FileName, imported.ID)
+		}
+		again, err := scenarioQuery.NewExportScenarioHandler(target).Handle(context.Background(), scenarioQuery.ExportScenarioQuery{Requester: admin, ID: imported.ID})
+		if err != nil {
+			t.Fatalf("Failed to export %s again: %v", imported.ID, err)
+		}
+		if !bytes.Equal(again.Data, file.Data) {
+			t.Errorf("Scenario %s changed through export and import:\n got  %s\n want %s", imported.ID, again.Data, file.Data)
+		}
+	}
+	for _, file := range exported {
+		if bytes.Contains(file.Data, []byte(`"side"`)) {
+			t.Errorf("Expected %s to leave out the side of roles", file.FileName)
+		}
+	}
+
+	archive, err := scenarioHandler.ZipScenarios(exported)
+	if err != nil {
+		t.Fatalf("Failed to zip scenarios: %v", err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("Failed to read archive: %v", err)
+	}
+	if len(reader.File) != len(exported) {
+		t.Fatalf("Expected %d files in the archive, got %d", len(exported), len(reader.File))
+	}
+	f, _ := reader.File[0].Open()
+	data, _ := io.ReadAll(f)
+	if reader.File[0].Name != exported[0].FileName || !bytes.Equal(data, exported[0].Data) {
+		t.Errorf("Archive entry %s does not match the export", reader.File[0].Name)
+	}
+}
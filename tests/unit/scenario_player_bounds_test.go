@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apiAdapter "telemafia/internal/adapters/api"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	gameEntity "telemafia/internal/domain/game/entity"
+	gameCommand "telemafia/internal/domain/game/usecase/command"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	"telemafia/internal/shared/common"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+// boundedScenario fits 4-6 players: with 3 the town roles fill every seat, and after 6 the town roles run out
+func boundedScenario() *scenarioEntity.Scenario {
+	rate := float32(0.34)
+	return &scenarioEntity.Scenario{Name: "Bounded", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", PopulationRate: &rate, DefaultRole: &scenarioEntity.Role{Name: "Goon"}},
+		{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Doctor"}, {Name: "Detective"}, {Name: "Villager", AddedAt: 3}, {Name: "Sniper", AddedAt: 5}}},
+	}}
+}
+
+func TestScenarioPlayerBoundsAreComputedFromRoles(t *testing.T) {
+	scenario := boundedScenario()
+	if min, max := scenario.PlayerBounds(); min != 4 || max != 6 {
+		t.Fatalf("Expected computed bounds 4-6, got %d-%d", min, max)
+	}
+	if err := scenario.CheckPlayerCount(5); err != nil {
+		t.Errorf("Expected 5 players to fit, got %v", err)
+	}
+	if err := scenario.CheckPlayerCount(3); !errors.Is(err, scenarioEntity.ErrTooFewPlayers) {
+		t.Errorf("Expected too few players, got %v", err)
+	}
+	if err := scenario.CheckPlayerCount(7); !errors.Is(err, scenarioEntity.ErrTooManyPlayers) {
+		t.Errorf("Expected too many players, got %v", err)
+	}
+
+	villagers := &scenarioEntity.Scenario{Name: "Open", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", Roles: []scenarioEntity.Role{{Name: "Boss"}}},
+		{Name: "Town", DefaultRole: &scenarioEntity.Role{Name: "Villager"}},
+	}}
+	if min, max := villagers.PlayerBounds(); min != 2 || max != 0 {
+		t.Errorf("Expected a default role without rate to leave the maximum open, got %d-%d", min, max)
+	}
+}
+
+func TestScenarioPlayerBoundsPreferDeclaredCounts(t *testing.T) {
+	scenario := boundedScenario()
+	scenario.MinPlayers = 5
+	if min, max := scenario.PlayerBounds(); min != 5 || max != 6 {
+		t.Errorf("Expected declared minimum with computed maximum 5-6, got %d-%d", min, max)
+	}
+	if err := scenario.CheckPlayerCount(4); !errors.Is(err, scenarioEntity.ErrTooFewPlayers) {
+		t.Errorf("Expected the declared minimum to refuse 4 players, got %v", err)
+	}
+
+	// Declared bounds cannot make a count playable whose roles do not add up
+	scenario.MaxPlayers = 8
+	if err := scenario.CheckPlayerCount(8); !errors.Is(err, scenarioEntity.ErrRoleCountMismatch) {
+		t.Errorf("Expected a role mismatch for 8 players, got %v", err)
+	}
+	if report := scenarioEntity.NewScenarioValidator(6, 20).Validate(scenario); report.MaxPlayers != 8 || !report.HasErrors() {
+		t.Errorf("Expected the validator to check 6-8 players and find the mismatch, got %d-%d %+v", report.MinPlayers, report.MaxPlayers, report.Issues)
+	}
+
+	scenario.MinPlayers = 9
+	if err := scenario.Validate(); err == nil {
+		t.Errorf("Expected max_players below min_players to be refused")
+	}
+}
+
+func TestGamesRefuseRoomsOutsideDeclaredBounds(t *testing.T) {
+	common.InitSeed()
+	ctx := context.Background()
+	roomRepo := memrepo.NewInMemoryRoomRepository()
+	gameRepo := memrepo.NewInMemoryGameRepository()
+	scenarioRepo := memrepo.NewInMemoryScenarioRepository()
+	scenario := boundedScenario()
+	scenario.ID = "bounded"
+	scenario.MinPlayers = 5
+	if err := scenarioRepo.CreateScenario(scenario); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	room := newSeatedRoom(2, 3, 4, 5)
+	if err := roomRepo.CreateRoom(room); err != nil {
+		t.Fatalf("Failed to create room: %v", err)
+	}
+	moderator := sharedEntity.User{ID: 1}
+
+	// 4 players get 4 roles, but the scenario asks for at least 5
+	create := gameCommand.NewCreateGameHandler(gameRepo, apiAdapter.NewLocalRoomClient(roomRepo), apiAdapter.NewLocalScenarioClient(scenarioRepo))
+	if _, err := create.Handle(ctx, gameCommand.CreateGameCommand{Requester: moderator, RoomID: room.ID, ScenarioID: scenario.ID}); !errors.Is(err, scenarioEntity.ErrTooFewPlayers) {
+		t.Errorf("Expected the game to be refused with ErrTooFewPlayers, got %v", err)
+	}
+
+	// A game created while the room was full still checks the room when roles are dealt
+	game := &gameEntity.Game{ID: "g1", State: gameEntity.GameStateWaitingForPlayers, Room: room, Scenario: scenario, Assignments: make(map[sharedEntity.UserID]scenarioEntity.Role)}
+	if err := gameRepo.CreateGame(game); err != nil {
+		t.Fatalf("Failed to create game: %v", err)
+	}
+	assign := gameCommand.NewAssignRolesHandler(gameRepo, scenarioRepo, roomRepo)
+	if _, err := assign.Handle(ctx, gameCommand.AssignRolesCommand{Requester: moderator, GameID: game.ID}); !errors.Is(err, scenarioEntity.ErrTooFewPlayers) {
+		t.Errorf("Expected roles to be refused with ErrTooFewPlayers, got %v", err)
+	}
+}
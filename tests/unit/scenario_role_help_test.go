@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	fsAdapter "telemafia/internal/adapters/filesystem"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	scenarioHandler "telemafia/internal/presentation/telegram/handler/scenario"
+	messages "telemafia/internal/presentation/telegram/messages"
+)
+
+func TestBundledScenariosDescribeEverySideAndRole(t *testing.T) {
+	repo := memrepo.NewInMemoryScenarioRepository()
+	handler := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource("../../resources/scenario"), repo)
+	if _, err := handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{}); err != nil {
+		t.Fatalf("Seeding failed: %v", err)
+	}
+	all, _ := repo.GetAllScenarios()
+	for _, s := range all {
+		for _, side := range s.Sides {
+			if side.Description == "" {
+				t.Errorf("Scenario %s: side '%s' has no description", s.ID, side.Name)
+			}
+			roles := side.Roles
+			if side.DefaultRole != nil {
+				roles = append([]scenarioEntity.Role{*side.DefaultRole}, roles...)
+			}
+			for _, role := range roles {
+				if role.Description == "" {
+					t.Errorf("Scenario %s: role '%s' has no description", s.ID, role.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestRoleHelpIncludesSideAbilitiesAndTips(t *testing.T) {
+	msgs, err := messages.LoadMessages("../../messages.json")
+	if err != nil {
+		t.Fatalf("Failed to load messages: %v", err)
+	}
+	detective := scenarioEntity.Role{
+		Name:        "Detective",
+		Side:        "Town",
+		Description: "Checks one player every night.",
+		Tips:        []string{"Do not reveal yourself too early."},
+		Abilities:   []scenarioEntity.Ability{{Name: "Check", Description: "Learn a player's side", Uses: 2}},
+	}
+	villager := scenarioEntity.Role{Name: "Villager", Description: "No night action."}
+	scenario := &scenarioEntity.Scenario{
+		Name: "Test",
+		Sides: []scenarioEntity.Side{{
+			Name:        "Town",
+			Description: "Find and vote out the mafia.",
+			DefaultRole: &villager,
+			Roles:       []scenarioEntity.Role{detective, villager},
+		}},
+	}
+
+	side, ok := scenario.FindSide("Town")
+	if !ok || side.Description != "Find and vote out the mafia." {
+		t.Fatalf("Expected FindSide to return Town, got %+v, %v", side, ok)
+	}
+	if _, ok := scenario.FindSide("Nobody"); ok {
+		t.Errorf("Expected FindSide to miss an unknown side")
+	}
+
+	help := scenarioHandler.RoleHelp(msgs, scenario, detective)
+	for _, want := range []string{"Detective", "Find and vote out the mafia.", "Checks one player every night.", "Learn a player's side", "Do not reveal yourself too early."} {
+		if !strings.Contains(help, want) {
+			t.Errorf("Expected role help to contain %q, got:\n%s", want, help)
+		}
+	}
+
+	list := scenarioHandler.ScenarioRolesText(msgs, scenario)
+	if n := strings.Count(list, "No night action."); n != 1 {
+		t.Errorf("Expected the default role to be listed once, got %d times:\n%s", n, list)
+	}
+	if !strings.Contains(list, "Checks one player every night.") {
+		t.Errorf("Expected the role list to explain the detective, got:\n%s", list)
+	}
+}
+
+func TestSplitMessageKeepsPartsUnderLimit(t *testing.T) {
+	text := strings.Repeat("line of text\n", 50) + "\n" + strings.Repeat("x", 30)
+	parts := scenarioHandler.SplitMessage(text, 100)
+	if len(parts) < 2 {
+		t.Fatalf("Expected the text to be split, got %d parts", len(parts))
+	}
+	for _, p := range parts {
+		if len([]rune(p)) > 100 {
+			t.Errorf("Part longer than limit: %d", len([]rune(p)))
+		}
+	}
+	if joined := strings.Join(parts, ""); strings.Count(joined, "line of text") != 50 {
+		t.Errorf("Expected no text to be lost when splitting")
+	}
+}
+
+func TestRolePreviewMatchesGetRoles(t *testing.T) {
+	msgs, err := messages.LoadMessages("../../messages.json")
+	if err != nil {
+		t.Fatalf("Failed to load messages: %v", err)
+	}
+	rate := float32(0.25)
+	scenario := &scenarioEntity.Scenario{ID: "p1", Name: "Preview", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", PopulationRate: &rate, DefaultRole: &scenarioEntity.Role{Name: "Goon"}, Roles: []scenarioEntity.Role{{Name: "Boss"}}},
+		{Name: "Town", DefaultRole: &scenarioEntity.Role{Name: "Villager"}, Roles: []scenarioEntity.Role{{Name: "Doctor"}, {Name: "Sniper", AddedAt: 10}}},
+	}}
+
+	counts := make(map[string]int)
+	for _, role := range scenario.GetRoles(8) {
+		counts[role.Name]++
+	}
+	preview := scenarioHandler.RolePreview(scenario, 8, msgs)
+	for name, n := range counts {
+		if want := fmt.Sprintf(msgs.Scenario.DetailPreviewRole, n, name); !strings.Contains(preview, want) {
+			t.Errorf("Expected preview to contain %q, got:\n%s", want, preview)
+		}
+	}
+	if strings.Contains(preview, "Sniper") {
+		t.Errorf("Expected the sniper to be left out below 10 players, got:\n%s", preview)
+	}
+
+	detail, _ := scenarioHandler.PrepareScenarioDetail(scenario, 0, false, msgs)
+	if want := fmt.Sprintf(msgs.Scenario.DetailRole, "Sniper", 10); !strings.Contains(detail, want) {
+		t.Errorf("Expected the detail to show added_at thresholds, got:\n%s", detail)
+	}
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fsAdapter "telemafia/internal/adapters/filesystem"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+)
+
+func TestSeedScenariosUpsertsAndReportsErrors(t *testing.T) {
+	dir := t.TempDir()
+	classic, err := os.ReadFile("../../resources/scenario/classic.json")
+	if err != nil {
+		t.Fatalf("Failed to read classic.json: %v", err)
+	}
+	files := map[string]string{
+		"Classic.json":  string(classic),
+		"broken.json":   `{"name": "Broken", "sides": [`,
+		"no_sides.json": `{"name": "Lonely", "sides": []}`,
+		"notes.txt":     "not a scenario",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	repo := memrepo.NewInMemoryScenarioRepository()
+	handler := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource(dir), repo)
+
+	results, err := handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{})
+	if err != nil {
+		t.Fatalf("Seeding failed: %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 JSON files to be processed, got %d: %+v", len(results), results)
+	}
+	byFile := make(map[string]scenarioCommand.SeedScenarioResult)
+	for _, r := range results {
+		byFile[r.File] = r
+	}
+	if r := byFile["Classic.json"]; r.Err != nil || r.ScenarioID != "classic" || r.Replaced {
+		t.Errorf("Unexpected result for Classic.json: %+v", r)
+	}
+	if byFile["broken.json"].Err == nil || byFile["no_sides.json"].Err == nil {
+		t.Errorf("Expected errors for invalid files, got %+v / %+v", byFile["broken.json"], byFile["no_sides.json"])
+	}
+
+	// Seeding again leaves an unchanged scenario alone and follows changes to its file
+	results, _ = handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{})
+	for _, r := range results {
+		if r.File == "Classic.json" && (!r.Unchanged || r.Replaced || r.Skipped) {
+			t.Errorf("Expected the unchanged file to leave the scenario alone, got %+v", r)
+		}
+	}
+	renamed := strings.Replace(string(classic), `"name": "`, `"name": "v2 `, 1)
+	if err := os.WriteFile(filepath.Join(dir, "Classic.json"), []byte(renamed), 0o644); err != nil {
+		t.Fatalf("Failed to rewrite Classic.json: %v", err)
+	}
+	results, _ = handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{})
+	for _, r := range results {
+		if r.File == "Classic.json" && !r.Replaced {
+			t.Errorf("Expected the changed file to update the scenario, got %+v", r)
+		}
+	}
+	if stored, _ := repo.GetScenarioByID("classic"); !strings.HasPrefix(stored.Name, "v2 ") {
+		t.Errorf("Expected the file change to reach the stored scenario, got name %q", stored.Name)
+	}
+
+	// A copy edited in the bot is kept
+	edited, _ := repo.GetScenarioByID("classic")
+	edited = edited.Clone()
+	edited.Name = "Edited"
+	if err := repo.UpdateScenario(edited); err != nil {
+		t.Fatalf("Failed to edit scenario: %v", err)
+	}
+	results, _ = handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{})
+	for _, r := range results {
+		if r.File == "Classic.json" && (!r.Skipped || r.Replaced) {
+			t.Errorf("Expected second seed to keep the stored scenario, got %+v", r)
+		}
+	}
+	if stored, _ := repo.GetScenarioByID("classic"); stored.Name != "Edited" {
+		t.Errorf("Expected the edit to survive seeding, got name %q", stored.Name)
+	}
+
+	// Overwrite replaces by ID instead of duplicating
+	results, _ = handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{Overwrite: true})
+	for _, r := range results {
+		if r.File == "Classic.json" && !r.Replaced {
+			t.Errorf("Expected overwriting seed to replace the scenario, got %+v", r)
+		}
+	}
+	all, _ := repo.GetAllScenarios()
+	if len(all) != 1 || all[0].ID != "classic" || all[0].Name == "Edited" {
+		t.Errorf("Expected exactly one reseeded scenario 'classic', got %+v", all)
+	}
+}
+
+func TestSeedScenariosBundledFilesAreValid(t *testing.T) {
+	repo := memrepo.NewInMemoryScenarioRepository()
+	handler := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource("../../resources/scenario"), repo)
+
+	results, err := handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{})
+	if err != nil {
+		t.Fatalf("Seeding failed: %v", err)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("Bundled scenario %s failed to load: %v", r.File, r.Err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	memrepo "telemafia/internal/adapters/repository/memory"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioPort "telemafia/internal/domain/scenario/port"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	sharedEntity "telemafia/internal/shared/entity"
+)
+
+func TestAddScenarioJSONConflictPolicies(t *testing.T) {
+	repo := memrepo.NewInMemoryScenarioRepository()
+	handler := scenarioCommand.NewAddScenarioJSONHandler(repo)
+	admin := sharedEntity.User{Admin: true}
+	add := func(json string, policy scenarioCommand.ScenarioConflictPolicy) (*scenarioEntity.Scenario, error) {
+		return handler.Handle(context.Background(), scenarioCommand.AddScenarioJSONCommand{Requester: admin, JSONData: json, OnConflict: policy})
+	}
+
+	const v1 = `{"id": "night", "name": "Night", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`
+	const v2 = `{"id": "night", "name": "Night", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}, {"name": "Detective"}]}]}`
+
+	created, err := add(v1, scenarioCommand.ConflictFail)
+	if err != nil || created.ID != "night" {
+		t.Fatalf("Expected scenario 'night' to be created, got %+v (err %v)", created, err)
+	}
+
+	// Same slug: rejected with a conflict error by default
+	_, err = add(v2, scenarioCommand.ConflictFail)
+	var conflict *scenarioEntity.ScenarioConflictError
+	if !errors.As(err, &conflict) || conflict.Field != "id" || !errors.Is(err, scenarioEntity.ErrScenarioConflict) {
+		t.Fatalf("Expected an id conflict, got %v", err)
+	}
+
+	// Replace keeps the ID and updates the content
+	if _, err := add(v2, scenarioCommand.ConflictReplace); err != nil {
+		t.Fatalf("Replace failed: %v", err)
+	}
+	stored, _ := repo.GetScenarioByID("night")
+	if len(stored.Sides[0].Roles) != 2 {
+		t.Errorf("Expected replaced scenario to have 2 roles, got %+v", stored.Sides[0].Roles)
+	}
+
+	// Create new picks a free slug
+	copyScenario, err := add(v2, scenarioCommand.ConflictCreateNew)
+	if err != nil || copyScenario.ID != "night-2" {
+		t.Errorf("Expected copy with ID 'night-2', got %+v (err %v)", copyScenario, err)
+	}
+
+	// Name-only collision (no slug in JSON) is detected case-insensitively
+	_, err = add(`{"name": "night", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`, scenarioCommand.ConflictFail)
+	if !errors.As(err, &conflict) || conflict.Field != "name" {
+		t.Errorf("Expected a name conflict, got %v", err)
+	}
+
+	// Without an ID in the JSON the slug comes from the name and skips taken ones
+	copyByName, err := add(`{"name": "night", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`, scenarioCommand.ConflictCreateNew)
+	if err != nil || copyByName.ID != "night-3" {
+		t.Errorf("Expected copy with ID 'night-3', got %+v (err %v)", copyByName, err)
+	}
+	named, err := add(`{"name": "Day Fall", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`, scenarioCommand.ConflictFail)
+	if err != nil || named.ID != "day-fall" {
+		t.Errorf("Expected ID 'day-fall' from the name, got %+v (err %v)", named, err)
+	}
+	unnamed, err := add(`{"name": "شب", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`, scenarioCommand.ConflictFail)
+	if err != nil || scenarioEntity.ValidateScenarioID(unnamed.ID) != nil {
+		t.Errorf("Expected a generated ID for a name without Latin characters, got %+v (err %v)", unnamed, err)
+	}
+
+	// Invalid slugs are rejected
+	if _, err := add(`{"id": "Bad Slug!", "name": "Other", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`, scenarioCommand.ConflictFail); !errors.Is(err, scenarioEntity.ErrInvalidScenarioID) {
+		t.Errorf("Expected ErrInvalidScenarioID, got %v", err)
+	}
+}
+
+// deletingScenarioRepository deletes a scenario right after the scenarios are listed, like an admin
+// deleting it while an upload is checked for conflicts
+type deletingScenarioRepository struct {
+	scenarioPort.ScenarioRepository
+	deleteID string
+}
+
+func (r *deletingScenarioRepository) GetAllScenarios() ([]*scenarioEntity.Scenario, error) {
+	all, err := r.ScenarioRepository.GetAllScenarios()
+	if r.deleteID != "" {
+		_ = r.ScenarioRepository.DeleteScenario(r.deleteID)
+		r.deleteID = ""
+	}
+	return all, err
+}
+
+func TestReplaceDoesNotResurrectDeletedScenario(t *testing.T) {
+	repo := &deletingScenarioRepository{ScenarioRepository: memrepo.NewInMemoryScenarioRepository()}
+	if err := repo.CreateScenario(&scenarioEntity.Scenario{ID: "night", Name: "Night", Sides: []scenarioEntity.Side{{Name: "Town"}}}); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	repo.deleteID = "night"
+
+	handler := scenarioCommand.NewAddScenarioJSONHandler(repo)
+	cmd := scenarioCommand.AddScenarioJSONCommand{
+		Requester:  sharedEntity.User{Admin: true},
+		JSONData:   `{"name": "Night", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`,
+		OnConflict: scenarioCommand.ConflictReplace,
+	}
+	if _, err := handler.Handle(context.Background(), cmd); !errors.Is(err, scenarioEntity.ErrScenarioNotFound) {
+		t.Errorf("Expected ErrScenarioNotFound for a scenario deleted during the upload, got %v", err)
+	}
+	if _, err := repo.GetScenarioByID("night"); !errors.Is(err, scenarioEntity.ErrScenarioNotFound) {
+		t.Errorf("Expected the deleted scenario to stay deleted, got %v", err)
+	}
+}
+
+func TestScenarioIDsFitCallbackData(t *testing.T) {
+	long := strings.Repeat("a", scenarioEntity.MaxScenarioIDLength)
+	if err := scenarioEntity.ValidateScenarioID(long); err != nil {
+		t.Errorf("Expected a slug of %d characters to be valid, got %v", len(long), err)
+	}
+	if err := scenarioEntity.ValidateScenarioID(long + "a"); !errors.Is(err, scenarioEntity.ErrInvalidScenarioID) {
+		t.Errorf("Expected a longer slug to be refused, got %v", err)
+	}
+	if id := scenarioCommand.ScenarioIDFromFileName("The Very Long Night Of The Sleepless Town.json"); id != "the-very-long-night-of-the-sle" {
+		t.Errorf("Expected the derived slug to be cut, got '%s'", id)
+	}
+
+	repo := memrepo.NewInMemoryScenarioRepository()
+	if err := repo.CreateScenario(&scenarioEntity.Scenario{ID: long, Name: "Long"}); err != nil {
+		t.Fatalf("Failed to create scenario: %v", err)
+	}
+	created, err := scenarioCommand.NewAddScenarioJSONHandler(repo).Handle(context.Background(), scenarioCommand.AddScenarioJSONCommand{
+		Requester:  sharedEntity.User{Admin: true},
+		JSONData:   `{"id": "` + long + `", "name": "Other", "sides": [{"name": "Town", "roles": [{"name": "Doctor"}]}]}`,
+		OnConflict: scenarioCommand.ConflictCreateNew,
+	})
+	if err != nil || created.ID != long[:len(long)-2]+"-2" {
+		t.Errorf("Expected the free slug to be cut to fit, got %+v (err %v)", created, err)
+	}
+}
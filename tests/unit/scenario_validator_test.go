@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fsAdapter "telemafia/internal/adapters/filesystem"
+	memrepo "telemafia/internal/adapters/repository/memory"
+	scenarioEntity "telemafia/internal/domain/scenario/entity"
+	scenarioCommand "telemafia/internal/domain/scenario/usecase/command"
+	scenarioHandler "telemafia/internal/presentation/telegram/handler/scenario"
+	messages "telemafia/internal/presentation/telegram/messages"
+)
+
+func hasIssue(issues []scenarioEntity.ValidationIssue, code scenarioEntity.IssueCode) (scenarioEntity.ValidationIssue, bool) {
+	for _, issue := range issues {
+		if issue.Code == code {
+			return issue, true
+		}
+	}
+	return scenarioEntity.ValidationIssue{}, false
+}
+
+func TestScenarioValidatorReportsRatesDuplicatesAndParity(t *testing.T) {
+	rate, townRate := float32(0.5), float32(0.6)
+	scenario := &scenarioEntity.Scenario{Name: "Broken", Sides: []scenarioEntity.Side{
+		{Name: "Mafia", PopulationRate: &rate, DefaultRole: &scenarioEntity.Role{Name: "Goon"},
+			Roles:         []scenarioEntity.Role{{Name: "Boss"}, {Name: "Doctor"}},
+			WinConditions: []scenarioEntity.WinCondition{{Type: scenarioEntity.WinParity}}},
+		{Name: "Town", PopulationRate: &townRate, DefaultRole: &scenarioEntity.Role{Name: "Villager"},
+			Roles: []scenarioEntity.Role{{Name: "Doctor"}}},
+	}}
+
+	report := scenarioEntity.NewScenarioValidator(6, 8).Validate(scenario)
+	if len(report.Counts) != 3 || report.Counts[0].Players != 6 {
+		t.Fatalf("Expected a summary for 6-8 players, got %+v", report.Counts)
+	}
+	if !report.HasErrors() {
+		t.Fatalf("Expected errors, got %+v", report.Issues)
+	}
+	if issue, ok := hasIssue(report.Errors(), scenarioEntity.IssueRatesExceedPlayers); !ok || fmt.Sprintf("%.2f", issue.Rate) != "1.10" {
+		t.Errorf("Expected the population rate total to be reported, got %+v", report.Issues)
+	}
+	if issue, ok := hasIssue(report.Errors(), scenarioEntity.IssueSharedRole); !ok || issue.Role != "Doctor" || issue.Side != "Mafia" || issue.OtherSide != "Town" {
+		t.Errorf("Expected the shared role name to be reported, got %+v", report.Issues)
+	}
+	if issue, ok := hasIssue(report.Errors(), scenarioEntity.IssueParityAtStart); !ok || issue.PlayerRanges() != "6, 8" {
+		t.Errorf("Expected parity at the start for 6 and 8 players, got %+v", report.Issues)
+	}
+	for _, count := range report.Counts {
+		if roles := scenario.GetRoles(count.Players); len(roles) != count.Roles {
+			t.Errorf("Summary for %d players counts %d roles, GetRoles hands out %d", count.Players, count.Roles, len(roles))
+		}
+	}
+
+	msgs, err := messages.LoadMessages("../../messages.json")
+	if err != nil {
+		t.Fatalf("Failed to load messages: %v", err)
+	}
+	shared, _ := hasIssue(report.Errors(), scenarioEntity.IssueSharedRole)
+	if text := scenarioHandler.ValidationIssueText(shared, report, msgs); text != fmt.Sprintf(msgs.Scenario.ValidationIssueSharedRole, "Doctor", "Mafia", "Town") {
+		t.Errorf("Expected the shared role text from messages, got %q", text)
+	}
+}
+
+func TestScenarioValidatorReportsMismatchesAndAcceptsClassic(t *testing.T) {
+	report := scenarioEntity.DefaultScenarioValidator().Validate(&scenarioEntity.Scenario{Name: "Empty"})
+	if !report.HasErrors() || len(report.Counts) != 0 {
+		t.Errorf("Expected a structural error and no summaries, got %+v", report)
+	}
+
+	fixed := &scenarioEntity.Scenario{Name: "Fixed", Sides: []scenarioEntity.Side{
+		{Name: "Town", Roles: []scenarioEntity.Role{{Name: "Doctor"}, {Name: "Detective"}, {Name: "Sniper", AddedAt: 7}}},
+	}}
+	report = scenarioEntity.NewScenarioValidator(6, 7).Validate(fixed)
+	if issue, ok := hasIssue(report.Errors(), scenarioEntity.IssueTooFewRoles); !ok || issue.PlayerRanges() != "6-7" {
+		t.Errorf("Expected too few roles for 6-7 players, got %+v", report.Issues)
+	}
+
+	repo := memrepo.NewInMemoryScenarioRepository()
+	handler := scenarioCommand.NewSeedScenariosHandler(fsAdapter.NewScenarioDirectorySource("../../resources/scenario"), repo)
+	if _, err := handler.Handle(context.Background(), scenarioCommand.SeedScenariosCommand{}); err != nil {
+		t.Fatalf("Seeding failed: %v", err)
+	}
+	classic, err := repo.GetScenarioByID("classic")
+	if err != nil {
+		t.Fatalf("Failed to load classic: %v", err)
+	}
+	if report := scenarioEntity.DefaultScenarioValidator().Validate(classic); len(report.Issues) != 0 {
+		t.Errorf("Expected no issues for the classic scenario, got %+v", report.Issues)
+	}
+}
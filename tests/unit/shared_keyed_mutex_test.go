@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"telemafia/internal/shared/common"
+)
+
+func TestKeyedMutexSerializesOneKeyOnly(t *testing.T) {
+	var locks common.KeyedMutex[string]
+
+	unlockA := locks.Lock("a")
+
+	// A different key is not blocked by "a"
+	done := make(chan struct{})
+	go func() {
+		locks.Lock("b")()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected key b to lock while a is held")
+	}
+
+	// The same key waits until "a" is released
+	acquired := make(chan struct{})
+	go func() {
+		locks.Lock("a")()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("Expected key a to wait for its holder")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlockA()
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("Expected key a to lock once released")
+	}
+}
+
+func TestKeyedMutexCountsConcurrentHolders(t *testing.T) {
+	var locks common.KeyedMutex[int]
+	counter := 0
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer locks.Lock(7)()
+			v := counter
+			time.Sleep(time.Microsecond)
+			counter = v + 1
+		}()
+	}
+	wg.Wait()
+	if counter != 50 {
+		t.Fatalf("Expected 50 serialized increments, got %d", counter)
+	}
+}